@@ -34,16 +34,20 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ias"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/kubeconfig"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/leaderelection"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/metrics"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/middleware"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/notification"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/operation"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
 	orchestrate "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration/handlers"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration/manager"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/deprovisioning"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/hibernation"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/input"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/provisioning"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/run_task"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/update"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/upgrade_cluster"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/upgrade_kyma"
@@ -58,6 +62,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/suspension"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/swagger"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/webhook"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -68,6 +73,7 @@ import (
 	runtime2 "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -146,8 +152,27 @@ type Config struct {
 
 	OrchestrationConfig orchestration.Config
 
+	// Suspension selects the plans, which instances are hibernated instead of deprovisioned on suspension
+	Suspension suspension.Config
+
+	// DurableQueue enables keeping the processing queues in the database, so queued operations
+	// survive restarts and are not processed by several replicas at the same time.
+	DurableQueue process.DurableQueueConfig
+
+	// LeaderElection allows to run the workers of the processing queues only in one replica.
+	// Other replicas serve the API only, it requires the durable queue.
+	LeaderElection leaderelection.Config
+
+	// Outbox enables saving events with durable subscribers in the database, so they are delivered at least once
+	Outbox event.OutboxConfig
+
+	// Webhooks sends events of operations as CloudEvents to the configured endpoints
+	Webhooks webhook.Config
+
 	TrialRegionMappingFilePath string
 	MaxPaginationPage          int `envconfig:"default=100"`
+	// RuntimesWatch configures streaming of changes of runtimes by GET /runtimes?watch=true
+	RuntimesWatch runtime.WatchConfig
 
 	LogLevel string `envconfig:"default=info"`
 
@@ -242,6 +267,16 @@ func main() {
 	cli, err := initClient(k8sCfg)
 	fatalOnError(err)
 
+	// elect the replica which runs the workers of the processing queues
+	if cfg.LeaderElection.Enabled && !cfg.DurableQueue.Enabled {
+		fatalOnError(errors.New("leader election requires the durable queue, operations queued by other replicas would not be processed"))
+	}
+	k8sClientset, err := kubernetes.NewForConfig(k8sCfg)
+	fatalOnError(err)
+	elector := leaderelection.NewElector(cfg.LeaderElection, k8sClientset, logs.WithField("service", "leaderElection"))
+	fatalOnError(elector.Run(ctx))
+	workersReady := elector.Leading()
+
 	// create director client
 	directorClient := director.NewDirectorClient(ctx, cfg.Director, logs.WithField("service", "directorClient"))
 
@@ -328,10 +363,20 @@ func main() {
 
 	// application event broker
 	eventBroker := event.NewPubSub(logs)
+	if cfg.Outbox.Enabled {
+		eventBroker = event.NewDurablePubSub(db.Outbox(), process.OperationEvents, cfg.Outbox, logs.WithField("service", "outbox"))
+	}
 
 	// metrics collectors
 	metrics.RegisterAll(eventBroker, db.Operations(), db.Instances())
 
+	if cfg.Webhooks.Enabled {
+		endpoints, err := webhook.ReadEndpointsFromYAML(cfg.Webhooks.EndpointsYAMLFilePath)
+		fatalOnError(err)
+		err = webhook.NewSink(cfg.Webhooks, endpoints, logs.WithField("service", "webhooks")).Subscribe(eventBroker)
+		fatalOnError(err)
+	}
+
 	//setup runtime overrides appender
 	runtimeOverrides := runtimeoverrides.NewRuntimeOverrides(ctx, cli)
 
@@ -342,20 +387,22 @@ func main() {
 	// run queues
 	const workersAmount = 5
 	provisionManager := provisioning.NewStagedManager(db.Operations(), eventBroker, cfg.OperationTimeout, logs.WithField("provisioning", "manager"))
-	provisionQueue := NewProvisioningProcessingQueue(ctx, provisionManager, 60, &cfg, db, provisionerClient, directorClient, inputFactory,
+	provisionQueue := NewProvisioningProcessingQueue(ctx, workersReady, provisionManager, 60, &cfg, db, provisionerClient, directorClient, inputFactory,
 		avsDel, internalEvalAssistant, externalEvalCreator, internalEvalUpdater, runtimeVerConfigurator,
 		runtimeOverrides, bundleBuilder,
 		edpClient, accountProvider, fileSystem, reconcilerClient, logs)
 
 	deprovisionManager := deprovisioning.NewManager(db.Operations(), eventBroker, logs.WithField("deprovisioning", "manager"))
-	deprovisionQueue := NewDeprovisioningProcessingQueue(ctx, workersAmount, deprovisionManager, &cfg, db, eventBroker, provisionerClient,
+	deprovisionQueue := NewDeprovisioningProcessingQueue(ctx, workersReady, workersAmount, deprovisionManager, &cfg, db, eventBroker, provisionerClient,
 		avsDel, internalEvalAssistant, externalEvalAssistant, bundleBuilder, edpClient, accountProvider, reconcilerClient,
 		k8sClientProvider, logs)
 
 	updateManager := update.NewManager(db.Operations(), eventBroker, cfg.OperationTimeout, logs)
-	updateQueue := NewUpdateProcessingQueue(ctx, updateManager, 20, db, inputFactory, provisionerClient, eventBroker,
+	updateQueue := NewUpdateProcessingQueue(ctx, workersReady, updateManager, 20, db, inputFactory, provisionerClient, eventBroker,
 		runtimeVerConfigurator, db.RuntimeStates(), componentsProvider, reconcilerClient, cfg, k8sClientProvider, logs)
 
+	hibernationQueue := NewHibernationProcessingQueue(ctx, workersReady, db, provisionerClient, eventBroker, nil, workersAmount, cfg, logs)
+
 	/***/
 	servicesConfig, err := broker.NewServicesConfigFromFile(cfg.CatalogFilePath)
 	fatalOnError(err)
@@ -363,7 +410,8 @@ func main() {
 	// create server
 	router := mux.NewRouter()
 
-	createAPI(router, servicesConfig, inputFactory, &cfg, db, provisionQueue, deprovisionQueue, updateQueue, logger, logs, inputFactory.GetPlanDefaults)
+	bindingCredentials := kubeconfig.NewServiceAccountManager(provisionerClient, kubeconfig.NewClientsetFromKubeconfig, cfg.Broker.Binding.ClusterRole)
+	createAPI(router, servicesConfig, inputFactory, &cfg, db, provisionQueue, deprovisionQueue, updateQueue, hibernationQueue, bindingCredentials, logger, logs, inputFactory.GetPlanDefaults)
 
 	// create metrics endpoint
 	router.Handle("/metrics", promhttp.Handler())
@@ -376,13 +424,23 @@ func main() {
 	runtimeLister := orchestration.NewRuntimeLister(db.Instances(), db.Operations(), runtime.NewConverter(cfg.DefaultRequestRegion), logs)
 	runtimeResolver := orchestrationExt.NewGardenerRuntimeResolver(dynamicGardener, gardenerNamespace, runtimeLister, logs)
 
-	kymaQueue := NewKymaOrchestrationProcessingQueue(ctx, db, runtimeOverrides, provisionerClient, eventBroker, inputFactory, nil, time.Minute, runtimeVerConfigurator, runtimeResolver, upgradeEvalManager,
-		&cfg, internalEvalAssistant, reconcilerClient, notificationBuilder, fileSystem, logs, cli, 1)
-	clusterQueue := NewClusterOrchestrationProcessingQueue(ctx, db, provisionerClient, eventBroker, inputFactory,
-		nil, time.Minute, runtimeResolver, upgradeEvalManager, notificationBuilder, logs, cli, cfg, 1)
+	// the concurrency limits apply to the operations of all orchestration types
+	limiter := manager.NewGlobalLimiter(cli, cfg.OrchestrationConfig, logs.WithField("orchestration", "limiter"))
+	err = limiter.Restore(db.Orchestrations(), db.Operations())
+	fatalOnError(err)
+	upgradeKymaManager := upgrade_kyma.NewManager(db.Operations(), eventBroker, logs.WithField("upgradeKyma", "manager"))
+	kymaQueue := NewKymaOrchestrationProcessingQueue(ctx, workersReady, upgradeKymaManager, db, runtimeOverrides, provisionerClient, eventBroker, inputFactory, nil, time.Minute, runtimeVerConfigurator, runtimeResolver, upgradeEvalManager,
+		&cfg, internalEvalAssistant, reconcilerClient, notificationBuilder, fileSystem, logs, cli, limiter, 1)
+	upgradeClusterManager := upgrade_cluster.NewManager(db.Operations(), eventBroker, logs.WithField("upgradeCluster", "manager"))
+	clusterQueue := NewClusterOrchestrationProcessingQueue(ctx, workersReady, upgradeClusterManager, db, provisionerClient, eventBroker, inputFactory,
+		nil, time.Minute, runtimeResolver, upgradeEvalManager, notificationBuilder, logs, cli, limiter, cfg, 1)
+	runTaskManager := run_task.NewManager(db.Operations(), eventBroker, logs.WithField("runTask", "manager"))
+	taskQueue := NewTaskOrchestrationProcessingQueue(ctx, workersReady, runTaskManager, db, provisionerClient, eventBroker, nil, time.Minute, runtimeResolver,
+		k8sClientProvider, logs, cli, limiter, cfg, 1)
 
 	// TODO: in case of cluster upgrade the same Azure Zones must be send to the Provisioner
-	orchestrationHandler := orchestrate.NewOrchestrationHandler(db, kymaQueue, clusterQueue, cfg.MaxPaginationPage, logs)
+	previewer := manager.NewPreviewer(runtimeResolver, cli, &cfg.OrchestrationConfig, logs)
+	orchestrationHandler := orchestrate.NewOrchestrationHandler(db, kymaQueue, clusterQueue, taskQueue, previewer, cfg.MaxPaginationPage, logs)
 
 	if !cfg.DisableProcessOperationsInProgress {
 		err = processOperationsInProgressByType(internal.OperationTypeProvision, db.Operations(), provisionQueue, logs)
@@ -391,10 +449,18 @@ func main() {
 		fatalOnError(err)
 		err = processOperationsInProgressByType(internal.OperationTypeUpdate, db.Operations(), updateQueue, logs)
 		fatalOnError(err)
+		err = processOperationsInProgressByType(internal.OperationTypeHibernate, db.Operations(), hibernationQueue, logs)
+		fatalOnError(err)
+		err = processOperationsInProgressByType(internal.OperationTypeWakeUp, db.Operations(), hibernationQueue, logs)
+		fatalOnError(err)
 		err = reprocessOrchestrations(orchestrationExt.UpgradeKymaOrchestration, db.Orchestrations(), db.Operations(), kymaQueue, logs)
 		fatalOnError(err)
+		err = reprocessOrchestrations(orchestrationExt.RollbackKymaOrchestration, db.Orchestrations(), db.Operations(), kymaQueue, logs)
+		fatalOnError(err)
 		err = reprocessOrchestrations(orchestrationExt.UpgradeClusterOrchestration, db.Orchestrations(), db.Operations(), clusterQueue, logs)
 		fatalOnError(err)
+		err = reprocessOrchestrations(orchestrationExt.RunTaskOrchestration, db.Orchestrations(), db.Operations(), taskQueue, logs)
+		fatalOnError(err)
 	} else {
 		logger.Info("Skipping processing operation in progress on start")
 	}
@@ -410,14 +476,37 @@ func main() {
 	orchestrationHandler.AttachRoutes(router)
 
 	// create list runtimes endpoint
-	runtimeHandler := runtime.NewHandler(db.Instances(), db.Operations(), db.RuntimeStates(), cfg.MaxPaginationPage, cfg.DefaultRequestRegion)
+	runtimeHandler := runtime.NewHandler(db.Instances(), db.Operations(), db.RuntimeStates(), cfg.MaxPaginationPage, cfg.DefaultRequestRegion).
+		WithWatchConfig(cfg.RuntimesWatch)
 	runtimeHandler.AttachRoutes(router)
 
+	// create operation steps journal and resume endpoints
+	resumer := operation.NewResumer(db.Operations(), db.Orchestrations(), operation.ResumeQueues{
+		Provisioning:   provisionQueue,
+		Deprovisioning: deprovisionQueue,
+		Update:         updateQueue,
+		UpgradeKyma:    kymaQueue,
+		UpgradeCluster: clusterQueue,
+		RunTask:        taskQueue,
+	}, operation.ResumeSteps{
+		Provisioning:   provisionManager,
+		Deprovisioning: deprovisionManager,
+		Update:         updateManager,
+		UpgradeKyma:    upgradeKymaManager,
+		UpgradeCluster: upgradeClusterManager,
+		RunTask:        runTaskManager,
+	}, logs)
+	operationHandler := operation.NewHandler(db.Operations(), resumer, logs)
+	operationHandler.AttachRoutes(router)
+
 	router.StrictSlash(true).PathPrefix("/").Handler(http.StripPrefix("/", http.FileServer(http.Dir("/swagger"))))
 	svr := handlers.CustomLoggingHandler(os.Stdout, router, func(writer io.Writer, params handlers.LogFormatterParams) {
 		logs.Infof("Call handled: method=%s url=%s statusCode=%d size=%d", params.Request.Method, params.URL.Path, params.StatusCode, params.Size)
 	})
 
+	// all durable subscribers are registered, events from the outbox can be delivered
+	eventBroker.RunDispatcher(workersReady, ctx.Done())
+
 	fatalOnError(http.ListenAndServe(cfg.Host+":"+cfg.Port, svr))
 }
 
@@ -453,8 +542,8 @@ func isVersionFollowingSemanticVersioning(version string) bool {
 	return false
 }
 
-func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planValidator broker.PlanValidator, cfg *Config, db storage.BrokerStorage, provisionQueue, deprovisionQueue, updateQueue *process.Queue, logger lager.Logger, logs logrus.FieldLogger, planDefaults broker.PlanDefaults) {
-	suspensionCtxHandler := suspension.NewContextUpdateHandler(db.Operations(), provisionQueue, deprovisionQueue, logs)
+func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planValidator broker.PlanValidator, cfg *Config, db storage.BrokerStorage, provisionQueue, deprovisionQueue, updateQueue, hibernationQueue *process.Queue, bindingCredentials broker.BindingCredentials, logger lager.Logger, logs logrus.FieldLogger, planDefaults broker.PlanDefaults) {
+	suspensionCtxHandler := suspension.NewContextUpdateHandler(db.Operations(), provisionQueue, deprovisionQueue, hibernationQueue, cfg.Suspension, logs)
 
 	defaultPlansConfig, err := servicesConfig.DefaultPlansConfig()
 	fatalOnError(err)
@@ -467,10 +556,10 @@ func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planVal
 		broker.NewUpdate(cfg.Broker, db.Instances(), db.RuntimeStates(), db.Operations(), suspensionCtxHandler, cfg.UpdateProcessingEnabled, cfg.UpdateSubAccountMovementEnabled, updateQueue, planDefaults, logs, cfg.KymaDashboardConfig),
 		broker.NewGetInstance(cfg.Broker, db.Instances(), db.Operations(), logs),
 		broker.NewLastOperation(db.Operations(), logs),
-		broker.NewBind(logs),
-		broker.NewUnbind(logs),
-		broker.NewGetBinding(logs),
-		broker.NewLastBindingOperation(logs),
+		broker.NewBind(cfg.Broker.Binding, db.Instances(), db.Bindings(), bindingCredentials, logs),
+		broker.NewUnbind(db.Instances(), db.Bindings(), bindingCredentials, logs),
+		broker.NewGetBinding(db.Bindings(), logs),
+		broker.NewLastBindingOperation(db.Bindings(), logs),
 	}
 
 	router.Use(middleware.AddRegionToContext(cfg.DefaultRequestRegion))
@@ -488,6 +577,14 @@ func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planVal
 	router.Handle("/info/runtimes", runtimesInfoHandler)
 }
 
+// newProcessingQueue creates a queue kept in the database if the durable queue is enabled, an in-memory queue otherwise
+func newProcessingQueue(name string, executor process.Executor, db storage.BrokerStorage, cfg process.DurableQueueConfig, log logrus.FieldLogger) *process.Queue {
+	if cfg.Enabled {
+		return process.NewDurableQueue(name, executor, db.QueueItems(), cfg, log)
+	}
+	return process.NewQueue(executor, log)
+}
+
 // queues all in progress operations by type
 func processOperationsInProgressByType(opType internal.OperationType, op storage.Operations, queue *process.Queue, log logrus.FieldLogger) error {
 	operations, err := op.GetNotFinishedOperationsByType(opType)
@@ -495,7 +592,7 @@ func processOperationsInProgressByType(opType internal.OperationType, op storage
 		return errors.Wrap(err, "while getting in progress operations from storage")
 	}
 	for _, operation := range operations {
-		queue.Add(operation.ID)
+		queue.Resume(operation.ID)
 		log.Infof("Resuming the processing of %s operation ID: %s", opType, operation.ID)
 	}
 	return nil
@@ -531,7 +628,7 @@ func processOrchestration(orchestrationType orchestrationExt.Type, state string,
 	})
 
 	for _, o := range orchestrations {
-		queue.Add(o.OrchestrationID)
+		queue.Resume(o.OrchestrationID)
 		log.Infof("Resuming the processing of %s %s orchestration ID: %s", state, orchestrationType, o.OrchestrationID)
 	}
 	return nil
@@ -555,10 +652,12 @@ func processCancelingOrchestrations(orchestrationType orchestrationExt.Type, orc
 	for _, o := range orchestrations {
 		count := 0
 		err = nil
-		if orchestrationType == orchestrationExt.UpgradeKymaOrchestration {
+		if orchestrationType == orchestrationExt.UpgradeKymaOrchestration || orchestrationType == orchestrationExt.RollbackKymaOrchestration {
 			_, count, _, err = operationsStorage.ListUpgradeKymaOperationsByOrchestrationID(o.OrchestrationID, dbmodel.OperationFilter{States: []string{orchestrationExt.InProgress}})
 		} else if orchestrationType == orchestrationExt.UpgradeClusterOrchestration {
 			_, count, _, err = operationsStorage.ListUpgradeClusterOperationsByOrchestrationID(o.OrchestrationID, dbmodel.OperationFilter{States: []string{orchestrationExt.InProgress}})
+		} else if orchestrationType == orchestrationExt.RunTaskOrchestration {
+			_, count, _, err = operationsStorage.ListRunTaskOperationsByOrchestrationID(o.OrchestrationID, dbmodel.OperationFilter{States: []string{orchestrationExt.InProgress}})
 		}
 		if err != nil {
 			return errors.Wrapf(err, "while listing %s operations for orchestration %s", orchestrationType, o.OrchestrationID)
@@ -566,7 +665,7 @@ func processCancelingOrchestrations(orchestrationType orchestrationExt.Type, orc
 
 		if count > 0 {
 			log.Infof("Resuming the processing of %s %s orchestration ID: %s", orchestrationExt.Canceling, orchestrationType, o.OrchestrationID)
-			queue.Add(o.OrchestrationID)
+			queue.Resume(o.OrchestrationID)
 			return nil
 		}
 	}
@@ -606,7 +705,7 @@ func panicOnError(err error) {
 	}
 }
 
-func NewProvisioningProcessingQueue(ctx context.Context, provisionManager *provisioning.StagedManager, workersAmount int,
+func NewProvisioningProcessingQueue(ctx context.Context, workersReady <-chan struct{}, provisionManager *provisioning.StagedManager, workersAmount int,
 	cfg *Config, db storage.BrokerStorage, provisionerClient provisioner.Client, directorClient provisioning.DirectorClient,
 	inputFactory input.CreatorForPlan, avsDel *avs.Delegator, internalEvalAssistant *avs.InternalEvalAssistant,
 	externalEvalCreator *provisioning.ExternalEvalCreator, internalEvalUpdater *provisioning.InternalEvalUpdater,
@@ -649,7 +748,7 @@ func NewProvisioningProcessingQueue(ctx context.Context, provisionManager *provi
 		},
 		{
 			stage:    createRuntimeStageName,
-			step:     provisioning.NewInternalEvaluationStep(avsDel, internalEvalAssistant),
+			step:     provisioning.NewInternalEvaluationStep(avsDel, internalEvalAssistant, cfg.Avs),
 			disabled: cfg.Avs.Disabled,
 		},
 		{
@@ -694,7 +793,7 @@ func NewProvisioningProcessingQueue(ctx context.Context, provisionManager *provi
 		// post actions
 		{
 			stage: postActionsStageName,
-			step:  provisioning.NewExternalEvalStep(externalEvalCreator),
+			step:  provisioning.NewExternalEvalStep(externalEvalCreator, cfg.Avs),
 		},
 		{
 			stage: postActionsStageName,
@@ -710,13 +809,13 @@ func NewProvisioningProcessingQueue(ctx context.Context, provisionManager *provi
 		}
 	}
 
-	queue := process.NewQueue(provisionManager, logs)
-	queue.Run(ctx.Done(), workersAmount)
+	queue := newProcessingQueue("provisioning", provisionManager, db, cfg.DurableQueue, logs)
+	queue.RunAfter(workersReady, ctx.Done(), workersAmount)
 
 	return queue
 }
 
-func NewUpdateProcessingQueue(ctx context.Context, manager *update.Manager, workersAmount int, db storage.BrokerStorage, inputFactory input.CreatorForPlan,
+func NewUpdateProcessingQueue(ctx context.Context, workersReady <-chan struct{}, manager *update.Manager, workersAmount int, db storage.BrokerStorage, inputFactory input.CreatorForPlan,
 	provisionerClient provisioner.Client, publisher event.Publisher, runtimeVerConfigurator *runtimeversion.RuntimeVersionConfigurator, runtimeStatesDb storage.RuntimeStates,
 	runtimeProvider input.ComponentListProvider, reconcilerClient reconciler.Client, cfg Config, k8sClientProvider func(kcfg string) (client.Client, error), logs logrus.FieldLogger) *process.Queue {
 
@@ -827,13 +926,13 @@ func NewUpdateProcessingQueue(ctx context.Context, manager *update.Manager, work
 			fatalOnError(err)
 		}
 	}
-	queue := process.NewQueue(manager, logs)
-	queue.Run(ctx.Done(), workersAmount)
+	queue := newProcessingQueue("update", manager, db, cfg.DurableQueue, logs)
+	queue.RunAfter(workersReady, ctx.Done(), workersAmount)
 
 	return queue
 }
 
-func NewDeprovisioningProcessingQueue(ctx context.Context, workersAmount int, deprovisionManager *deprovisioning.Manager, cfg *Config, db storage.BrokerStorage, pub event.Publisher,
+func NewDeprovisioningProcessingQueue(ctx context.Context, workersReady <-chan struct{}, workersAmount int, deprovisionManager *deprovisioning.Manager, cfg *Config, db storage.BrokerStorage, pub event.Publisher,
 	provisionerClient provisioner.Client, avsDel *avs.Delegator, internalEvalAssistant *avs.InternalEvalAssistant,
 	externalEvalAssistant *avs.ExternalEvalAssistant, bundleBuilder ias.BundleBuilder,
 	edpClient deprovisioning.EDPClient, accountProvider hyperscaler.AccountProvider, reconcilerClient reconciler.Client,
@@ -855,6 +954,10 @@ func NewDeprovisioningProcessingQueue(ctx context.Context, workersAmount int, de
 			weight: 1,
 			step:   deprovisioning.NewRemoveServiceInstanceStep(db.Operations()),
 		},
+		{
+			weight: 1,
+			step:   deprovisioning.NewRemoveBindingsStep(db.Operations(), db.Bindings()),
+		},
 		{
 			weight: 1,
 			step:   deprovisioning.NewAvsEvaluationsRemovalStep(avsDel, db.Operations(), externalEvalAssistant, internalEvalAssistant),
@@ -888,21 +991,20 @@ func NewDeprovisioningProcessingQueue(ctx context.Context, workersAmount int, de
 		}
 	}
 
-	queue := process.NewQueue(deprovisionManager, logs)
-	queue.Run(ctx.Done(), workersAmount)
+	queue := newProcessingQueue("deprovisioning", deprovisionManager, db, cfg.DurableQueue, logs)
+	queue.RunAfter(workersReady, ctx.Done(), workersAmount)
 
 	return queue
 }
 
-func NewKymaOrchestrationProcessingQueue(ctx context.Context, db storage.BrokerStorage,
+func NewKymaOrchestrationProcessingQueue(ctx context.Context, workersReady <-chan struct{}, upgradeKymaManager *upgrade_kyma.Manager, db storage.BrokerStorage,
 	runtimeOverrides upgrade_kyma.RuntimeOverridesAppender, provisionerClient provisioner.Client,
 	pub event.Publisher, inputFactory input.CreatorForPlan, icfg *upgrade_kyma.TimeSchedule,
 	pollingInterval time.Duration, runtimeVerConfigurator *runtimeversion.RuntimeVersionConfigurator,
 	runtimeResolver orchestrationExt.RuntimeResolver, upgradeEvalManager *avs.EvaluationManager,
 	cfg *Config, internalEvalAssistant *avs.InternalEvalAssistant, reconcilerClient reconciler.Client,
-	notificationBuilder notification.BundleBuilder, fileSystem afero.Fs, logs logrus.FieldLogger, cli client.Client, speedFactor int) *process.Queue {
+	notificationBuilder notification.BundleBuilder, fileSystem afero.Fs, logs logrus.FieldLogger, cli client.Client, limiter *manager.GlobalLimiter, speedFactor int) *process.Queue {
 
-	upgradeKymaManager := upgrade_kyma.NewManager(db.Operations(), pub, logs.WithField("upgradeKyma", "manager"))
 	upgradeKymaInit := upgrade_kyma.NewInitialisationStep(db.Operations(), db.Orchestrations(), db.Instances(),
 		provisionerClient, inputFactory, upgradeEvalManager, icfg, runtimeVerConfigurator, notificationBuilder)
 
@@ -956,22 +1058,21 @@ func NewKymaOrchestrationProcessingQueue(ctx context.Context, db storage.BrokerS
 		}
 	}
 
-	orchestrateKymaManager := manager.NewUpgradeKymaManager(db.Orchestrations(), db.Operations(), db.Instances(),
+	orchestrateKymaManager := manager.NewUpgradeKymaManager(db.Orchestrations(), db.Operations(), db.Instances(), db.RuntimeStates(),
 		upgradeKymaManager, runtimeResolver, pollingInterval, logs.WithField("upgradeKyma", "orchestration"),
-		cli, &cfg.OrchestrationConfig, notificationBuilder, speedFactor)
-	queue := process.NewQueue(orchestrateKymaManager, logs)
+		cli, &cfg.OrchestrationConfig, limiter, notificationBuilder, speedFactor)
+	queue := newProcessingQueue("upgradeKyma", orchestrateKymaManager, db, cfg.DurableQueue, logs)
 
-	queue.Run(ctx.Done(), 3)
+	queue.RunAfter(workersReady, ctx.Done(), 3)
 
 	return queue
 }
 
-func NewClusterOrchestrationProcessingQueue(ctx context.Context, db storage.BrokerStorage, provisionerClient provisioner.Client,
+func NewClusterOrchestrationProcessingQueue(ctx context.Context, workersReady <-chan struct{}, upgradeClusterManager *upgrade_cluster.Manager, db storage.BrokerStorage, provisionerClient provisioner.Client,
 	pub event.Publisher, inputFactory input.CreatorForPlan, icfg *upgrade_cluster.TimeSchedule, pollingInterval time.Duration,
 	runtimeResolver orchestrationExt.RuntimeResolver, upgradeEvalManager *avs.EvaluationManager, notificationBuilder notification.BundleBuilder, logs logrus.FieldLogger,
-	cli client.Client, cfg Config, speedFactor int) *process.Queue {
+	cli client.Client, limiter *manager.GlobalLimiter, cfg Config, speedFactor int) *process.Queue {
 
-	upgradeClusterManager := upgrade_cluster.NewManager(db.Operations(), pub, logs.WithField("upgradeCluster", "manager"))
 	upgradeClusterInit := upgrade_cluster.NewInitialisationStep(db.Operations(), db.Orchestrations(), provisionerClient, inputFactory, upgradeEvalManager, icfg, notificationBuilder)
 	upgradeClusterManager.InitStep(upgradeClusterInit)
 
@@ -998,10 +1099,84 @@ func NewClusterOrchestrationProcessingQueue(ctx context.Context, db storage.Brok
 
 	orchestrateClusterManager := manager.NewUpgradeClusterManager(db.Orchestrations(), db.Operations(), db.Instances(),
 		upgradeClusterManager, runtimeResolver, pollingInterval, logs.WithField("upgradeCluster", "orchestration"),
-		cli, cfg.OrchestrationConfig, notificationBuilder, speedFactor)
-	queue := process.NewQueue(orchestrateClusterManager, logs)
+		cli, cfg.OrchestrationConfig, limiter, notificationBuilder, speedFactor)
+	queue := newProcessingQueue("upgradeCluster", orchestrateClusterManager, db, cfg.DurableQueue, logs)
+
+	queue.RunAfter(workersReady, ctx.Done(), 3)
+
+	return queue
+}
+
+func NewHibernationProcessingQueue(ctx context.Context, workersReady <-chan struct{}, db storage.BrokerStorage, provisionerClient provisioner.Client,
+	pub event.Publisher, icfg *hibernation.TimeSchedule, workersAmount int, cfg Config, logs logrus.FieldLogger) *process.Queue {
+
+	hibernationManager := hibernation.NewManager(db.Operations(), pub, logs.WithField("hibernation", "manager"))
+	hibernationManager.InitStep(hibernation.NewInitialisationStep(db.Operations(), db.Instances(), icfg))
+
+	hibernationSteps := []struct {
+		weight int
+		step   hibernation.Step
+		opType internal.OperationType
+	}{
+		{
+			weight: 2,
+			step:   hibernation.NewHibernateRuntimeStep(db.Operations(), provisionerClient, icfg),
+			opType: internal.OperationTypeHibernate,
+		},
+		{
+			weight: 2,
+			step:   hibernation.NewWakeUpRuntimeStep(db.Operations(), provisionerClient, icfg),
+			opType: internal.OperationTypeWakeUp,
+		},
+		{
+			weight: 3,
+			step:   hibernation.NewCheckRuntimeOperationStep(db.Operations(), provisionerClient, icfg),
+		},
+	}
+	for _, step := range hibernationSteps {
+		hibernationManager.AddStep(step.weight, step.step, step.opType)
+	}
+
+	queue := newProcessingQueue("hibernation", hibernationManager, db, cfg.DurableQueue, logs)
+	queue.RunAfter(workersReady, ctx.Done(), workersAmount)
+
+	return queue
+}
+
+func NewTaskOrchestrationProcessingQueue(ctx context.Context, workersReady <-chan struct{}, runTaskManager *run_task.Manager, db storage.BrokerStorage, provisionerClient provisioner.Client,
+	pub event.Publisher, icfg *run_task.TimeSchedule, pollingInterval time.Duration, runtimeResolver orchestrationExt.RuntimeResolver,
+	k8sClientProvider func(kcfg string) (client.Client, error), logs logrus.FieldLogger, cli client.Client, limiter *manager.GlobalLimiter, cfg Config, speedFactor int) *process.Queue {
+
+	runTaskInit := run_task.NewInitialisationStep(db.Operations(), db.Orchestrations(), icfg)
+	runTaskManager.InitStep(runTaskInit)
+
+	runTaskSteps := []struct {
+		weight int
+		step   run_task.Step
+	}{
+		{
+			weight: 1,
+			step:   run_task.NewGetKubeconfigStep(db.Operations(), provisionerClient, k8sClientProvider),
+		},
+		{
+			weight: 2,
+			step:   run_task.NewApplyManifestsStep(db.Operations(), icfg),
+		},
+		{
+			weight: 3,
+			step:   run_task.NewRunJobStep(db.Operations(), icfg),
+		},
+	}
+	for _, step := range runTaskSteps {
+		runTaskManager.AddStep(step.weight, step.step)
+	}
+
+	orchestrateTaskManager := manager.NewRunTaskManager(db.Orchestrations(), db.Operations(), db.Instances(),
+		runTaskManager, runtimeResolver, pollingInterval, logs.WithField("runTask", "orchestration"),
+		cli, cfg.OrchestrationConfig, limiter, speedFactor)
+	queue := newProcessingQueue("runTask", orchestrateTaskManager, db, cfg.DurableQueue, logs)
 
-	queue.Run(ctx.Done(), 3)
+	queue.RunAfter(workersReady, ctx.Done(), 3)
 
 	return queue
 }
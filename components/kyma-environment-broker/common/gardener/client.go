@@ -72,6 +72,55 @@ func (b Shoot) GetSpecRegion() string {
 	return str
 }
 
+func (b Shoot) GetSpecKubernetesVersion() string {
+	str, _, err := unstructured.NestedString(b.Unstructured.Object, "spec", "kubernetes", "version")
+	if err != nil {
+		// NOTE this is a safety net, gardener v1beta1 API would need to break the contract for this to panic
+		panic(fmt.Sprintf("Shoot missing field '.spec.kubernetes.version': %v", err))
+	}
+	return str
+}
+
+func (b Shoot) GetSpecProviderType() string {
+	str, _, err := unstructured.NestedString(b.Unstructured.Object, "spec", "provider", "type")
+	if err != nil {
+		// NOTE this is a safety net, gardener v1beta1 API would need to break the contract for this to panic
+		panic(fmt.Sprintf("Shoot missing field '.spec.provider.type': %v", err))
+	}
+	return str
+}
+
+// GetSpecSeedName returns the name of the seed of the shoot, which is empty if the shoot is not scheduled yet
+func (b Shoot) GetSpecSeedName() string {
+	str, _, err := unstructured.NestedString(b.Unstructured.Object, "spec", "seedName")
+	if err != nil {
+		// NOTE this is a safety net, gardener v1beta1 API would need to break the contract for this to panic
+		panic(fmt.Sprintf("Shoot has invalid field '.spec.seedName': %v", err))
+	}
+	return str
+}
+
+// GetSpecMachineTypes returns the machine types of all worker pools of the shoot
+func (b Shoot) GetSpecMachineTypes() []string {
+	workers, _, err := unstructured.NestedSlice(b.Unstructured.Object, "spec", "provider", "workers")
+	if err != nil {
+		// NOTE this is a safety net, gardener v1beta1 API would need to break the contract for this to panic
+		panic(fmt.Sprintf("Shoot missing field '.spec.provider.workers': %v", err))
+	}
+	machineTypes := []string{}
+	for _, w := range workers {
+		worker, ok := w.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		machineType, _, _ := unstructured.NestedString(worker, "machine", "type")
+		if machineType != "" {
+			machineTypes = append(machineTypes, machineType)
+		}
+	}
+	return machineTypes
+}
+
 var SecretBindingResource = schema.GroupVersionResource{Group: "core.gardener.cloud", Version: "v1beta1", Resource: "secretbindings"}
 var ShootResource = schema.GroupVersionResource{Group: "core.gardener.cloud", Version: "v1beta1", Resource: "shoots"}
 
@@ -0,0 +1,118 @@
+package operation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+)
+
+// Client is the interface to interact with the KEB /operations API as an HTTP client using OIDC ID token in JWT format.
+type Client interface {
+	ListSteps(operationID string) (StepsResponse, error)
+	Resume(operationID string, params ResumeParameters) (ResumeResponse, error)
+}
+
+type client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient constructs and returns new Client for KEB /operations API
+// It takes the following arguments:
+//   - ctx  : context in which the http request will be executed
+//   - url  : base url of all KEB APIs, e.g. https://kyma-env-broker.kyma.local
+//   - auth : TokenSource object which provides the ID token for the HTTP request
+func NewClient(ctx context.Context, url string, auth oauth2.TokenSource) Client {
+	return &client{
+		url:        url,
+		httpClient: oauth2.NewClient(ctx, auth),
+	}
+}
+
+// ListSteps fetches the journal of steps of the given operation from KEB
+func (c client) ListSteps(operationID string) (StepsResponse, error) {
+	steps := StepsResponse{}
+	url := fmt.Sprintf("%s/operations/%s/steps", c.url, operationID)
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return steps, errors.Wrapf(err, "while calling %s", url)
+	}
+
+	// Drain response body and close, return error to context if there isn't any.
+	defer func() {
+		derr := drainResponseBody(resp.Body)
+		if err == nil {
+			err = derr
+		}
+		cerr := resp.Body.Close()
+		if err == nil {
+			err = cerr
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return steps, fmt.Errorf("calling %s returned %s status", url, resp.Status)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&steps)
+	if err != nil {
+		return steps, errors.Wrap(err, "while decoding response body")
+	}
+
+	return steps, nil
+}
+
+// Resume sets the failed operation back to in progress, skipping the given steps and stages
+func (c client) Resume(operationID string, params ResumeParameters) (ResumeResponse, error) {
+	resumed := ResumeResponse{}
+	url := fmt.Sprintf("%s/operations/%s/resume", c.url, operationID)
+	body, err := json.Marshal(params)
+	if err != nil {
+		return resumed, errors.Wrap(err, "while marshaling resume parameters")
+	}
+
+	resp, err := c.httpClient.Post(url, "application/json", bytes.NewBuffer(body))
+	if err != nil {
+		return resumed, errors.Wrapf(err, "while calling %s", url)
+	}
+
+	// Drain response body and close, return error to context if there isn't any.
+	defer func() {
+		derr := drainResponseBody(resp.Body)
+		if err == nil {
+			err = derr
+		}
+		cerr := resp.Body.Close()
+		if err == nil {
+			err = cerr
+		}
+	}()
+
+	if resp.StatusCode != http.StatusAccepted {
+		return resumed, fmt.Errorf("calling %s returned %s status", url, resp.Status)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&resumed)
+	if err != nil {
+		return resumed, errors.Wrap(err, "while decoding response body")
+	}
+
+	return resumed, nil
+}
+
+func drainResponseBody(body io.Reader) error {
+	if body == nil {
+		return nil
+	}
+	_, err := io.Copy(ioutil.Discard, io.LimitReader(body, 4096))
+	return err
+}
@@ -0,0 +1,46 @@
+package operation
+
+import (
+	"time"
+)
+
+type StepResult string
+
+const (
+	StepSucceeded StepResult = "succeeded"
+	StepRetry     StepResult = "retry"
+	StepFailed    StepResult = "failed"
+)
+
+// StepDTO is a single run of a step of the operation
+type StepDTO struct {
+	StepName     string        `json:"stepName"`
+	Stage        string        `json:"stage"`
+	StartedAt    time.Time     `json:"startedAt"`
+	FinishedAt   time.Time     `json:"finishedAt"`
+	Duration     time.Duration `json:"duration"`
+	Result       StepResult    `json:"result"`
+	RetryDelay   time.Duration `json:"retryDelay,omitempty"`
+	ErrorReason  string        `json:"errorReason,omitempty"`
+	ErrorMessage string        `json:"errorMessage,omitempty"`
+}
+
+// StepsResponse is the journal of steps of the operation ordered by the start time
+type StepsResponse struct {
+	OperationID string    `json:"operationID"`
+	Data        []StepDTO `json:"data"`
+	Count       int       `json:"count"`
+}
+
+// ResumeParameters holds the steps and stages of the failed operation which are marked as finished when the operation is resumed
+type ResumeParameters struct {
+	SkipSteps  []string `json:"skipSteps,omitempty"`
+	SkipStages []string `json:"skipStages,omitempty"`
+}
+
+type ResumeResponse struct {
+	OperationID string `json:"operationID"`
+	Type        string `json:"type"`
+	State       string `json:"state"`
+	Description string `json:"description"`
+}
@@ -29,8 +29,13 @@ type Client interface {
 	GetOperation(orchestrationID, operationID string) (OperationDetailResponse, error)
 	UpgradeKyma(params Parameters) (UpgradeResponse, error)
 	UpgradeCluster(params Parameters) (UpgradeResponse, error)
+	RunTask(params Parameters) (UpgradeResponse, error)
 	CancelOrchestration(orchestrationID string) error
+	PauseOrchestration(orchestrationID string) error
+	ResumeOrchestration(orchestrationID string) error
 	RetryOrchestration(orchestrationID string, operationIDs []string) (RetryResponse, error)
+	RollbackOrchestration(orchestrationID string, request RollbackRequest) (UpgradeResponse, error)
+	PreviewOrchestration(request PreviewRequest) (PreviewResponse, error)
 }
 
 type client struct {
@@ -271,8 +276,79 @@ func (c client) UpgradeCluster(params Parameters) (UpgradeResponse, error) {
 	return ur, nil
 }
 
-// common func trigger kyma or cluster upgrade
-func (c client) upgradeOperation(uri string, params Parameters) (UpgradeResponse, error) {
+// RunTask creates a new run task orchestration according to the given orchestration parameters, which must contain the task.
+// If successful, the UpgradeResponse returned contains the ID of the newly created orchestration.
+func (c client) RunTask(params Parameters) (UpgradeResponse, error) {
+	uri := "/run/task"
+
+	ur, err := c.upgradeOperation(uri, params)
+	if err != nil {
+		return ur, errors.Wrap(err, "while calling run task operation")
+	}
+
+	return ur, nil
+}
+
+// RollbackOrchestration creates a new Kyma rollback orchestration, which restores the runtimes upgraded by the given orchestration.
+// If successful, the UpgradeResponse returned contains the ID of the newly created orchestration.
+func (c client) RollbackOrchestration(orchestrationID string, request RollbackRequest) (UpgradeResponse, error) {
+	uri := fmt.Sprintf("/orchestrations/%s/rollback", orchestrationID)
+
+	ur, err := c.upgradeOperation(uri, request)
+	if err != nil {
+		return ur, errors.Wrap(err, "while calling kyma rollback operation")
+	}
+
+	return ur, nil
+}
+
+// PreviewOrchestration resolves the runtimes targeted by the orchestration of the given type and parameters, and estimates its schedule.
+// The orchestration is not created.
+func (c client) PreviewOrchestration(request PreviewRequest) (PreviewResponse, error) {
+	pr := PreviewResponse{}
+	blob, err := json.Marshal(request)
+	if err != nil {
+		return pr, errors.Wrap(err, "while converting preview request to JSON")
+	}
+
+	u, err := url.Parse(c.url)
+	if err != nil {
+		return pr, errors.Wrapf(err, "while parsing %s", c.url)
+	}
+	u.Path = path.Join(u.Path, "/orchestrations/preview")
+
+	resp, err := c.httpClient.Post(u.String(), "application/json", bytes.NewBuffer(blob))
+	if err != nil {
+		return pr, errors.Wrapf(err, "while calling %s", u)
+	}
+
+	// Drain response body and close, return error to context if there isn't any.
+	defer func() {
+		derr := drainResponseBody(resp.Body)
+		if err == nil {
+			err = derr
+		}
+		cerr := resp.Body.Close()
+		if err == nil {
+			err = cerr
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return pr, fmt.Errorf("calling %s returned %s status", u, resp.Status)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&pr)
+	if err != nil {
+		return pr, errors.Wrap(err, "while decoding response body")
+	}
+
+	return pr, nil
+}
+
+// common func trigger kyma or cluster upgrade, or kyma rollback
+func (c client) upgradeOperation(uri string, params interface{}) (UpgradeResponse, error) {
 	ur := UpgradeResponse{}
 	blob, err := json.Marshal(params)
 	if err != nil {
@@ -361,11 +437,23 @@ func (c client) RetryOrchestration(orchestrationID string, operationIDs []string
 }
 
 func (c client) CancelOrchestration(orchestrationID string) error {
-	url := fmt.Sprintf("%s/orchestrations/%s/cancel", c.url, orchestrationID)
+	return c.putOrchestrationAction(orchestrationID, "cancel")
+}
+
+func (c client) PauseOrchestration(orchestrationID string) error {
+	return c.putOrchestrationAction(orchestrationID, "pause")
+}
+
+func (c client) ResumeOrchestration(orchestrationID string) error {
+	return c.putOrchestrationAction(orchestrationID, "resume")
+}
+
+func (c client) putOrchestrationAction(orchestrationID, action string) error {
+	url := fmt.Sprintf("%s/orchestrations/%s/%s", c.url, orchestrationID, action)
 
 	req, err := http.NewRequest(http.MethodPut, url, nil)
 	if err != nil {
-		return errors.Wrap(err, "while creating cancel request")
+		return errors.Wrapf(err, "while creating %s request", action)
 	}
 
 	resp, err := c.httpClient.Do(req)
@@ -228,6 +228,122 @@ func TestClient_UpgradeKyma(t *testing.T) {
 	})
 }
 
+func TestClient_RunTask(t *testing.T) {
+	// given
+	called := 0
+	params := Parameters{
+		Targets: TargetSpec{
+			Include: []RuntimeTarget{
+				{
+					Target: TargetAll,
+				},
+			},
+		},
+		Strategy: StrategySpec{
+			Type:     ParallelStrategy,
+			Schedule: Immediate,
+		},
+		Task: &TaskParameters{
+			Job:     "apiVersion: batch/v1\nkind: Job\n",
+			Timeout: "30m",
+		},
+	}
+	orchestrationID := orch1.OrchestrationID
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called++
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/run/task", r.URL.Path)
+		reqBody := Parameters{}
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
+		require.NoError(t, err)
+		assert.Equal(t, params, reqBody)
+
+		err = respondUpgrade(w, orchestrationID)
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+	client := NewClient(context.TODO(), ts.URL, fixToken)
+
+	// when
+	ur, err := client.RunTask(params)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 1, called)
+	assert.Equal(t, orchestrationID, ur.OrchestrationID)
+}
+
+func TestClient_RollbackOrchestration(t *testing.T) {
+	// given
+	called := 0
+	request := RollbackRequest{
+		Strategy: StrategySpec{
+			Type:     ParallelStrategy,
+			Schedule: Immediate,
+		},
+	}
+	rollbackID := orch2.OrchestrationID
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called++
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, fmt.Sprintf("/orchestrations/%s/rollback", orch1.OrchestrationID), r.URL.Path)
+		reqBody := RollbackRequest{}
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
+		require.NoError(t, err)
+		assert.Equal(t, request, reqBody)
+
+		err = respondUpgrade(w, rollbackID)
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+	client := NewClient(context.TODO(), ts.URL, fixToken)
+
+	// when
+	ur, err := client.RollbackOrchestration(orch1.OrchestrationID, request)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 1, called)
+	assert.Equal(t, rollbackID, ur.OrchestrationID)
+}
+
+func TestClient_PreviewOrchestration(t *testing.T) {
+	// given
+	called := 0
+	request := PreviewRequest{
+		Type: UpgradeKymaOrchestration,
+		Parameters: Parameters{
+			Targets: TargetSpec{
+				Include: []RuntimeTarget{{Target: TargetAll}},
+			},
+		},
+		OperationDuration: "30m",
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called++
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/orchestrations/preview", r.URL.Path)
+		reqBody := PreviewRequest{}
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
+		require.NoError(t, err)
+		assert.Equal(t, request, reqBody)
+
+		err = json.NewEncoder(w).Encode(PreviewResponse{Count: 2, OperationDuration: "30m0s"})
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+	client := NewClient(context.TODO(), ts.URL, fixToken)
+
+	// when
+	pr, err := client.PreviewOrchestration(request)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 1, called)
+	assert.Equal(t, 2, pr.Count)
+	assert.Equal(t, "30m0s", pr.OperationDuration)
+}
+
 func TestClient_CancelOrchestration(t *testing.T) {
 	t.Run("test_URL__NoError_path", func(t *testing.T) {
 		// given
@@ -253,6 +369,35 @@ func TestClient_CancelOrchestration(t *testing.T) {
 	})
 }
 
+func TestClient_PauseResumeOrchestration(t *testing.T) {
+	for action, call := range map[string]func(c Client, id string) error{
+		"pause":  Client.PauseOrchestration,
+		"resume": Client.ResumeOrchestration,
+	} {
+		t.Run(action, func(t *testing.T) {
+			// given
+			called := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called++
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, fmt.Sprintf("/orchestrations/%s/%s", orch1.OrchestrationID, action), r.URL.Path)
+
+				err := respondStatus(w, orch1)
+				require.NoError(t, err)
+			}))
+			defer ts.Close()
+			client := NewClient(context.TODO(), ts.URL, fixToken)
+
+			// when
+			err := call(client, orch1.OrchestrationID)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, 1, called)
+		})
+	}
+}
+
 func TestClient_RetryOrchestration(t *testing.T) {
 	t.Run("test_URL_NoError_path", func(t *testing.T) {
 		// given
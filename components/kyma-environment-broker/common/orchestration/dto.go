@@ -14,6 +14,10 @@ type Parameters struct {
 	Kubernetes *KubernetesParameters `json:"kubernetes,omitempty"`
 	// upgrade kyma specific parameters
 	Kyma *KymaParameters `json:"kyma,omitempty"`
+	// rollback kyma specific parameters
+	Rollback *RollbackParameters `json:"rollback,omitempty"`
+	// run task specific parameters
+	Task *TaskParameters `json:"task,omitempty"`
 	//customer notification status
 	NotificationState notificationStateType `json:"notificationstate,omitempty"`
 }
@@ -29,6 +33,29 @@ type KymaParameters struct {
 	Version string `json:"version,omitempty"`
 }
 
+// RollbackParameters hold the attributes of kyma rollback orchestrations.
+type RollbackParameters struct {
+	// OrchestrationID is the ID of the upgrade kyma orchestration which is rolled back
+	OrchestrationID string `json:"orchestrationID"`
+}
+
+// TaskParameters hold the attributes of run task orchestrations.
+// The manifests are applied in every runtime, then the Job is created and the operation finishes together with the Job.
+type TaskParameters struct {
+	// Manifests are Kubernetes objects in YAML or JSON, created or updated in the runtime in the given order
+	Manifests []string `json:"manifests,omitempty"`
+	// Job is a batch/v1 Job in YAML or JSON, created in the runtime after the manifests are applied
+	Job string `json:"job,omitempty"`
+	// Timeout is the maximal time of waiting for the Job to finish, for example "30m"
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// RollbackRequest is the body of the request which rolls back the orchestration, all fields are optional
+type RollbackRequest struct {
+	Strategy StrategySpec `json:"strategy,omitempty"`
+	DryRun   bool         `json:"dryRun,omitempty"`
+}
+
 const (
 	// StateParam parameter used in list orchestrations / operations queries to filter by state
 	StateParam = "state"
@@ -40,6 +67,7 @@ const (
 	InProgress = "in progress"
 	Canceling  = "canceling"
 	Retrying   = "retrying" // to signal a retry sign before marking it to pending
+	Paused     = "paused"   // pending operations are not scheduled until the orchestration is resumed
 	Canceled   = "canceled"
 	Succeeded  = "succeeded"
 	Failed     = "failed"
@@ -74,6 +102,20 @@ type RuntimeTarget struct {
 	Shoot string `json:"shoot,omitempty"`
 	// InstanceID is used to identify an instance by it's instance ID
 	InstanceID string `json:"instanceID,omitempty"`
+	// Semantic version constraint to match against the runtime's current Kyma version. E.g. ">=1.24.0, <2.0.0"
+	KymaVersion string `json:"kymaVersion,omitempty"`
+	// Semantic version constraint to match against the shoot cluster's Kubernetes version. E.g. "<1.22"
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	// Provider is used to match runtimes by the shoot cluster's provider type. E.g. "aws", "azure", "gcp", "openstack"
+	Provider string `json:"provider,omitempty"`
+	// MachineType is used to match runtimes with a worker pool of the given machine type. E.g. "m5.2xlarge"
+	MachineType string `json:"machineType,omitempty"`
+	// CreatedAfter is used to match runtimes whose instance was created after the given time
+	CreatedAfter *time.Time `json:"createdAfter,omitempty"`
+	// CreatedBefore is used to match runtimes whose instance was created before the given time
+	CreatedBefore *time.Time `json:"createdBefore,omitempty"`
+	// ShootLabels is used to match runtimes whose shoot cluster has all the given labels
+	ShootLabels map[string]string `json:"shootLabels,omitempty"`
 }
 
 type Type string
@@ -81,12 +123,15 @@ type Type string
 const (
 	UpgradeKymaOrchestration    Type = "upgradeKyma"
 	UpgradeClusterOrchestration Type = "upgradeCluster"
+	RollbackKymaOrchestration   Type = "rollbackKyma"
+	RunTaskOrchestration        Type = "runTask"
 )
 
 type StrategyType string
 
 const (
 	ParallelStrategy StrategyType = "parallel"
+	WavesStrategy    StrategyType = "waves"
 )
 
 type ScheduleType string
@@ -101,11 +146,32 @@ type ParallelStrategySpec struct {
 	Workers int `json:"workers"`
 }
 
+// WavesStrategySpec defines parameters for the waves orchestration strategy, which starts with a canary wave
+// and processes the rest of operations in growing waves. Operations of a wave are processed by Parallel.Workers workers.
+type WavesStrategySpec struct {
+	// Canary is the size of the first wave, a number of runtimes (e.g. "5") or a percentage of all runtimes (e.g. "10%")
+	Canary string `json:"canary,omitempty"`
+	// Factor is the multiplier of the size of every next wave
+	Factor int `json:"factor,omitempty"`
+	// SoakTime is the time to wait after a wave before the next wave starts, e.g. "30m"
+	SoakTime string `json:"soakTime,omitempty"`
+	// SuccessThreshold is the minimal percentage of succeeded operations of a wave required to start the next wave.
+	// When it is not set, all operations of a wave must succeed. 0 starts the next wave regardless of the results.
+	SuccessThreshold *int `json:"successThreshold,omitempty"`
+}
+
 // StrategySpec is the strategy part common for all orchestration trigger/status API
 type StrategySpec struct {
 	Type     StrategyType         `json:"type"`
 	Schedule ScheduleType         `json:"schedule,omitempty"`
 	Parallel ParallelStrategySpec `json:"parallel,omitempty"`
+	Waves    WavesStrategySpec    `json:"waves,omitempty"`
+	// StartAt is the time before which the orchestration does not resolve the targets and does not start operations
+	StartAt *time.Time `json:"startAt,omitempty"`
+	// MaxFailures is the number of failed operations above which the orchestration is paused, 0 means no limit
+	MaxFailures int `json:"maxFailures,omitempty"`
+	// MaxFailureRatio is the ratio of failed operations to all operations of the orchestration above which the orchestration is paused, e.g. 0.1
+	MaxFailureRatio float64 `json:"maxFailureRatio,omitempty"`
 }
 
 // TargetSpec is the targets part common for all orchestration trigger/status API
@@ -172,6 +238,38 @@ type RetryResponse struct {
 	Msg               string   `json:"msg"`
 }
 
+// PreviewRequest holds the orchestration type and parameters which are previewed without creating the orchestration
+type PreviewRequest struct {
+	Type       Type       `json:"type"`
+	Parameters Parameters `json:"parameters"`
+	// OperationDuration is the estimated duration of a single runtime operation, e.g. "45m"
+	OperationDuration string `json:"operationDuration,omitempty"`
+}
+
+// PreviewResponse holds the runtimes targeted by the previewed orchestration and its estimated schedule
+type PreviewResponse struct {
+	Runtimes          []PreviewRuntime   `json:"runtimes"`
+	Count             int                `json:"count"`
+	Breakdown         []PreviewBreakdown `json:"breakdown"`
+	OperationDuration string             `json:"operationDuration"`
+	EstimatedStart    time.Time          `json:"estimatedStart"`
+	EstimatedEnd      time.Time          `json:"estimatedEnd"`
+}
+
+// PreviewRuntime is the runtime targeted by the previewed orchestration with the estimated time of its operation
+type PreviewRuntime struct {
+	Runtime
+	EstimatedStart time.Time `json:"estimatedStart"`
+	EstimatedEnd   time.Time `json:"estimatedEnd"`
+}
+
+// PreviewBreakdown is the number of runtimes targeted by the previewed orchestration in the plan and region
+type PreviewBreakdown struct {
+	Plan   string `json:"plan"`
+	Region string `json:"region"`
+	Count  int    `json:"count"`
+}
+
 type MaintenancePolicyMatch struct {
 	GlobalAccountID string `json:"globalAccountID"`
 	Plan            string `json:"plan"`
@@ -194,6 +292,63 @@ type MaintenancePolicy struct {
 	Default MaintenancePolicyEntry  `json:"default"`
 }
 
+// BlackoutPeriod is a time period, e.g. holidays or a freeze week, during which no orchestration operations are started
+type BlackoutPeriod struct {
+	Name  string    `json:"name"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+type BlackoutCalendar struct {
+	Periods []BlackoutPeriod `json:"periods"`
+}
+
+// BlackoutEnd returns the end of the blackout period which covers the given time, periods which overlap or adjoin are merged
+func (c BlackoutCalendar) BlackoutEnd(t time.Time) (time.Time, bool) {
+	end, found := t, false
+	for extended := true; extended; {
+		extended = false
+		for _, p := range c.Periods {
+			if !end.Before(p.Start) && end.Before(p.End) {
+				end, found, extended = p.End, true, true
+			}
+		}
+	}
+	return end, found
+}
+
+// ConflictPolicy defines what happens with the operation for a runtime which is processed by another orchestration
+type ConflictPolicy string
+
+const (
+	// QueueConflict postpones the operation until the other operation of the runtime finishes
+	QueueConflict ConflictPolicy = "queue"
+	// RejectConflict cancels the operation
+	RejectConflict ConflictPolicy = "reject"
+)
+
+// ConcurrencyLimits caps the number of operations in progress at the same time across all orchestrations.
+// The limits are defined per provider type, region, and Gardener seed, the "*" key applies to all values which are not listed.
+// Zero or missing limit means no limit.
+type ConcurrencyLimits struct {
+	Total           int            `json:"total,omitempty"`
+	Provider        map[string]int `json:"provider,omitempty"`
+	Region          map[string]int `json:"region,omitempty"`
+	Seed            map[string]int `json:"seed,omitempty"`
+	RuntimeConflict ConflictPolicy `json:"runtimeConflict,omitempty"`
+}
+
+// AnyLimitKey is the key of the limit which applies to all providers, regions, or seeds without their own limit
+const AnyLimitKey = "*"
+
+// LimitFor returns the limit for the given provider, region or seed, zero means no limit
+func LimitFor(limits map[string]int, key string) int {
+	if limit, ok := limits[key]; ok {
+		return limit
+	}
+	return limits[AnyLimitKey]
+}
+
 type notificationStateType string
 
 const (
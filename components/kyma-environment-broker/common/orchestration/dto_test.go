@@ -0,0 +1,53 @@
+package orchestration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlackoutCalendar_BlackoutEnd(t *testing.T) {
+	// given
+	day := time.Date(2026, time.December, 24, 0, 0, 0, 0, time.UTC)
+	calendar := BlackoutCalendar{Periods: []BlackoutPeriod{
+		{Name: "christmas", Start: day, End: day.AddDate(0, 0, 3)},
+		{Name: "freeze", Start: day.AddDate(0, 0, 2), End: day.AddDate(0, 0, 10)},
+		{Name: "new year", Start: day.AddDate(0, 0, 10), End: day.AddDate(0, 0, 12)},
+		{Name: "audit", Start: day.AddDate(0, 1, 0), End: day.AddDate(0, 1, 1)},
+	}}
+
+	for name, tc := range map[string]struct {
+		time        time.Time
+		expectedEnd time.Time
+		found       bool
+	}{
+		"before all periods": {
+			time:        day.Add(-time.Hour),
+			expectedEnd: day.Add(-time.Hour),
+		},
+		"overlapping and adjoining periods": {
+			time:        day.Add(time.Hour),
+			expectedEnd: day.AddDate(0, 0, 12),
+			found:       true,
+		},
+		"single period": {
+			time:        day.AddDate(0, 1, 0),
+			expectedEnd: day.AddDate(0, 1, 1),
+			found:       true,
+		},
+		"end of period": {
+			time:        day.AddDate(0, 1, 1),
+			expectedEnd: day.AddDate(0, 1, 1),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			end, found := calendar.BlackoutEnd(tc.time)
+
+			// then
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.expectedEnd, end)
+		})
+	}
+}
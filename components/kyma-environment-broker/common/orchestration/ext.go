@@ -2,6 +2,16 @@ package orchestration
 
 import (
 	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrExecutionFinished is returned by Insert when the execution processed all its operations and takes no more of them,
+	// the operations can be processed by a new execution
+	ErrExecutionFinished = errors.New("execution is finished")
+	// ErrExecutionHalted is returned by Insert when the execution was halted or canceled and takes no more operations
+	ErrExecutionHalted = errors.New("execution is halted")
 )
 
 // Runtime is the data type which captures the needed runtime specific attributes to perform orchestrations on a given runtime.
@@ -19,6 +29,12 @@ type Runtime struct {
 	MaintenanceDays      []string  `json:"maintenanceDays"`
 	Plan                 string    `json:"plan"`
 	Region               string    `json:"region"`
+	// The corresponding shoot cluster's .spec.provider.type and .spec.seedName values
+	Provider string `json:"provider,omitempty"`
+	Seed     string `json:"seed,omitempty"`
+	// The Kyma and Kubernetes versions of the runtime at the time the targets were resolved
+	KymaVersion       string `json:"kymaVersion,omitempty"`
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
 }
 
 // RuntimeOperation holds information about operation performed on a runtime
@@ -50,12 +66,48 @@ type Strategy interface {
 	Wait(executionID string)
 	// Cancel shutdowns a given execution.
 	Cancel(executionID string)
-	// Insert operations into the delaying queue of a given execution ID
+	// Insert operations into the delaying queue of a given execution ID.
+	// It returns ErrExecutionFinished or ErrExecutionHalted when the execution does not take more operations.
 	Insert(execID string, operations []RuntimeOperation, strategySpec StrategySpec) error
 	// SpeedUp makes the retries speedFactor times faster, used for unit testing
 	SpeedUp(speedFactor int)
 }
 
+// HaltingStrategy is implemented by strategies which can stop scheduling operations before all of them are processed.
+type HaltingStrategy interface {
+	// Halted returns the reason why the execution stopped scheduling operations, or an empty string if it did not stop.
+	Halted(executionID string) string
+}
+
+// OperationStateGetter returns states of operations, it is used by strategies which depend on results of processed operations.
+type OperationStateGetter interface {
+	GetOperationStates(operationIDs []string) (map[string]string, error)
+}
+
+// BlackoutCalendarGetter returns the global blackout calendar, it is read whenever an operation is about to start, so changes apply to running orchestrations.
+type BlackoutCalendarGetter interface {
+	GetBlackoutCalendar() (BlackoutCalendar, error)
+}
+
+// LimitDecision tells the strategy whether the operation can start
+type LimitDecision string
+
+const (
+	// LimitAcquired means that the operation can start
+	LimitAcquired LimitDecision = "acquired"
+	// LimitThrottled means that the operation must wait, because a concurrency limit is reached or the runtime is processed by another operation
+	LimitThrottled LimitDecision = "throttled"
+	// LimitRejected means that the operation was rejected, because the runtime is processed by another orchestration, and it is finished
+	LimitRejected LimitDecision = "rejected"
+)
+
+// ConcurrencyLimiter limits the operations in progress at the same time across all orchestrations.
+// Every acquired operation must be released after it is processed.
+type ConcurrencyLimiter interface {
+	Acquire(op RuntimeOperation) LimitDecision
+	Release(op RuntimeOperation)
+}
+
 func ConvertSliceOfDaysToMap(days []string) map[time.Weekday]bool {
 	m := make(map[time.Weekday]bool)
 	for _, day := range days {
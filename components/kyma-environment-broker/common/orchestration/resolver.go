@@ -3,9 +3,11 @@ package orchestration
 import (
 	"context"
 	"regexp"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/Masterminds/semver"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
 	"github.com/pkg/errors"
@@ -133,6 +135,10 @@ func (resolver *GardenerRuntimeResolver) getRuntime(runtimeID string) (runtime.R
 
 func (resolver *GardenerRuntimeResolver) resolveRuntimeTarget(rt RuntimeTarget, shoots []unstructured.Unstructured) ([]Runtime, error) {
 	runtimes := []Runtime{}
+	kymaVersion, kubernetesVersion, err := parseVersionConstraints(rt)
+	if err != nil {
+		return nil, err
+	}
 	// Iterate over all shoots. Evaluate target specs. If multiple are specified, all must match for a given shoot.
 	for _, s := range shoots {
 		shoot := &gardener.Shoot{s}
@@ -167,11 +173,8 @@ func (resolver *GardenerRuntimeResolver) resolveRuntimeTarget(rt RuntimeTarget,
 			continue
 		}
 
-		// Match exact shoot by runtimeID
-		if rt.RuntimeID != "" {
-			if rt.RuntimeID == runtimeID {
-				runtimes = append(runtimes, resolver.runtimeFromDTO(r, shoot.GetName(), maintenanceWindowBegin, maintenanceWindowEnd))
-			}
+		// Match exact shoot by runtimeID, other selectors of the target must match as well
+		if rt.RuntimeID != "" && rt.RuntimeID != runtimeID {
 			continue
 		}
 
@@ -218,18 +221,105 @@ func (resolver *GardenerRuntimeResolver) resolveRuntimeTarget(rt RuntimeTarget,
 			}
 		}
 
+		// Perform match against KymaVersion constraint
+		if kymaVersion != nil && !matchVersion(kymaVersion, r.KymaVersion) {
+			continue
+		}
+
+		// Perform match against KubernetesVersion constraint
+		if kubernetesVersion != nil && !matchVersion(kubernetesVersion, shoot.GetSpecKubernetesVersion()) {
+			continue
+		}
+
+		// Perform match against a specific Provider
+		if rt.Provider != "" && !strings.EqualFold(rt.Provider, shoot.GetSpecProviderType()) {
+			continue
+		}
+
+		// Perform match against worker pools with a specific MachineType
+		if rt.MachineType != "" && !containsString(shoot.GetSpecMachineTypes(), rt.MachineType) {
+			continue
+		}
+
+		// Perform match against the instance creation time
+		if rt.CreatedAfter != nil && !r.Status.CreatedAt.After(*rt.CreatedAfter) {
+			continue
+		}
+		if rt.CreatedBefore != nil && !r.Status.CreatedAt.Before(*rt.CreatedBefore) {
+			continue
+		}
+
+		// Perform match against shoot labels
+		if !matchLabels(rt.ShootLabels, shoot.GetLabels()) {
+			continue
+		}
+
 		// Check if target: all is specified
 		if rt.Target != "" && rt.Target != TargetAll {
 			continue
 		}
 
-		runtimes = append(runtimes, resolver.runtimeFromDTO(r, shoot.GetName(), maintenanceWindowBegin, maintenanceWindowEnd))
+		runtimes = append(runtimes, resolver.runtimeFromDTO(r, shoot, maintenanceWindowBegin, maintenanceWindowEnd))
 	}
 
 	return runtimes, nil
 }
 
-func (*GardenerRuntimeResolver) runtimeFromDTO(runtime runtime.RuntimeDTO, shootName string, windowBegin, windowEnd time.Time) Runtime {
+// ValidateRuntimeTarget checks if the version constraints and the creation time range of the runtime target are valid
+func ValidateRuntimeTarget(rt RuntimeTarget) error {
+	if _, _, err := parseVersionConstraints(rt); err != nil {
+		return err
+	}
+	if rt.CreatedAfter != nil && rt.CreatedBefore != nil && !rt.CreatedAfter.Before(*rt.CreatedBefore) {
+		return errors.New("createdAfter must be before createdBefore")
+	}
+	return nil
+}
+
+func parseVersionConstraints(rt RuntimeTarget) (kymaVersion, kubernetesVersion *semver.Constraints, err error) {
+	if rt.KymaVersion != "" {
+		kymaVersion, err = semver.NewConstraint(rt.KymaVersion)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "while parsing kymaVersion constraint %s", rt.KymaVersion)
+		}
+	}
+	if rt.KubernetesVersion != "" {
+		kubernetesVersion, err = semver.NewConstraint(rt.KubernetesVersion)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "while parsing kubernetesVersion constraint %s", rt.KubernetesVersion)
+		}
+	}
+	return kymaVersion, kubernetesVersion, nil
+}
+
+// matchVersion returns false for the versions which are not semantic, e.g. PR-123 or main-00e83e99
+func matchVersion(constraint *semver.Constraints, version string) bool {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	return constraint.Check(v)
+}
+
+func matchLabels(selector, labels map[string]string) bool {
+	for key, value := range selector {
+		if actual, ok := labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (*GardenerRuntimeResolver) runtimeFromDTO(runtime runtime.RuntimeDTO, shoot *gardener.Shoot, windowBegin, windowEnd time.Time) Runtime {
 	return Runtime{
 		InstanceID:             runtime.InstanceID,
 		RuntimeID:              runtime.RuntimeID,
@@ -237,9 +327,13 @@ func (*GardenerRuntimeResolver) runtimeFromDTO(runtime runtime.RuntimeDTO, shoot
 		SubAccountID:           runtime.SubAccountID,
 		Plan:                   runtime.ServicePlanName,
 		Region:                 runtime.ProviderRegion,
-		ShootName:              shootName,
+		Provider:               shoot.GetSpecProviderType(),
+		Seed:                   shoot.GetSpecSeedName(),
+		ShootName:              shoot.GetName(),
 		MaintenanceWindowBegin: windowBegin,
 		MaintenanceWindowEnd:   windowEnd,
 		MaintenanceDays:        []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"},
+		KymaVersion:            runtime.KymaVersion,
+		KubernetesVersion:      shoot.GetSpecKubernetesVersion(),
 	}
 }
@@ -130,6 +130,17 @@ func TestResolver_Resolve(t *testing.T) {
 			},
 			ExpectedRuntimes: []expectedRuntime{expectedRuntime1},
 		},
+		"IncludeRuntimeWithOtherKymaVersion": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						RuntimeID:   "runtime-id-1",
+						KymaVersion: ">=2.2.0",
+					},
+				},
+				Exclude: nil,
+			},
+		},
 		"IncludeInstance": {
 			Target: TargetSpec{
 				Include: []RuntimeTarget{
@@ -185,6 +196,88 @@ func TestResolver_Resolve(t *testing.T) {
 			},
 			ExpectedRuntimes: []expectedRuntime{expectedRuntime1},
 		},
+		"IncludeKymaVersion": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						KymaVersion: ">=2.2.0, <2.10.0",
+					},
+				},
+				Exclude: nil,
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime2, expectedRuntime3},
+		},
+		"IncludeKubernetesVersion": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						KubernetesVersion: "<1.23",
+					},
+				},
+				Exclude: nil,
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime1, expectedRuntime2},
+		},
+		"IncludeProvider": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						Provider: "Azure",
+					},
+				},
+				Exclude: nil,
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime2, expectedRuntime10},
+		},
+		"IncludeMachineType": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						MachineType: "machine-1",
+					},
+				},
+				Exclude: nil,
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime1, expectedRuntime10},
+		},
+		"IncludeCreatedBetween": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						CreatedAfter:  timePtr(runtimeCreatedAt.Add(36 * time.Hour)),
+						CreatedBefore: timePtr(runtimeCreatedAt.Add(120 * time.Hour)),
+					},
+				},
+				Exclude: nil,
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime2, expectedRuntime3},
+		},
+		"IncludeShootLabels": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						ShootLabels: map[string]string{"canary": "true"},
+					},
+				},
+				Exclude: nil,
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime3},
+		},
+		"IncludeAllExcludeKymaVersion": {
+			Target: TargetSpec{
+				Include: []RuntimeTarget{
+					{
+						Target: TargetAll,
+					},
+				},
+				Exclude: []RuntimeTarget{
+					{
+						KymaVersion: "<2.3.0",
+					},
+				},
+			},
+			ExpectedRuntimes: []expectedRuntime{expectedRuntime3, expectedRuntime10},
+		},
 	} {
 		t.Run(tn, func(t *testing.T) {
 			// when
@@ -202,6 +295,60 @@ func TestResolver_Resolve(t *testing.T) {
 	}
 }
 
+func TestResolver_Resolve_InvalidVersionConstraint(t *testing.T) {
+	// given
+	client := newFakeGardenerClient()
+	lister := newRuntimeListerMock()
+	logger := newLogDummy()
+	resolver := NewGardenerRuntimeResolver(client, shootNamespace, lister, logger)
+
+	// when
+	runtimes, err := resolver.Resolve(TargetSpec{
+		Include: []RuntimeTarget{
+			{
+				KymaVersion: "not-a-version",
+			},
+		},
+	})
+
+	// then
+	assert.Error(t, err)
+	assert.Len(t, runtimes, 0)
+}
+
+func TestValidateRuntimeTarget(t *testing.T) {
+	for tn, tc := range map[string]struct {
+		Target RuntimeTarget
+		Valid  bool
+	}{
+		"Valid": {
+			Target: RuntimeTarget{KymaVersion: ">=2.0", KubernetesVersion: "~1.22", CreatedAfter: timePtr(runtimeCreatedAt), CreatedBefore: timePtr(runtimeCreatedAt.Add(time.Hour))},
+			Valid:  true,
+		},
+		"InvalidKymaVersion": {
+			Target: RuntimeTarget{KymaVersion: "main-00e83e99"},
+		},
+		"InvalidKubernetesVersion": {
+			Target: RuntimeTarget{KubernetesVersion: ">>1.22"},
+		},
+		"InvalidCreationRange": {
+			Target: RuntimeTarget{CreatedAfter: timePtr(runtimeCreatedAt), CreatedBefore: timePtr(runtimeCreatedAt)},
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			// when
+			err := ValidateRuntimeTarget(tc.Target)
+
+			// then
+			if tc.Valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestResolver_Resolve_GardenerFailure(t *testing.T) {
 	// given
 	fake := k8stesting.Fake{}
@@ -257,6 +404,8 @@ func TestResolver_Resolve_StorageFailure(t *testing.T) {
 	assert.Len(t, runtimes, 0)
 }
 
+var runtimeCreatedAt = time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+
 var (
 	shoot1 = fixShoot(1, globalAccountID1, region1)
 	shoot2 = fixShoot(2, globalAccountID1, region2)
@@ -284,6 +433,18 @@ var (
 )
 
 func fixShoot(id int, globalAccountID, region string) unstructured.Unstructured {
+	providerType := "gcp"
+	if id%2 == 0 {
+		providerType = "azure"
+	}
+	labels := map[string]interface{}{
+		globalAccountLabel: globalAccountID,
+		subAccountLabel:    fmt.Sprintf("subaccount-id-%d", id),
+	}
+	if id == 3 {
+		labels["canary"] = "true"
+	}
+
 	return unstructured.Unstructured{
 		Object: map[string]interface{}{
 			"apiVersion": "core.gardener.cloud/v1beta1",
@@ -291,16 +452,27 @@ func fixShoot(id int, globalAccountID, region string) unstructured.Unstructured
 			"metadata": map[string]interface{}{
 				"name":      fmt.Sprintf("shoot%d", id),
 				"namespace": shootNamespace,
-				"labels": map[string]interface{}{
-					globalAccountLabel: globalAccountID,
-					subAccountLabel:    fmt.Sprintf("subaccount-id-%d", id),
-				},
+				"labels":    labels,
 				"annotations": map[string]interface{}{
 					runtimeIDAnnotation: fmt.Sprintf("runtime-id-%d", id),
 				},
 			},
 			"spec": map[string]interface{}{
 				"region": region,
+				"kubernetes": map[string]interface{}{
+					"version": fmt.Sprintf("1.%d.0", 20+id),
+				},
+				"provider": map[string]interface{}{
+					"type": providerType,
+					"workers": []interface{}{
+						map[string]interface{}{
+							"name": "cpu-worker-0",
+							"machine": map[string]interface{}{
+								"type": fmt.Sprintf("machine-%d", id%3),
+							},
+						},
+					},
+				},
 				"maintenance": map[string]interface{}{
 					"timeWindow": map[string]interface{}{
 						"begin": "030000+0000",
@@ -326,7 +498,9 @@ func fixRuntimeDTO(id int, globalAccountID, planName string, state runtimeOpStat
 		GlobalAccountID: globalAccountID,
 		SubAccountID:    fmt.Sprintf("subaccount-id-%d", id),
 		ServicePlanName: planName,
+		KymaVersion:     fmt.Sprintf("2.%d.0", id),
 		Status: runtime.RuntimeStatus{
+			CreatedAt: runtimeCreatedAt.Add(time.Duration(id) * 24 * time.Hour),
 			Provisioning: &runtime.Operation{
 				State:     state.provision,
 				CreatedAt: time.Now(),
@@ -444,3 +618,7 @@ func assertRuntimeTargets(t *testing.T, expectedRuntimes []expectedRuntime, runt
 		assert.Equal(t, s.GetSpecMaintenanceTimeWindowEnd(), r.MaintenanceWindowEnd.Format(maintenanceWindowFormat))
 	}
 }
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
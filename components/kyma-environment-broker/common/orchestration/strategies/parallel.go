@@ -13,8 +13,13 @@ import (
 	"k8s.io/client-go/util/workqueue"
 )
 
+// throttledOperationDelay is the time after which the strategy tries to start the operation throttled by the concurrency limiter again
+const throttledOperationDelay = 30 * time.Second
+
 type ParallelOrchestrationStrategy struct {
 	executor        orchestration.OperationExecutor
+	blackouts       orchestration.BlackoutCalendarGetter
+	limiter         orchestration.ConcurrencyLimiter
 	dq              map[string]workqueue.DelayingInterface // scheduling queue, delaying queue for all pending & in progress ops
 	pq              map[string]workqueue.DelayingInterface // processing queue, delaying queue for the in progress ops
 	wg              map[string]*sync.WaitGroup
@@ -27,9 +32,13 @@ type ParallelOrchestrationStrategy struct {
 
 // NewParallelOrchestrationStrategy returns a new parallel orchestration strategy, which
 // executes operations in parallel using a pool of workers and a delaying queue to support time-based scheduling.
-func NewParallelOrchestrationStrategy(executor orchestration.OperationExecutor, log logrus.FieldLogger, rescheduleDelay time.Duration) orchestration.Strategy {
+// Operations are not started during the periods of the blackout calendar, the blackouts can be nil.
+// Operations are started only if the concurrency limiter allows it, the limiter can be nil.
+func NewParallelOrchestrationStrategy(executor orchestration.OperationExecutor, blackouts orchestration.BlackoutCalendarGetter, limiter orchestration.ConcurrencyLimiter, log logrus.FieldLogger, rescheduleDelay time.Duration) orchestration.Strategy {
 	strategy := &ParallelOrchestrationStrategy{
 		executor:        executor,
+		blackouts:       blackouts,
+		limiter:         limiter,
 		dq:              map[string]workqueue.DelayingInterface{},
 		pq:              map[string]workqueue.DelayingInterface{},
 		wg:              map[string]*sync.WaitGroup{},
@@ -142,10 +151,22 @@ func (p *ParallelOrchestrationStrategy) scheduleOperationsLoop(execID string, st
 
 		log := p.log.WithField("operationID", op.ID)
 		if duration <= 0 {
-			log.Infof("operation is scheduled now")
-
-			pq.Add(item)
-			p.processOperation(execID)
+			switch p.acquire(op) {
+			case orchestration.LimitThrottled:
+				log.Infof("operation is throttled by the concurrency limits")
+				dq.AddAfter(item, time.Duration(int64(throttledOperationDelay)/int64(p.speedFactor)))
+				dq.Done(item)
+				continue
+			case orchestration.LimitRejected:
+				log.Infof("operation is rejected by the concurrency limiter")
+				dq.Done(item)
+			default:
+				log.Infof("operation is scheduled now")
+
+				pq.Add(item)
+				p.processOperation(execID)
+				p.release(op)
+			}
 
 			p.mux.Lock()
 			p.scheduleNum[execID]--
@@ -200,6 +221,19 @@ func (p *ParallelOrchestrationStrategy) processOperation(execID string) {
 
 }
 
+func (p *ParallelOrchestrationStrategy) acquire(op *orchestration.RuntimeOperation) orchestration.LimitDecision {
+	if p.limiter == nil {
+		return orchestration.LimitAcquired
+	}
+	return p.limiter.Acquire(*op)
+}
+
+func (p *ParallelOrchestrationStrategy) release(op *orchestration.RuntimeOperation) {
+	if p.limiter != nil {
+		p.limiter.Release(*op)
+	}
+}
+
 func (p *ParallelOrchestrationStrategy) updateMaintenanceWindow(execID string, op *orchestration.RuntimeOperation, strategy orchestration.StrategySpec) (time.Duration, error) {
 	var duration time.Duration
 	id := op.ID
@@ -208,14 +242,17 @@ func (p *ParallelOrchestrationStrategy) updateMaintenanceWindow(execID string, o
 	case orchestration.MaintenanceWindow:
 		// if time window for this operation has finished, we requeue and reprocess on next time window
 		if !op.MaintenanceWindowEnd.IsZero() && op.MaintenanceWindowEnd.Before(time.Now()) {
-			if p.rescheduleDelay > 0 {
-				op.MaintenanceWindowBegin = op.MaintenanceWindowBegin.Add(p.rescheduleDelay)
-				op.MaintenanceWindowEnd = op.MaintenanceWindowEnd.Add(p.rescheduleDelay)
-			} else {
-				currentDay := op.MaintenanceWindowBegin.Weekday()
-				diff := orchestration.NextAvailableDayDiff(currentDay, orchestration.ConvertSliceOfDaysToMap(op.MaintenanceDays))
-				op.MaintenanceWindowBegin = op.MaintenanceWindowBegin.AddDate(0, 0, diff)
-				op.MaintenanceWindowEnd = op.MaintenanceWindowEnd.AddDate(0, 0, diff)
+			// the operation can be postponed by a blackout period for more than one time window
+			for op.MaintenanceWindowEnd.Before(time.Now()) {
+				if p.rescheduleDelay > 0 {
+					op.MaintenanceWindowBegin = op.MaintenanceWindowBegin.Add(p.rescheduleDelay)
+					op.MaintenanceWindowEnd = op.MaintenanceWindowEnd.Add(p.rescheduleDelay)
+				} else {
+					currentDay := op.MaintenanceWindowBegin.Weekday()
+					diff := orchestration.NextAvailableDayDiff(currentDay, orchestration.ConvertSliceOfDaysToMap(op.MaintenanceDays))
+					op.MaintenanceWindowBegin = op.MaintenanceWindowBegin.AddDate(0, 0, diff)
+					op.MaintenanceWindowEnd = op.MaintenanceWindowEnd.AddDate(0, 0, diff)
+				}
 			}
 
 			err := p.executor.Reschedule(id, op.MaintenanceWindowBegin, op.MaintenanceWindowEnd)
@@ -231,7 +268,30 @@ func (p *ParallelOrchestrationStrategy) updateMaintenanceWindow(execID string, o
 	case orchestration.Immediate:
 	}
 
-	return duration, nil
+	return p.postponeByBlackout(id, duration), nil
+}
+
+// postponeByBlackout moves the start of the operation to the end of the blackout period, if the operation would start during it
+func (p *ParallelOrchestrationStrategy) postponeByBlackout(operationID string, duration time.Duration) time.Duration {
+	if p.blackouts == nil {
+		return duration
+	}
+	calendar, err := p.blackouts.GetBlackoutCalendar()
+	if err != nil {
+		p.log.Warnf("while getting blackout calendar: %s", err)
+		return duration
+	}
+
+	start := time.Now()
+	if duration > 0 {
+		start = start.Add(duration)
+	}
+	end, found := calendar.BlackoutEnd(start)
+	if !found {
+		return duration
+	}
+	p.log.WithField("operationID", operationID).Infof("operation is postponed until the end of the blackout period at %s", end)
+	return time.Until(end)
 }
 
 func (p *ParallelOrchestrationStrategy) Wait(executionID string) {
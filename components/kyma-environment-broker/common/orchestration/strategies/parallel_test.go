@@ -35,10 +35,46 @@ func (t *testExecutor) Reschedule(operationID string, maintenanceWindowBegin, ma
 	return nil
 }
 
+type testBlackouts struct {
+	calendar orchestration.BlackoutCalendar
+}
+
+func (b *testBlackouts) GetBlackoutCalendar() (orchestration.BlackoutCalendar, error) {
+	return b.calendar, nil
+}
+
+type testLimiter struct {
+	mux        sync.Mutex
+	limit      int
+	rejected   string
+	inProgress int
+	acquired   int
+}
+
+func (l *testLimiter) Acquire(op orchestration.RuntimeOperation) orchestration.LimitDecision {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	if op.ID == l.rejected {
+		return orchestration.LimitRejected
+	}
+	if l.inProgress >= l.limit {
+		return orchestration.LimitThrottled
+	}
+	l.inProgress++
+	l.acquired++
+	return orchestration.LimitAcquired
+}
+
+func (l *testLimiter) Release(op orchestration.RuntimeOperation) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.inProgress--
+}
+
 func TestNewParallelOrchestrationStrategy_Immediate(t *testing.T) {
 	// given
 	executor := &testExecutor{opCalled: map[string]bool{}}
-	s := NewParallelOrchestrationStrategy(executor, logrus.New(), 0)
+	s := NewParallelOrchestrationStrategy(executor, nil, nil, logrus.New(), 0)
 
 	ops := make([]orchestration.RuntimeOperation, 3)
 	for i := range ops {
@@ -58,7 +94,7 @@ func TestNewParallelOrchestrationStrategy_Immediate(t *testing.T) {
 func TestNewParallelOrchestrationStrategy_MaintenanceWindow(t *testing.T) {
 	// given
 	executor := &testExecutor{opCalled: map[string]bool{}}
-	s := NewParallelOrchestrationStrategy(executor, logrus.New(), 0)
+	s := NewParallelOrchestrationStrategy(executor, nil, nil, logrus.New(), 0)
 
 	start := time.Now().Add(3 * time.Second)
 
@@ -84,7 +120,7 @@ func TestNewParallelOrchestrationStrategy_MaintenanceWindow(t *testing.T) {
 func TestNewParallelOrchestrationStrategy_Reschedule(t *testing.T) {
 	// given
 	executor := &testExecutor{opCalled: map[string]bool{}}
-	s := NewParallelOrchestrationStrategy(executor, logrus.New(), 5*time.Second)
+	s := NewParallelOrchestrationStrategy(executor, nil, nil, logrus.New(), 5*time.Second)
 
 	start := time.Now().Add(-5 * time.Second)
 
@@ -106,3 +142,66 @@ func TestNewParallelOrchestrationStrategy_Reschedule(t *testing.T) {
 	assert.NoError(t, err)
 	s.Wait(id)
 }
+
+func TestNewParallelOrchestrationStrategy_Blackout(t *testing.T) {
+	// given
+	executor := &testExecutor{opCalled: map[string]bool{}}
+	blackoutEnd := time.Now().Add(2 * time.Second)
+	blackouts := &testBlackouts{calendar: orchestration.BlackoutCalendar{Periods: []orchestration.BlackoutPeriod{
+		{Name: "freeze", Start: time.Now().Add(-time.Hour), End: blackoutEnd},
+	}}}
+	s := NewParallelOrchestrationStrategy(executor, blackouts, nil, logrus.New(), 0)
+
+	ops := make([]orchestration.RuntimeOperation, 3)
+	for i := range ops {
+		ops[i] = orchestration.RuntimeOperation{
+			ID: rand.String(5),
+		}
+	}
+
+	// when
+	id, err := s.Execute(ops, orchestration.StrategySpec{Schedule: orchestration.Immediate, Parallel: orchestration.ParallelStrategySpec{Workers: 3}})
+
+	// then
+	assert.NoError(t, err)
+	time.Sleep(time.Second)
+	executor.mux.Lock()
+	assert.Empty(t, executor.opCalled)
+	executor.mux.Unlock()
+
+	s.Wait(id)
+	assert.Len(t, executor.opCalled, 3)
+	assert.False(t, time.Now().Before(blackoutEnd))
+}
+
+func TestNewParallelOrchestrationStrategy_ConcurrencyLimiter(t *testing.T) {
+	// given
+	executor := &testExecutor{opCalled: map[string]bool{}}
+	ops := make([]orchestration.RuntimeOperation, 4)
+	for i := range ops {
+		ops[i] = orchestration.RuntimeOperation{
+			ID: rand.String(5),
+		}
+	}
+	limiter := &testLimiter{limit: 1, rejected: ops[3].ID}
+	s := NewParallelOrchestrationStrategy(executor, nil, limiter, logrus.New(), 0)
+	s.SpeedUp(100)
+
+	// when
+	id, err := s.Execute(ops, orchestration.StrategySpec{Schedule: orchestration.Immediate, Parallel: orchestration.ParallelStrategySpec{Workers: 4}})
+
+	// then
+	assert.NoError(t, err)
+	time.Sleep(time.Second)
+	executor.mux.Lock()
+	assert.Len(t, executor.opCalled, 1, "only one operation is processed at the same time")
+	executor.mux.Unlock()
+
+	s.Wait(id)
+	executor.mux.Lock()
+	assert.Len(t, executor.opCalled, 3)
+	assert.False(t, executor.opCalled[ops[3].ID], "rejected operation is not processed")
+	executor.mux.Unlock()
+	assert.Equal(t, 3, limiter.acquired)
+	assert.Equal(t, 0, limiter.inProgress)
+}
@@ -0,0 +1,384 @@
+package strategies
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	DefaultWaveFactor           = 2
+	DefaultWaveSuccessThreshold = 100
+	waveResultsPollInterval     = 10 * time.Second
+)
+
+type WavesOrchestrationStrategy struct {
+	parallel    orchestration.Strategy
+	states      orchestration.OperationStateGetter
+	executions  map[string]*wavesExecution
+	mux         sync.Mutex
+	log         logrus.FieldLogger
+	speedFactor int
+}
+
+type wavesExecution struct {
+	spec  orchestration.StrategySpec
+	waves [][]orchestration.RuntimeOperation
+	// current is the index of the processed wave and currentID its execution ID in the parallel strategy
+	current    int
+	currentID  string
+	running    bool
+	haltReason string
+	cancel     chan struct{}
+	done       chan struct{}
+}
+
+// NewWavesOrchestrationStrategy returns a new waves orchestration strategy, which processes operations in waves of growing size.
+// Every wave is processed by the parallel strategy, the next wave starts after the soak time if enough operations of the previous one succeeded.
+func NewWavesOrchestrationStrategy(executor orchestration.OperationExecutor, states orchestration.OperationStateGetter, blackouts orchestration.BlackoutCalendarGetter, limiter orchestration.ConcurrencyLimiter, log logrus.FieldLogger) orchestration.Strategy {
+	return &WavesOrchestrationStrategy{
+		parallel:    NewParallelOrchestrationStrategy(executor, blackouts, limiter, log, 0),
+		states:      states,
+		executions:  map[string]*wavesExecution{},
+		log:         log,
+		speedFactor: 1,
+	}
+}
+
+func (w *WavesOrchestrationStrategy) SpeedUp(factor int) {
+	w.speedFactor = factor
+	w.parallel.SpeedUp(factor)
+}
+
+// Execute starts processing of the first wave, next waves are started asynchronously.
+func (w *WavesOrchestrationStrategy) Execute(operations []orchestration.RuntimeOperation, strategySpec orchestration.StrategySpec) (string, error) {
+	if len(operations) == 0 {
+		return "", nil
+	}
+	sizes, err := WaveSizes(len(operations), strategySpec.Waves)
+	if err != nil {
+		return "", errors.Wrap(err, "while calculating sizes of waves")
+	}
+
+	e := &wavesExecution{
+		spec:   strategySpec,
+		cancel: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	start := 0
+	for _, size := range sizes {
+		// the capacity is limited, so operations inserted into a wave do not overwrite the next wave
+		e.waves = append(e.waves, operations[start:start+size:start+size])
+		start += size
+	}
+
+	execID := uuid.New().String()
+	w.mux.Lock()
+	w.executions[execID] = e
+	w.mux.Unlock()
+
+	if err := w.startWave(e, 0); err != nil {
+		close(e.done)
+		w.mux.Lock()
+		delete(w.executions, execID)
+		w.mux.Unlock()
+		return execID, err
+	}
+	go w.run(execID, e)
+
+	return execID, nil
+}
+
+func (w *WavesOrchestrationStrategy) run(execID string, e *wavesExecution) {
+	defer w.finish(execID, e)
+	log := w.log.WithField("executionID", execID)
+
+	for i := 0; ; i++ {
+		w.mux.Lock()
+		waveID := e.currentID
+		w.mux.Unlock()
+		w.parallel.Wait(waveID)
+
+		w.mux.Lock()
+		e.running = false
+		last := i == len(e.waves)-1
+		w.mux.Unlock()
+		if w.canceled(e) || last {
+			return
+		}
+
+		succeeded, total, err := w.countSucceeded(e, i)
+		if err != nil {
+			return
+		}
+		log.Infof("wave %d of %d finished, %d of %d operations succeeded", i+1, len(e.waves), succeeded, total)
+		threshold := WaveSuccessThreshold(e.spec.Waves)
+		if succeeded*100 < total*threshold {
+			haltReason := fmt.Sprintf("wave %d of %d: %d of %d operations succeeded, which is below the success threshold of %d%%",
+				i+1, len(e.waves), succeeded, total, threshold)
+			w.mux.Lock()
+			e.haltReason = haltReason
+			w.mux.Unlock()
+			log.Warnf("halting the execution: %s", haltReason)
+			return
+		}
+
+		if soakTime := w.soakTime(e.spec.Waves); soakTime > 0 {
+			log.Infof("waiting %s before the next wave", soakTime)
+			select {
+			case <-time.After(soakTime):
+			case <-e.cancel:
+				return
+			}
+		}
+
+		if err := w.startWave(e, i+1); err != nil {
+			w.mux.Lock()
+			e.haltReason = err.Error()
+			w.mux.Unlock()
+			log.Errorf("halting the execution: %s", err)
+			return
+		}
+	}
+}
+
+// finish drops the finished execution, the halted one is kept until its halt reason is read by Halted
+func (w *WavesOrchestrationStrategy) finish(execID string, e *wavesExecution) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	if e.haltReason == "" || w.canceled(e) {
+		delete(w.executions, execID)
+	}
+	close(e.done)
+}
+
+func (w *WavesOrchestrationStrategy) startWave(e *wavesExecution, i int) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	waveID, err := w.parallel.Execute(e.waves[i], e.spec)
+	if err != nil {
+		return errors.Wrapf(err, "while starting wave %d", i+1)
+	}
+	e.current = i
+	e.currentID = waveID
+	e.running = true
+	return nil
+}
+
+// countSucceeded returns the number of succeeded operations of the wave and the number of all its operations, including
+// operations inserted while the wave was running. It retries until the states are read or the execution is canceled.
+func (w *WavesOrchestrationStrategy) countSucceeded(e *wavesExecution, i int) (int, int, error) {
+	var ids []string
+	succeeded := 0
+	err := wait.PollImmediateUntil(waveResultsPollInterval/time.Duration(w.speedFactor), func() (bool, error) {
+		w.mux.Lock()
+		ids = make([]string, 0, len(e.waves[i]))
+		for _, op := range e.waves[i] {
+			ids = append(ids, op.ID)
+		}
+		w.mux.Unlock()
+
+		states, err := w.states.GetOperationStates(ids)
+		if err != nil {
+			w.log.Errorf("while getting states of operations of the wave: %s", err)
+			return false, nil
+		}
+		succeeded = 0
+		for _, id := range ids {
+			if states[id] == orchestration.Succeeded {
+				succeeded++
+			}
+		}
+		return true, nil
+	}, e.cancel)
+
+	return succeeded, len(ids), err
+}
+
+func (w *WavesOrchestrationStrategy) soakTime(spec orchestration.WavesStrategySpec) time.Duration {
+	// the spec is validated when the orchestration is created
+	soakTime, _ := parseSoakTime(spec.SoakTime)
+	return soakTime / time.Duration(w.speedFactor)
+}
+
+func (w *WavesOrchestrationStrategy) canceled(e *wavesExecution) bool {
+	select {
+	case <-e.cancel:
+		return true
+	default:
+		return false
+	}
+}
+
+func (w *WavesOrchestrationStrategy) finished(e *wavesExecution) bool {
+	select {
+	case <-e.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Insert adds operations to the wave in progress, or to the next wave if the current one is already finished.
+// Operations are not taken by the halted or canceled execution and by the execution which finished its last wave.
+func (w *WavesOrchestrationStrategy) Insert(execID string, operations []orchestration.RuntimeOperation, strategySpec orchestration.StrategySpec) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	e, exist := w.executions[execID]
+	if !exist {
+		// finished executions are dropped
+		return errors.Wrapf(orchestration.ErrExecutionFinished, "no execution for the execution ID: %s", execID)
+	}
+	if e.haltReason != "" || w.canceled(e) {
+		return errors.Wrapf(orchestration.ErrExecutionHalted, "while inserting operations to the execution %s", execID)
+	}
+	if e.running {
+		if err := w.parallel.Insert(e.currentID, operations, strategySpec); err != nil {
+			return err
+		}
+		// the operations are counted when the success threshold of the wave is checked
+		e.waves[e.current] = append(e.waves[e.current], operations...)
+		return nil
+	}
+	if w.finished(e) || e.current+1 >= len(e.waves) {
+		return errors.Wrapf(orchestration.ErrExecutionFinished, "while inserting operations to the execution %s", execID)
+	}
+	e.waves[e.current+1] = append(e.waves[e.current+1], operations...)
+	return nil
+}
+
+func (w *WavesOrchestrationStrategy) Wait(executionID string) {
+	w.mux.Lock()
+	e := w.executions[executionID]
+	w.mux.Unlock()
+	if e != nil {
+		<-e.done
+	}
+}
+
+func (w *WavesOrchestrationStrategy) Cancel(executionID string) {
+	if executionID == "" {
+		return
+	}
+	w.log.Infof("Cancelling strategy execution %s", executionID)
+
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	e := w.executions[executionID]
+	if e == nil || w.canceled(e) {
+		return
+	}
+	close(e.cancel)
+	w.parallel.Cancel(e.currentID)
+	if w.finished(e) {
+		delete(w.executions, executionID)
+	}
+}
+
+// Halted returns the reason why the execution did not start the next wave, once the execution is finished.
+// The execution is dropped when its halt reason is read, so the reason is returned only once.
+func (w *WavesOrchestrationStrategy) Halted(executionID string) string {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	e := w.executions[executionID]
+	if e == nil || !w.finished(e) {
+		return ""
+	}
+	delete(w.executions, executionID)
+	return e.haltReason
+}
+
+// ValidateWavesStrategySpec checks the canary size and the soak time of the waves strategy
+func ValidateWavesStrategySpec(spec orchestration.WavesStrategySpec) error {
+	if _, err := WaveSizes(1, spec); err != nil {
+		return err
+	}
+	if _, err := parseSoakTime(spec.SoakTime); err != nil {
+		return err
+	}
+	if threshold := WaveSuccessThreshold(spec); threshold < 0 || threshold > 100 {
+		return errors.New("waves.successThreshold must be between 0 and 100")
+	}
+	if spec.Factor < 0 {
+		return errors.New("waves.factor must not be negative")
+	}
+	return nil
+}
+
+// WaveSuccessThreshold returns the success threshold of the waves strategy, or DefaultWaveSuccessThreshold when it is not set
+func WaveSuccessThreshold(spec orchestration.WavesStrategySpec) int {
+	if spec.SuccessThreshold == nil {
+		return DefaultWaveSuccessThreshold
+	}
+	return *spec.SuccessThreshold
+}
+
+// WaveSizes splits the given number of operations into the canary wave and the next waves, each of them Factor times bigger than the previous one
+func WaveSizes(total int, spec orchestration.WavesStrategySpec) ([]int, error) {
+	canary, err := parseCanary(spec.Canary, total)
+	if err != nil {
+		return nil, err
+	}
+	factor := spec.Factor
+	if factor < 1 {
+		factor = DefaultWaveFactor
+	}
+
+	var sizes []int
+	for size, remaining := canary, total; remaining > 0; size *= factor {
+		if size > remaining {
+			size = remaining
+		}
+		sizes = append(sizes, size)
+		remaining -= size
+	}
+	return sizes, nil
+}
+
+func parseCanary(canary string, total int) (int, error) {
+	if canary == "" {
+		return 1, nil
+	}
+
+	var size int
+	if percent := strings.TrimSuffix(canary, "%"); percent != canary {
+		p, err := strconv.Atoi(percent)
+		if err != nil || p <= 0 || p > 100 {
+			return 0, errors.Errorf("invalid waves.canary percentage %s", canary)
+		}
+		size = (total*p + 99) / 100
+	} else {
+		n, err := strconv.Atoi(canary)
+		if err != nil || n <= 0 {
+			return 0, errors.Errorf("invalid waves.canary %s, it must be a positive number or a percentage", canary)
+		}
+		size = n
+	}
+
+	if size < 1 {
+		size = 1
+	}
+	return size, nil
+}
+
+func parseSoakTime(soakTime string) (time.Duration, error) {
+	if soakTime == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(soakTime)
+	if err != nil || d < 0 {
+		return 0, errors.Errorf("invalid waves.soakTime %s", soakTime)
+	}
+	return d, nil
+}
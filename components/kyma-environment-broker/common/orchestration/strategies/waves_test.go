@@ -0,0 +1,268 @@
+package strategies
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type waveExecutor struct {
+	mux    sync.Mutex
+	failed map[string]bool
+	states map[string]string
+	order  []string
+	// the operation with the held ID is executed when the hold channel is closed
+	held string
+	hold chan struct{}
+}
+
+func (e *waveExecutor) Execute(opID string) (time.Duration, error) {
+	if opID == e.held {
+		<-e.hold
+	}
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	e.order = append(e.order, opID)
+	e.states[opID] = orchestration.Succeeded
+	if e.failed[opID] {
+		e.states[opID] = orchestration.Failed
+	}
+	return 0, nil
+}
+
+func (e *waveExecutor) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
+	return nil
+}
+
+func (e *waveExecutor) GetOperationStates(operationIDs []string) (map[string]string, error) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	states := map[string]string{}
+	for _, id := range operationIDs {
+		states[id] = e.states[id]
+	}
+	return states, nil
+}
+
+func waveOperations(n int) []orchestration.RuntimeOperation {
+	ops := make([]orchestration.RuntimeOperation, n)
+	for i := range ops {
+		ops[i] = orchestration.RuntimeOperation{ID: fmt.Sprintf("op-%d", i)}
+	}
+	return ops
+}
+
+func TestWaveSizes(t *testing.T) {
+	for name, tc := range map[string]struct {
+		total    int
+		spec     orchestration.WavesStrategySpec
+		expected []int
+	}{
+		"default canary": {
+			total:    10,
+			spec:     orchestration.WavesStrategySpec{},
+			expected: []int{1, 2, 4, 3},
+		},
+		"number canary": {
+			total:    10,
+			spec:     orchestration.WavesStrategySpec{Canary: "2", Factor: 3},
+			expected: []int{2, 6, 2},
+		},
+		"percentage canary": {
+			total:    25,
+			spec:     orchestration.WavesStrategySpec{Canary: "10%", Factor: 2},
+			expected: []int{3, 6, 12, 4},
+		},
+		"canary bigger than total": {
+			total:    3,
+			spec:     orchestration.WavesStrategySpec{Canary: "5"},
+			expected: []int{3},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sizes, err := WaveSizes(tc.total, tc.spec)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, sizes)
+		})
+	}
+}
+
+func TestValidateWavesStrategySpec(t *testing.T) {
+	assert.NoError(t, ValidateWavesStrategySpec(orchestration.WavesStrategySpec{Canary: "10%", SoakTime: "1h", SuccessThreshold: ptr.Integer(90)}))
+	assert.Error(t, ValidateWavesStrategySpec(orchestration.WavesStrategySpec{Canary: "0"}))
+	assert.Error(t, ValidateWavesStrategySpec(orchestration.WavesStrategySpec{Canary: "150%"}))
+	assert.Error(t, ValidateWavesStrategySpec(orchestration.WavesStrategySpec{Canary: "x"}))
+	assert.Error(t, ValidateWavesStrategySpec(orchestration.WavesStrategySpec{SoakTime: "1 hour"}))
+	assert.NoError(t, ValidateWavesStrategySpec(orchestration.WavesStrategySpec{SuccessThreshold: ptr.Integer(0)}))
+	assert.Error(t, ValidateWavesStrategySpec(orchestration.WavesStrategySpec{SuccessThreshold: ptr.Integer(101)}))
+}
+
+func TestWavesOrchestrationStrategy(t *testing.T) {
+	t.Run("should process all waves", func(t *testing.T) {
+		// given
+		executor := &waveExecutor{states: map[string]string{}}
+		s := NewWavesOrchestrationStrategy(executor, executor, nil, nil, logrus.New())
+		spec := orchestration.StrategySpec{
+			Type:     orchestration.WavesStrategy,
+			Schedule: orchestration.Immediate,
+			Parallel: orchestration.ParallelStrategySpec{Workers: 2},
+			Waves:    orchestration.WavesStrategySpec{Canary: "1", Factor: 2, SoakTime: "10ms", SuccessThreshold: ptr.Integer(100)},
+		}
+
+		// when
+		id, err := s.Execute(waveOperations(7), spec)
+		require.NoError(t, err)
+		s.Wait(id)
+
+		// then
+		assert.Len(t, executor.order, 7)
+		assert.Equal(t, "op-0", executor.order[0])
+		assert.Empty(t, s.(orchestration.HaltingStrategy).Halted(id))
+		assert.Empty(t, s.(*WavesOrchestrationStrategy).executions)
+	})
+
+	t.Run("should halt when the wave does not meet the success threshold", func(t *testing.T) {
+		// given
+		executor := &waveExecutor{states: map[string]string{}, failed: map[string]bool{"op-1": true}}
+		s := NewWavesOrchestrationStrategy(executor, executor, nil, nil, logrus.New())
+		spec := orchestration.StrategySpec{
+			Type:     orchestration.WavesStrategy,
+			Schedule: orchestration.Immediate,
+			Parallel: orchestration.ParallelStrategySpec{Workers: 1},
+			Waves:    orchestration.WavesStrategySpec{Canary: "1", Factor: 2, SuccessThreshold: ptr.Integer(60)},
+		}
+
+		// when
+		id, err := s.Execute(waveOperations(7), spec)
+		require.NoError(t, err)
+		s.Wait(id)
+
+		// then
+		assert.Len(t, executor.order, 3)
+		assert.Contains(t, s.(orchestration.HaltingStrategy).Halted(id), "wave 2 of 3: 1 of 2 operations succeeded")
+		assert.Empty(t, s.(*WavesOrchestrationStrategy).executions)
+	})
+
+	t.Run("should count operations inserted into the running wave", func(t *testing.T) {
+		// given
+		executor := &waveExecutor{states: map[string]string{}, failed: map[string]bool{"inserted": true}, held: "op-0", hold: make(chan struct{})}
+		s := NewWavesOrchestrationStrategy(executor, executor, nil, nil, logrus.New())
+		spec := orchestration.StrategySpec{
+			Type:     orchestration.WavesStrategy,
+			Schedule: orchestration.Immediate,
+			Parallel: orchestration.ParallelStrategySpec{Workers: 2},
+			Waves:    orchestration.WavesStrategySpec{Canary: "1", Factor: 2, SuccessThreshold: ptr.Integer(100)},
+		}
+		id, err := s.Execute(waveOperations(3), spec)
+		require.NoError(t, err)
+
+		// when
+		err = s.Insert(id, []orchestration.RuntimeOperation{{ID: "inserted"}}, spec)
+		require.NoError(t, err)
+		close(executor.hold)
+		s.Wait(id)
+
+		// then
+		assert.ElementsMatch(t, []string{"op-0", "inserted"}, executor.order)
+		assert.Contains(t, s.(orchestration.HaltingStrategy).Halted(id), "wave 1 of 2: 1 of 2 operations succeeded")
+	})
+
+	t.Run("should not insert operations into the halted execution", func(t *testing.T) {
+		// given
+		executor := &waveExecutor{states: map[string]string{}, failed: map[string]bool{"op-0": true}}
+		s := NewWavesOrchestrationStrategy(executor, executor, nil, nil, logrus.New())
+		spec := orchestration.StrategySpec{
+			Type:     orchestration.WavesStrategy,
+			Schedule: orchestration.Immediate,
+			Parallel: orchestration.ParallelStrategySpec{Workers: 1},
+			Waves:    orchestration.WavesStrategySpec{Canary: "1", Factor: 2, SuccessThreshold: ptr.Integer(100)},
+		}
+		id, err := s.Execute(waveOperations(3), spec)
+		require.NoError(t, err)
+		s.Wait(id)
+
+		// when
+		err = s.Insert(id, []orchestration.RuntimeOperation{{ID: "retried"}}, spec)
+
+		// then
+		assert.True(t, errors.Is(err, orchestration.ErrExecutionHalted))
+		assert.Equal(t, []string{"op-0"}, executor.order)
+		assert.NotEmpty(t, s.(orchestration.HaltingStrategy).Halted(id))
+	})
+
+	t.Run("should not insert operations into the execution which finished the last wave", func(t *testing.T) {
+		// given
+		executor := &waveExecutor{states: map[string]string{}}
+		s := NewWavesOrchestrationStrategy(executor, executor, nil, nil, logrus.New())
+		spec := orchestration.StrategySpec{
+			Type:     orchestration.WavesStrategy,
+			Schedule: orchestration.Immediate,
+			Parallel: orchestration.ParallelStrategySpec{Workers: 1},
+			Waves:    orchestration.WavesStrategySpec{Canary: "1", Factor: 2, SuccessThreshold: ptr.Integer(100)},
+		}
+		id, err := s.Execute(waveOperations(3), spec)
+		require.NoError(t, err)
+		s.Wait(id)
+
+		// when
+		err = s.Insert(id, []orchestration.RuntimeOperation{{ID: "retried"}}, spec)
+
+		// then
+		assert.True(t, errors.Is(err, orchestration.ErrExecutionFinished))
+		assert.Len(t, executor.order, 3)
+	})
+
+	t.Run("should process all waves when the success threshold is 0", func(t *testing.T) {
+		// given
+		executor := &waveExecutor{states: map[string]string{}, failed: map[string]bool{"op-0": true, "op-1": true}}
+		s := NewWavesOrchestrationStrategy(executor, executor, nil, nil, logrus.New())
+		spec := orchestration.StrategySpec{
+			Type:     orchestration.WavesStrategy,
+			Schedule: orchestration.Immediate,
+			Parallel: orchestration.ParallelStrategySpec{Workers: 1},
+			Waves:    orchestration.WavesStrategySpec{Canary: "1", Factor: 2, SuccessThreshold: ptr.Integer(0)},
+		}
+
+		// when
+		id, err := s.Execute(waveOperations(7), spec)
+		require.NoError(t, err)
+		s.Wait(id)
+
+		// then
+		assert.Len(t, executor.order, 7)
+		assert.Empty(t, s.(orchestration.HaltingStrategy).Halted(id))
+	})
+
+	t.Run("should stop after cancel during soak time", func(t *testing.T) {
+		// given
+		executor := &waveExecutor{states: map[string]string{}}
+		s := NewWavesOrchestrationStrategy(executor, executor, nil, nil, logrus.New())
+		spec := orchestration.StrategySpec{
+			Type:     orchestration.WavesStrategy,
+			Schedule: orchestration.Immediate,
+			Parallel: orchestration.ParallelStrategySpec{Workers: 1},
+			Waves:    orchestration.WavesStrategySpec{Canary: "1", SoakTime: "1h", SuccessThreshold: ptr.Integer(100)},
+		}
+		id, err := s.Execute(waveOperations(3), spec)
+		require.NoError(t, err)
+
+		// when
+		time.Sleep(100 * time.Millisecond)
+		s.Cancel(id)
+		s.Wait(id)
+
+		// then
+		assert.Equal(t, []string{"op-0"}, executor.order)
+		assert.Empty(t, s.(*WavesOrchestrationStrategy).executions)
+	})
+}
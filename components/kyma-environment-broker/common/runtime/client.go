@@ -1,6 +1,8 @@
 package runtime
 
 import (
+	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -8,16 +10,29 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/pagination"
 	"github.com/pkg/errors"
 )
 
-const defaultPageSize = 100
+// ErrResourceVersionExpired is returned by WatchRuntimes when the resource version is too old to resume the watch,
+// runtimes must be listed again to get the current resource version
+var ErrResourceVersionExpired = errors.New("resource version expired")
+
+const (
+	defaultPageSize = 100
+	// maxWatchEventSize limits the size of one event of the watch stream
+	maxWatchEventSize = 4 * 1024 * 1024
+)
 
 // Client is the interface to interact with the KEB /runtimes API as an HTTP client using OIDC ID token in JWT format.
 type Client interface {
 	ListRuntimes(params ListParameters) (RuntimesPage, error)
+	// WatchRuntimes streams changes of runtimes which happened after the given resource version, an empty version streams
+	// changes from now on. The handler is called for every event until the context is done, the server closes the stream
+	// or the handler returns an error.
+	WatchRuntimes(ctx context.Context, params ListParameters, resourceVersion string, handler func(WatchEvent) error) error
 }
 
 type client struct {
@@ -37,12 +52,12 @@ func NewClient(url string, httpClient *http.Client) Client {
 }
 
 // ListRuntimes fetches the runtimes from KEB according to the given parameters.
-// If params.Page or params.PageSize is not set (zero), the client will fetch and return all runtimes.
+// If params.Page (or params.Cursor) or params.PageSize is not set (zero), the client will fetch and return all runtimes.
 func (c *client) ListRuntimes(params ListParameters) (RuntimesPage, error) {
 	runtimes := RuntimesPage{}
 	getAll := false
 	fetchedAll := false
-	if params.Page == 0 || params.PageSize == 0 {
+	if (params.Page == 0 && params.Cursor == "") || params.PageSize == 0 {
 		getAll = true
 		params.Page = 1
 		params.PageSize = defaultPageSize
@@ -86,21 +101,95 @@ func (c *client) ListRuntimes(params ListParameters) (RuntimesPage, error) {
 		runtimes.TotalCount = rp.TotalCount
 		runtimes.Count += rp.Count
 		runtimes.Data = append(runtimes.Data, rp.Data...)
-		if getAll {
+		runtimes.NextCursor = rp.NextCursor
+		switch {
+		case !getAll:
+			fetchedAll = true
+		case rp.NextCursor != "":
+			// the cursor is preferred to the pages, which drift if runtimes are created or deleted meanwhile
+			params.Cursor = rp.NextCursor
+		case params.Cursor != "":
+			fetchedAll = true
+		default:
 			params.Page++
 			fetchedAll = runtimes.Count >= runtimes.TotalCount
-		} else {
-			fetchedAll = true
 		}
 	}
 
 	return runtimes, nil
 }
 
+func (c *client) WatchRuntimes(ctx context.Context, params ListParameters, resourceVersion string, handler func(WatchEvent) error) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/runtimes", c.url), nil)
+	if err != nil {
+		return errors.Wrap(err, "while creating request")
+	}
+	setQuery(req.URL, params)
+	query := req.URL.Query()
+	// the watch streams all matching runtimes
+	query.Del(pagination.PageParam)
+	query.Del(pagination.PageSizeParam)
+	query.Del(CursorParam)
+	query.Del(SortParam)
+	query.Del(FieldsParam)
+	query.Set(WatchParam, "true")
+	if resourceVersion != "" {
+		query.Set(ResourceVersionParam, resourceVersion)
+	}
+	req.URL.RawQuery = query.Encode()
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "while calling %s", req.URL.String())
+	}
+	defer func() {
+		_ = drainResponseBody(resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode == http.StatusGone {
+		return ErrResourceVersionExpired
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("calling %s returned %d (%s) status", req.URL.String(), resp.StatusCode, resp.Status)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), maxWatchEventSize)
+	for scanner.Scan() {
+		// the event type and ID are repeated in the data, only the data lines are needed
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+		var event WatchEvent
+		if err := json.Unmarshal([]byte(strings.TrimSpace(strings.TrimPrefix(line, "data:"))), &event); err != nil {
+			return errors.Wrap(err, "while decoding watch event")
+		}
+		if err := handler(event); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return errors.Wrap(err, "while reading watch events")
+	}
+	return nil
+}
+
 func setQuery(url *url.URL, params ListParameters) {
 	query := url.Query()
-	query.Add(pagination.PageParam, strconv.Itoa(params.Page))
+	if params.Cursor != "" {
+		query.Add(CursorParam, params.Cursor)
+	} else {
+		query.Add(pagination.PageParam, strconv.Itoa(params.Page))
+	}
 	query.Add(pagination.PageSizeParam, strconv.Itoa(params.PageSize))
+	if params.Sort != "" {
+		query.Add(SortParam, string(params.Sort))
+	}
+	if len(params.Fields) > 0 {
+		query.Add(FieldsParam, strings.Join(params.Fields, ","))
+	}
 	if params.OperationDetail != "" {
 		query.Add(OperationDetailParam, string(params.OperationDetail))
 	}
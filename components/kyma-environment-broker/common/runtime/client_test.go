@@ -116,6 +116,87 @@ func TestClient_ListRuntimes(t *testing.T) {
 		assert.Equal(t, 4, rp.TotalCount)
 		assert.Len(t, rp.Data, 4)
 	})
+
+	t.Run("test cursor pagination", func(t *testing.T) {
+		called := 0
+		params := ListParameters{
+			Sort:   SortByGlobalAccountID,
+			Fields: []string{"runtimeID", "status"},
+		}
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called++
+			query := r.URL.Query()
+			assert.Equal(t, string(SortByGlobalAccountID), query.Get(SortParam))
+			assert.Equal(t, "runtimeID,status", query.Get(FieldsParam))
+
+			var err error
+			switch query.Get(CursorParam) {
+			case "":
+				assert.Equal(t, "1", query.Get(pagination.PageParam))
+				err = respondRuntimesPage(w, RuntimesPage{Data: []RuntimeDTO{runtime1, runtime2}, Count: 2, TotalCount: 3, NextCursor: "c1"})
+			case "c1":
+				assert.Empty(t, query[pagination.PageParam])
+				err = respondRuntimesPage(w, RuntimesPage{Data: []RuntimeDTO{runtime3}, Count: 1, TotalCount: 4})
+			default:
+				t.Errorf("unexpected cursor %s", query.Get(CursorParam))
+			}
+			require.NoError(t, err)
+		}))
+		defer ts.Close()
+		client := NewClient(ts.URL, oauth2.NewClient(context.Background(), fixToken))
+
+		//when
+		rp, err := client.ListRuntimes(params)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, 2, called)
+		assert.Equal(t, 3, rp.Count)
+		assert.Len(t, rp.Data, 3)
+		assert.Empty(t, rp.NextCursor)
+	})
+}
+
+func TestClient_WatchRuntimes(t *testing.T) {
+	// given
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assert.Equal(t, "true", query.Get(WatchParam))
+		assert.Equal(t, "100", query.Get(ResourceVersionParam))
+		assert.ElementsMatch(t, []string{"ga1"}, query[GlobalAccountIDParam])
+		assert.Empty(t, query[pagination.PageParam])
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, event := range []WatchEvent{
+			{Type: WatchEventAdded, ResourceVersion: "101", Runtime: &runtime1},
+			{Type: WatchEventBookmark, ResourceVersion: "101"},
+			{Type: WatchEventDeleted, ResourceVersion: "102", Runtime: &runtime2},
+		} {
+			data, err := json.Marshal(event)
+			require.NoError(t, err)
+			_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ResourceVersion, event.Type, data)
+			require.NoError(t, err)
+		}
+	}))
+	defer ts.Close()
+	client := NewClient(ts.URL, oauth2.NewClient(context.Background(), fixToken))
+
+	// when
+	var events []WatchEvent
+	err := client.WatchRuntimes(context.Background(), ListParameters{GlobalAccountIDs: []string{"ga1"}}, "100", func(event WatchEvent) error {
+		events = append(events, event)
+		return nil
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, WatchEventAdded, events[0].Type)
+	assert.Equal(t, runtime1.InstanceID, events[0].Runtime.InstanceID)
+	assert.Equal(t, WatchEventBookmark, events[1].Type)
+	assert.Nil(t, events[1].Runtime)
+	assert.Equal(t, WatchEventDeleted, events[2].Type)
+	assert.Equal(t, "102", events[2].ResourceVersion)
 }
 
 func fixRuntimeDTO(id string) RuntimeDTO {
@@ -143,11 +224,14 @@ func fixRuntimeDTO(id string) RuntimeDTO {
 }
 
 func respondRuntimes(w http.ResponseWriter, runtimes []RuntimeDTO, totalCount int) error {
-	rp := RuntimesPage{
+	return respondRuntimesPage(w, RuntimesPage{
 		Data:       runtimes,
 		Count:      len(runtimes),
 		TotalCount: totalCount,
-	}
+	})
+}
+
+func respondRuntimesPage(w http.ResponseWriter, rp RuntimesPage) error {
 	data, err := json.Marshal(rp)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -49,6 +49,13 @@ type RuntimeDTO struct {
 	KymaVersion                 string                         `json:"kymaVersion,omitempty"`
 	KymaConfig                  *gqlschema.KymaConfigInput     `json:"kymaConfig,omitempty"`
 	ClusterConfig               *gqlschema.GardenerConfigInput `json:"clusterConfig,omitempty"`
+	HibernationSchedules        []HibernationSchedule          `json:"hibernationSchedules,omitempty"`
+}
+
+type HibernationSchedule struct {
+	Start    string `json:"start,omitempty"`
+	End      string `json:"end,omitempty"`
+	Location string `json:"location,omitempty"`
 }
 
 type RuntimeStatus struct {
@@ -95,6 +102,27 @@ type RuntimesPage struct {
 	Data       []RuntimeDTO `json:"data"`
 	Count      int          `json:"count"`
 	TotalCount int          `json:"totalCount"`
+	// ResourceVersion allows to watch changes of runtimes which happened after the page was listed
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+	// NextCursor is set if the page is full, pass it in the cursor parameter to get the runtimes following the page
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+type WatchEventType string
+
+const (
+	WatchEventAdded    WatchEventType = "ADDED"
+	WatchEventModified WatchEventType = "MODIFIED"
+	WatchEventDeleted  WatchEventType = "DELETED"
+	// WatchEventBookmark carries only the resource version, from which the watch can be resumed
+	WatchEventBookmark WatchEventType = "BOOKMARK"
+)
+
+// WatchEvent is the data of the server-sent event streamed by GET /runtimes?watch=true
+type WatchEvent struct {
+	Type            WatchEventType `json:"type"`
+	ResourceVersion string         `json:"resourceVersion"`
+	Runtime         *RuntimeDTO    `json:"runtime,omitempty"`
 }
 
 const (
@@ -109,6 +137,20 @@ const (
 	OperationDetailParam = "op_detail"
 	KymaConfigParam      = "kyma_config"
 	ClusterConfigParam   = "cluster_config"
+	WatchParam           = "watch"
+	ResourceVersionParam = "resource_version"
+	SortParam            = "sort"
+	FieldsParam          = "fields"
+	CursorParam          = "cursor"
+)
+
+// SortField is the field by which the runtimes are sorted in ascending order
+type SortField string
+
+const (
+	SortByCreatedAt       SortField = "createdAt"
+	SortByGlobalAccountID SortField = "globalAccountID"
+	SortByState           SortField = "state"
 )
 
 type OperationDetail string
@@ -145,6 +187,14 @@ type ListParameters struct {
 	Plans []string
 	// States parameter filters runtimes by specified runtime states. See type State for possible values
 	States []State
+	// Sort specifies the order of the runtimes, by default they are sorted by the creation time
+	Sort SortField
+	// Fields limits the returned fields of the runtimes to the given JSON field names, e.g. runtimeID or status.
+	// The server skips fetching the details which are not requested. All fields are returned if not set. The watch ignores the fields
+	Fields []string
+	// Cursor replaces the Page and specifies the NextCursor of the previously fetched page.
+	// Unlike the pages, the cursor pagination is stable while runtimes are created or deleted
+	Cursor string
 }
 
 func (rt RuntimeDTO) LastOperation() Operation {
@@ -145,4 +145,9 @@ replace (
 	k8s.io/client-go => k8s.io/client-go v0.24.1
 	k8s.io/kubectl => k8s.io/kubectl v0.24.1
 
+	// KEB uses changes of the provisioner GraphQL schema (the wake up mutation, hibernation schedules, worker pools)
+	// which are not in a published provisioner version yet, so the provisioner from the repository is used.
+	// The images are built from the repository root to include it. Require the published provisioner version
+	// with the schema changes and drop this replace once it is released.
+	github.com/kyma-project/control-plane/components/provisioner => ../provisioner
 )
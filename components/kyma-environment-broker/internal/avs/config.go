@@ -2,6 +2,7 @@ package avs
 
 import (
 	"fmt"
+	"time"
 
 	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
 )
@@ -29,6 +30,11 @@ type Config struct {
 	TrialInternalTesterAccessId int64 `envconfig:"optional"`
 	TrialParentId               int64 `envconfig:"optional"`
 	TrialGroupId                int64 `envconfig:"optional"`
+
+	// StepTimeLimit and StepMaxRetries limit the AVS provisioning steps, so a stuck AVS call fails the operation
+	// before the operation timeout. Zero disables the limit.
+	StepTimeLimit  time.Duration `envconfig:"default=30m"`
+	StepMaxRetries int           `envconfig:"default=60"`
 }
 
 func (c Config) IsTrialConfigured() bool {
@@ -2,27 +2,164 @@ package broker
 
 import (
 	"context"
-	"errors"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/kubeconfig"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
+//go:generate mockery -name=BindingCredentials -output=automock -outpkg=automock -case=underscore
+
+// BindingCredentials creates and revokes runtime access for service bindings
+type BindingCredentials interface {
+	Create(ctx context.Context, instance *internal.Instance, bindingID string, expirationSeconds int64) (string, time.Time, error)
+	Revoke(ctx context.Context, instance *internal.Instance, bindingID string) error
+}
+
+// BindingParams holds the parameters accepted by the bind endpoint
+type BindingParams struct {
+	ExpirationSeconds int64 `json:"expiration_seconds,omitempty"`
+}
+
 type BindEndpoint struct {
+	config           BindingConfig
+	instancesStorage storage.Instances
+	bindingsStorage  storage.Bindings
+	credentials      BindingCredentials
+
 	log logrus.FieldLogger
 }
 
-func NewBind(log logrus.FieldLogger) *BindEndpoint {
-	return &BindEndpoint{log: log.WithField("service", "BindEndpoint")}
+func NewBind(cfg BindingConfig, instancesStorage storage.Instances, bindingsStorage storage.Bindings, credentials BindingCredentials, log logrus.FieldLogger) *BindEndpoint {
+	return &BindEndpoint{
+		config:           cfg,
+		instancesStorage: instancesStorage,
+		bindingsStorage:  bindingsStorage,
+		credentials:      credentials,
+		log:              log.WithField("service", "BindEndpoint"),
+	}
 }
 
 // Bind creates a new service binding
 //   PUT /v2/service_instances/{instance_id}/service_bindings/{binding_id}
 func (b *BindEndpoint) Bind(ctx context.Context, instanceID, bindingID string, details domain.BindDetails, asyncAllowed bool) (domain.Binding, error) {
-	b.log.Infof("Bind instanceID:", instanceID)
-	b.log.Infof("Bind parameters: %s", string(details.RawParameters))
-	b.log.Infof("Bind context: %s", string(details.RawContext))
-	b.log.Infof("Bind asyncAllowed:", asyncAllowed)
+	logger := b.log.WithFields(logrus.Fields{"instanceID": instanceID, "bindingID": bindingID})
+	logger.Infof("Bind called, asyncAllowed: %v", asyncAllowed)
+
+	if !b.config.Enabled {
+		err := errors.New("service bindings are not enabled")
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+
+	if err := kubeconfig.ValidateBindingID(bindingID); err != nil {
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, err.Error())
+	}
+
+	params, err := b.bindingParams(details.RawParameters)
+	if err != nil {
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, err.Error())
+	}
+
+	instance, err := b.instancesStorage.GetByID(instanceID)
+	switch {
+	case err == nil:
+	case dberr.IsNotFound(err):
+		return domain.Binding{}, apiresponses.ErrInstanceDoesNotExist
+	default:
+		logger.Errorf("unable to get instance from the storage: %s", err)
+		return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to get instance from the storage"), http.StatusInternalServerError, fmt.Sprintf("could not create binding for instanceID %s", instanceID))
+	}
+	if instance.RuntimeID == "" {
+		err := fmt.Errorf("runtime for instance %s does not exist, provisioning could be in progress", instanceID)
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+
+	existing, err := b.bindingsStorage.Get(instanceID, bindingID)
+	switch {
+	case err == nil && existing.IsExpired():
+		// the expired binding is issued again with a new kubeconfig
+		logger.Infof("binding expired at %s, creating it again", existing.ExpiresAt)
+		if err := b.bindingsStorage.Delete(instanceID, bindingID); err != nil {
+			logger.Errorf("unable to delete expired binding: %s", err)
+			return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to delete expired binding"), http.StatusInternalServerError, fmt.Sprintf("could not create binding %s", bindingID))
+		}
+	case err == nil:
+		if existing.ExpirationSeconds == params.ExpirationSeconds {
+			logger.Info("binding already exists")
+			return domain.Binding{
+				AlreadyExists: true,
+				Credentials:   bindingCredentials(existing),
+			}, nil
+		}
+		return domain.Binding{}, apiresponses.ErrBindingAlreadyExists
+	case dberr.IsNotFound(err):
+	default:
+		logger.Errorf("unable to get binding from the storage: %s", err)
+		return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to get binding from the storage"), http.StatusInternalServerError, fmt.Sprintf("could not create binding %s", bindingID))
+	}
+
+	kubeconfig, expiresAt, err := b.credentials.Create(ctx, instance, bindingID, params.ExpirationSeconds)
+	if err != nil {
+		logger.Errorf("unable to create binding credentials: %s", err)
+		return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to create binding credentials"), http.StatusInternalServerError, fmt.Sprintf("could not create binding %s", bindingID))
+	}
+
+	now := time.Now()
+	binding := internal.Binding{
+		ID:                bindingID,
+		InstanceID:        instanceID,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		ExpiresAt:         expiresAt,
+		Kubeconfig:        kubeconfig,
+		ExpirationSeconds: params.ExpirationSeconds,
+	}
+	err = b.bindingsStorage.Insert(binding)
+	if err != nil {
+		logger.Errorf("unable to save binding: %s", err)
+		if err := b.credentials.Revoke(ctx, instance, bindingID); err != nil {
+			logger.Errorf("unable to revoke binding credentials: %s", err)
+		}
+		return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to save binding"), http.StatusInternalServerError, fmt.Sprintf("could not create binding %s", bindingID))
+	}
+	logger.Infof("binding created, expires at %s", expiresAt)
+
+	return domain.Binding{
+		Credentials: bindingCredentials(&binding),
+	}, nil
+}
+
+func (b *BindEndpoint) bindingParams(raw json.RawMessage) (BindingParams, error) {
+	params := BindingParams{}
+	if len(raw) != 0 {
+		if err := json.Unmarshal(raw, &params); err != nil {
+			return BindingParams{}, errors.Wrap(err, "while unmarshalling binding parameters")
+		}
+	}
+
+	if params.ExpirationSeconds == 0 {
+		params.ExpirationSeconds = b.config.ExpirationSeconds
+	}
+	if params.ExpirationSeconds < b.config.MinExpirationSeconds || params.ExpirationSeconds > b.config.MaxExpirationSeconds {
+		return BindingParams{}, fmt.Errorf("expiration_seconds must be between %d and %d", b.config.MinExpirationSeconds, b.config.MaxExpirationSeconds)
+	}
+
+	return params, nil
+}
 
-	return domain.Binding{}, errors.New("not supported")
+func bindingCredentials(binding *internal.Binding) map[string]interface{} {
+	return map[string]interface{}{
+		"kubeconfig": binding.Kubeconfig,
+		"expires_at": binding.ExpiresAt.UTC().Format(time.RFC3339),
+	}
 }
@@ -0,0 +1,218 @@
+package broker_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker/automock"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	bindingID      = "binding-001"
+	bindKubeconfig = "apiVersion: v1\nkind: Config"
+)
+
+func TestBindEndpoint_Bind(t *testing.T) {
+	t.Run("should create binding with default expiration", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		err := memoryStorage.Instances().Insert(fixture.FixInstance(instanceID))
+		require.NoError(t, err)
+
+		expiresAt := time.Now().Add(10 * time.Minute)
+		credentials := &automock.BindingCredentials{}
+		credentials.On("Create", mock.Anything, mock.AnythingOfType("*internal.Instance"), bindingID, int64(600)).Return(bindKubeconfig, expiresAt, nil).Once()
+		defer credentials.AssertExpectations(t)
+
+		svc := broker.NewBind(fixBindingConfig(), memoryStorage.Instances(), memoryStorage.Bindings(), credentials, logrus.StandardLogger())
+
+		// when
+		binding, err := svc.Bind(context.TODO(), instanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, binding.AlreadyExists)
+		assert.Equal(t, bindKubeconfig, binding.Credentials.(map[string]interface{})["kubeconfig"])
+
+		stored, err := memoryStorage.Bindings().Get(instanceID, bindingID)
+		require.NoError(t, err)
+		assert.Equal(t, bindKubeconfig, stored.Kubeconfig)
+		assert.Equal(t, int64(600), stored.ExpirationSeconds)
+	})
+
+	t.Run("should return existing binding for the same parameters", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		err := memoryStorage.Instances().Insert(fixture.FixInstance(instanceID))
+		require.NoError(t, err)
+		err = memoryStorage.Bindings().Insert(fixBinding(time.Now().Add(time.Hour)))
+		require.NoError(t, err)
+
+		credentials := &automock.BindingCredentials{}
+		defer credentials.AssertExpectations(t)
+
+		svc := broker.NewBind(fixBindingConfig(), memoryStorage.Instances(), memoryStorage.Bindings(), credentials, logrus.StandardLogger())
+
+		// when
+		binding, err := svc.Bind(context.TODO(), instanceID, bindingID, domain.BindDetails{
+			RawParameters: json.RawMessage(`{"expiration_seconds": 600}`),
+		}, false)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, binding.AlreadyExists)
+	})
+
+	t.Run("should return conflict for existing binding with different parameters", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		err := memoryStorage.Instances().Insert(fixture.FixInstance(instanceID))
+		require.NoError(t, err)
+		err = memoryStorage.Bindings().Insert(fixBinding(time.Now().Add(time.Hour)))
+		require.NoError(t, err)
+
+		svc := broker.NewBind(fixBindingConfig(), memoryStorage.Instances(), memoryStorage.Bindings(), &automock.BindingCredentials{}, logrus.StandardLogger())
+
+		// when
+		_, err = svc.Bind(context.TODO(), instanceID, bindingID, domain.BindDetails{
+			RawParameters: json.RawMessage(`{"expiration_seconds": 1200}`),
+		}, false)
+
+		// then
+		assert.Equal(t, apiresponses.ErrBindingAlreadyExists, err)
+	})
+
+	t.Run("should issue the expired binding again", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		err := memoryStorage.Instances().Insert(fixture.FixInstance(instanceID))
+		require.NoError(t, err)
+		err = memoryStorage.Bindings().Insert(fixBinding(time.Now().Add(-time.Minute)))
+		require.NoError(t, err)
+
+		expiresAt := time.Now().Add(10 * time.Minute)
+		credentials := &automock.BindingCredentials{}
+		credentials.On("Create", mock.Anything, mock.AnythingOfType("*internal.Instance"), bindingID, int64(600)).Return("reissued", expiresAt, nil).Once()
+		defer credentials.AssertExpectations(t)
+
+		svc := broker.NewBind(fixBindingConfig(), memoryStorage.Instances(), memoryStorage.Bindings(), credentials, logrus.StandardLogger())
+
+		// when
+		binding, err := svc.Bind(context.TODO(), instanceID, bindingID, domain.BindDetails{
+			RawParameters: json.RawMessage(`{"expiration_seconds": 600}`),
+		}, false)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, binding.AlreadyExists)
+		assert.Equal(t, "reissued", binding.Credentials.(map[string]interface{})["kubeconfig"])
+
+		stored, err := memoryStorage.Bindings().Get(instanceID, bindingID)
+		require.NoError(t, err)
+		assert.Equal(t, "reissued", stored.Kubeconfig)
+		assert.False(t, stored.IsExpired())
+	})
+
+	t.Run("should reject binding ID which cannot be used in the service account name", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		err := memoryStorage.Instances().Insert(fixture.FixInstance(instanceID))
+		require.NoError(t, err)
+
+		svc := broker.NewBind(fixBindingConfig(), memoryStorage.Instances(), memoryStorage.Bindings(), &automock.BindingCredentials{}, logrus.StandardLogger())
+
+		// when
+		_, err = svc.Bind(context.TODO(), instanceID, "binding/001", domain.BindDetails{}, false)
+
+		// then
+		require.Error(t, err)
+		apiErr, ok := err.(*apiresponses.FailureResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, apiErr.ValidatedStatusCode(nil))
+	})
+
+	t.Run("should reject expiration out of the allowed range", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		err := memoryStorage.Instances().Insert(fixture.FixInstance(instanceID))
+		require.NoError(t, err)
+
+		svc := broker.NewBind(fixBindingConfig(), memoryStorage.Instances(), memoryStorage.Bindings(), &automock.BindingCredentials{}, logrus.StandardLogger())
+
+		// when
+		_, err = svc.Bind(context.TODO(), instanceID, bindingID, domain.BindDetails{
+			RawParameters: json.RawMessage(`{"expiration_seconds": 60}`),
+		}, false)
+
+		// then
+		require.Error(t, err)
+		apiErr, ok := err.(*apiresponses.FailureResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, apiErr.ValidatedStatusCode(nil))
+	})
+
+	t.Run("should fail when bindings are disabled", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		cfg := fixBindingConfig()
+		cfg.Enabled = false
+
+		svc := broker.NewBind(cfg, memoryStorage.Instances(), memoryStorage.Bindings(), &automock.BindingCredentials{}, logrus.StandardLogger())
+
+		// when
+		_, err := svc.Bind(context.TODO(), instanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		require.Error(t, err)
+		apiErr, ok := err.(*apiresponses.FailureResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, apiErr.ValidatedStatusCode(nil))
+	})
+
+	t.Run("should fail for not existing instance", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+
+		svc := broker.NewBind(fixBindingConfig(), memoryStorage.Instances(), memoryStorage.Bindings(), &automock.BindingCredentials{}, logrus.StandardLogger())
+
+		// when
+		_, err := svc.Bind(context.TODO(), instanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		assert.Equal(t, apiresponses.ErrInstanceDoesNotExist, err)
+	})
+}
+
+func fixBindingConfig() broker.BindingConfig {
+	return broker.BindingConfig{
+		Enabled:              true,
+		ExpirationSeconds:    600,
+		MinExpirationSeconds: 600,
+		MaxExpirationSeconds: 7200,
+	}
+}
+
+func fixBinding(expiresAt time.Time) internal.Binding {
+	return internal.Binding{
+		ID:                bindingID,
+		InstanceID:        instanceID,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+		ExpiresAt:         expiresAt,
+		Kubeconfig:        bindKubeconfig,
+		ExpirationSeconds: 600,
+	}
+}
@@ -2,26 +2,70 @@ package broker
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
 type UnbindEndpoint struct {
+	instancesStorage storage.Instances
+	bindingsStorage  storage.Bindings
+	credentials      BindingCredentials
+
 	log logrus.FieldLogger
 }
 
-func NewUnbind(log logrus.FieldLogger) *UnbindEndpoint {
-	return &UnbindEndpoint{log: log.WithField("service", "UnbindEndpoint")}
+func NewUnbind(instancesStorage storage.Instances, bindingsStorage storage.Bindings, credentials BindingCredentials, log logrus.FieldLogger) *UnbindEndpoint {
+	return &UnbindEndpoint{
+		instancesStorage: instancesStorage,
+		bindingsStorage:  bindingsStorage,
+		credentials:      credentials,
+		log:              log.WithField("service", "UnbindEndpoint"),
+	}
 }
 
 // Unbind deletes an existing service binding
 //   DELETE /v2/service_instances/{instance_id}/service_bindings/{binding_id}
 func (b *UnbindEndpoint) Unbind(ctx context.Context, instanceID, bindingID string, details domain.UnbindDetails, asyncAllowed bool) (domain.UnbindSpec, error) {
-	b.log.Infof("Unbind instanceID: %s", instanceID)
-	b.log.Infof("Unbind details: %+v", details)
-	b.log.Infof("Unbind asyncAllowed: %v", asyncAllowed)
+	logger := b.log.WithFields(logrus.Fields{"instanceID": instanceID, "bindingID": bindingID})
+	logger.Infof("Unbind called, asyncAllowed: %v", asyncAllowed)
+
+	_, err := b.bindingsStorage.Get(instanceID, bindingID)
+	switch {
+	case err == nil:
+	case dberr.IsNotFound(err):
+		return domain.UnbindSpec{}, apiresponses.ErrBindingDoesNotExist
+	default:
+		logger.Errorf("unable to get binding from the storage: %s", err)
+		return domain.UnbindSpec{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to get binding from the storage"), http.StatusInternalServerError, fmt.Sprintf("could not delete binding %s", bindingID))
+	}
+
+	instance, err := b.instancesStorage.GetByID(instanceID)
+	switch {
+	case err == nil && instance.RuntimeID != "":
+		if err := b.credentials.Revoke(ctx, instance, bindingID); err != nil {
+			logger.Errorf("unable to revoke binding credentials: %s", err)
+			return domain.UnbindSpec{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to revoke binding credentials"), http.StatusInternalServerError, fmt.Sprintf("could not delete binding %s", bindingID))
+		}
+	case err == nil, dberr.IsNotFound(err):
+		// the runtime does not exist anymore, so there is nothing to revoke
+		logger.Info("runtime does not exist, skipping revoking binding credentials")
+	default:
+		logger.Errorf("unable to get instance from the storage: %s", err)
+		return domain.UnbindSpec{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to get instance from the storage"), http.StatusInternalServerError, fmt.Sprintf("could not delete binding %s", bindingID))
+	}
+
+	if err := b.bindingsStorage.Delete(instanceID, bindingID); err != nil {
+		logger.Errorf("unable to delete binding: %s", err)
+		return domain.UnbindSpec{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to delete binding"), http.StatusInternalServerError, fmt.Sprintf("could not delete binding %s", bindingID))
+	}
+	logger.Info("binding deleted")
 
-	return domain.UnbindSpec{}, errors.New("not supported")
+	return domain.UnbindSpec{}, nil
 }
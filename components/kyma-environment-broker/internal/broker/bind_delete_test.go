@@ -0,0 +1,74 @@
+package broker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker/automock"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnbindEndpoint_Unbind(t *testing.T) {
+	t.Run("should revoke credentials and delete binding", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		err := memoryStorage.Instances().Insert(fixture.FixInstance(instanceID))
+		require.NoError(t, err)
+		err = memoryStorage.Bindings().Insert(fixBinding(time.Now().Add(time.Hour)))
+		require.NoError(t, err)
+
+		credentials := &automock.BindingCredentials{}
+		credentials.On("Revoke", mock.Anything, mock.AnythingOfType("*internal.Instance"), bindingID).Return(nil).Once()
+		defer credentials.AssertExpectations(t)
+
+		svc := broker.NewUnbind(memoryStorage.Instances(), memoryStorage.Bindings(), credentials, logrus.StandardLogger())
+
+		// when
+		_, err = svc.Unbind(context.TODO(), instanceID, bindingID, domain.UnbindDetails{}, false)
+
+		// then
+		require.NoError(t, err)
+		_, err = memoryStorage.Bindings().Get(instanceID, bindingID)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return gone for not existing binding", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+
+		svc := broker.NewUnbind(memoryStorage.Instances(), memoryStorage.Bindings(), &automock.BindingCredentials{}, logrus.StandardLogger())
+
+		// when
+		_, err := svc.Unbind(context.TODO(), instanceID, bindingID, domain.UnbindDetails{}, false)
+
+		// then
+		assert.Equal(t, apiresponses.ErrBindingDoesNotExist, err)
+	})
+
+	t.Run("should delete binding of not existing instance", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		err := memoryStorage.Bindings().Insert(fixBinding(time.Now().Add(time.Hour)))
+		require.NoError(t, err)
+
+		svc := broker.NewUnbind(memoryStorage.Instances(), memoryStorage.Bindings(), &automock.BindingCredentials{}, logrus.StandardLogger())
+
+		// when
+		_, err = svc.Unbind(context.TODO(), instanceID, bindingID, domain.UnbindDetails{}, false)
+
+		// then
+		require.NoError(t, err)
+		bindings, err := memoryStorage.Bindings().ListByInstanceID(instanceID)
+		require.NoError(t, err)
+		assert.Empty(t, bindings)
+	})
+}
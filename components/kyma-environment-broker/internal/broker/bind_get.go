@@ -2,25 +2,50 @@ package broker
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
 type GetBindingEndpoint struct {
+	bindingsStorage storage.Bindings
+
 	log logrus.FieldLogger
 }
 
-func NewGetBinding(log logrus.FieldLogger) *GetBindingEndpoint {
-	return &GetBindingEndpoint{log: log.WithField("service", "GetBindingEndpoint")}
+func NewGetBinding(bindingsStorage storage.Bindings, log logrus.FieldLogger) *GetBindingEndpoint {
+	return &GetBindingEndpoint{
+		bindingsStorage: bindingsStorage,
+		log:             log.WithField("service", "GetBindingEndpoint"),
+	}
 }
 
 // GetBinding fetches an existing service binding
 //   GET /v2/service_instances/{instance_id}/service_bindings/{binding_id}
 func (b *GetBindingEndpoint) GetBinding(_ context.Context, instanceID, bindingID string, _ domain.FetchBindingDetails) (domain.GetBindingSpec, error) {
-	b.log.Infof("GetBinding instanceID: %s", instanceID)
-	b.log.Infof("GetBinding bindingID: %s", bindingID)
+	logger := b.log.WithFields(logrus.Fields{"instanceID": instanceID, "bindingID": bindingID})
+	logger.Info("GetBinding called")
+
+	binding, err := b.bindingsStorage.Get(instanceID, bindingID)
+	switch {
+	case err == nil:
+	case dberr.IsNotFound(err):
+		return domain.GetBindingSpec{}, apiresponses.ErrBindingNotFound
+	default:
+		logger.Errorf("unable to get binding from the storage: %s", err)
+		return domain.GetBindingSpec{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to get binding from the storage"), http.StatusInternalServerError, fmt.Sprintf("could not get binding %s", bindingID))
+	}
 
-	return domain.GetBindingSpec{}, errors.New("not supported")
+	return domain.GetBindingSpec{
+		Credentials: bindingCredentials(binding),
+		Parameters: BindingParams{
+			ExpirationSeconds: binding.ExpirationSeconds,
+		},
+	}, nil
 }
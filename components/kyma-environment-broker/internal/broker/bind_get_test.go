@@ -0,0 +1,37 @@
+package broker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBindingEndpoint_GetBinding(t *testing.T) {
+	// given
+	memoryStorage := storage.NewMemoryStorage()
+	err := memoryStorage.Bindings().Insert(fixBinding(time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+
+	svc := broker.NewGetBinding(memoryStorage.Bindings(), logrus.StandardLogger())
+
+	// when
+	spec, err := svc.GetBinding(context.TODO(), instanceID, bindingID, domain.FetchBindingDetails{})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, bindKubeconfig, spec.Credentials.(map[string]interface{})["kubeconfig"])
+
+	// when
+	_, err = svc.GetBinding(context.TODO(), instanceID, "not-existing", domain.FetchBindingDetails{})
+
+	// then
+	assert.Equal(t, apiresponses.ErrBindingNotFound, err)
+}
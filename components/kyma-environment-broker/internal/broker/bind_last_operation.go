@@ -2,26 +2,50 @@ package broker
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
 type LastBindingOperationEndpoint struct {
+	bindingsStorage storage.Bindings
+
 	log logrus.FieldLogger
 }
 
-func NewLastBindingOperation(log logrus.FieldLogger) *LastBindingOperationEndpoint {
-	return &LastBindingOperationEndpoint{log: log.WithField("service", "LastBindingOperationEndpoint")}
+func NewLastBindingOperation(bindingsStorage storage.Bindings, log logrus.FieldLogger) *LastBindingOperationEndpoint {
+	return &LastBindingOperationEndpoint{
+		bindingsStorage: bindingsStorage,
+		log:             log.WithField("service", "LastBindingOperationEndpoint"),
+	}
 }
 
 // LastBindingOperation fetches last operation state for a service binding
 //   GET /v2/service_instances/{instance_id}/service_bindings/{binding_id}/last_operation
+//
+// Bindings are created synchronously, so an existing binding is always reported as succeeded.
 func (b *LastBindingOperationEndpoint) LastBindingOperation(ctx context.Context, instanceID, bindingID string, details domain.PollDetails) (domain.LastOperation, error) {
-	b.log.Infof("LastBindingOperation instanceID: %s", instanceID)
-	b.log.Infof("LastBindingOperation bindingID: %s", bindingID)
-	b.log.Infof("LastBindingOperation details: %+v", details)
+	logger := b.log.WithFields(logrus.Fields{"instanceID": instanceID, "bindingID": bindingID})
+	logger.Infof("LastBindingOperation called, details: %+v", details)
+
+	_, err := b.bindingsStorage.Get(instanceID, bindingID)
+	switch {
+	case err == nil:
+	case dberr.IsNotFound(err):
+		return domain.LastOperation{}, apiresponses.ErrBindingDoesNotExist
+	default:
+		logger.Errorf("unable to get binding from the storage: %s", err)
+		return domain.LastOperation{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to get binding from the storage"), http.StatusInternalServerError, fmt.Sprintf("could not get binding %s", bindingID))
+	}
 
-	return domain.LastOperation{}, errors.New("not supported")
+	return domain.LastOperation{
+		State:       domain.Succeeded,
+		Description: "binding created",
+	}, nil
 }
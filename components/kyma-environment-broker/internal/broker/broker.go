@@ -38,6 +38,19 @@ type Config struct {
 	URL                             string
 	EnableKubeconfigURLLabel        bool `envconfig:"default=false"`
 	IncludeAdditionalParamsInSchema bool `envconfig:"default=false"`
+
+	Binding BindingConfig
+}
+
+// BindingConfig represents configuration for service bindings
+type BindingConfig struct {
+	Enabled bool `envconfig:"default=false"`
+	// ExpirationSeconds is used when the binding parameters do not specify the expiration
+	ExpirationSeconds    int64 `envconfig:"default=600"`
+	MinExpirationSeconds int64 `envconfig:"default=600"`
+	MaxExpirationSeconds int64 `envconfig:"default=7200"`
+	// ClusterRole is bound to the service account created in the runtime for every binding
+	ClusterRole string `envconfig:"default=view"`
 }
 
 type ServicesConfig map[string]Service
@@ -232,6 +232,12 @@ func (b *ProvisionEndpoint) validateAndExtract(details domain.ProvisionDetails,
 			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
 		}
 	}
+	if err := validateHibernationSchedules(details.PlanID, parameters.HibernationSchedules); err != nil {
+		return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if err := validateWorkerPools(details.PlanID, parameters.WorkerPools); err != nil {
+		return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
 
 	planValidator, err := b.validator(&details, provider)
 	if err != nil {
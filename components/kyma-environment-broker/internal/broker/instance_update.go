@@ -192,6 +192,14 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 			return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
 		}
 	}
+	if err := validateHibernationSchedules(instance.ServicePlanID, params.HibernationSchedules); err != nil {
+		logger.Errorf("invalid hibernation schedules: %s", err.Error())
+		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if err := validateWorkerPools(instance.ServicePlanID, params.WorkerPools); err != nil {
+		logger.Errorf("invalid worker pools: %s", err.Error())
+		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
 
 	operationID := uuid.New().String()
 	logger = logger.WithField("operationID", operationID)
@@ -235,6 +243,16 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 		updateStorage = append(updateStorage, "Runtime Administrators")
 	}
 
+	if params.HibernationSchedules != nil {
+		instance.Parameters.Parameters.HibernationSchedules = params.HibernationSchedules
+		updateStorage = append(updateStorage, "Hibernation schedules")
+	}
+
+	if params.WorkerPools != nil {
+		instance.Parameters.Parameters.WorkerPools = params.WorkerPools
+		updateStorage = append(updateStorage, "Worker pools")
+	}
+
 	if params.UpdateAutoScaler(&instance.Parameters.Parameters) {
 		updateStorage = append(updateStorage, "Auto Scaler parameters")
 	}
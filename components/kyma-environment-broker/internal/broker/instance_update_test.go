@@ -391,6 +391,138 @@ func TestUpdateEndpoint_UpdateParameters(t *testing.T) {
 	})
 }
 
+func TestUpdateEndpoint_UpdateHibernationSchedules(t *testing.T) {
+	// given
+	instance := fixture.FixInstance(instanceID)
+	st := storage.NewMemoryStorage()
+	st.Instances().Insert(instance)
+	st.Operations().InsertProvisioningOperation(fixProvisioningOperation("provisioning01"))
+
+	handler := &handler{}
+	q := process.NewQueue(nil, logrus.New())
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, true, q, planDefaults, logrus.New(), enabledDashboardConfig)
+
+	t.Run("Should fail on invalid hibernation schedule location", func(t *testing.T) {
+		// given
+		scheduleParams := `{"start":"00 20 * * 1,2,3,4,5","location":"Mars/Olympus"}`
+		errMsg := errors.New("hibernation schedule 0: location must be a valid time zone")
+		expectedErr := apiresponses.NewFailureResponse(errMsg, http.StatusUnprocessableEntity, errMsg.Error())
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			ServiceID:       "",
+			PlanID:          AzurePlanID,
+			RawParameters:   json.RawMessage("{\"hibernationSchedules\":[" + scheduleParams + "]}"),
+			PreviousValues:  domain.PreviousValues{},
+			RawContext:      json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+			MaintenanceInfo: nil,
+		}, true)
+
+		// then
+		require.Error(t, err)
+		assert.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, expectedErr.ValidatedStatusCode(nil), apierr.ValidatedStatusCode(nil))
+		assert.EqualError(t, apierr, errMsg.Error())
+	})
+
+	t.Run("Should store hibernation schedules in the instance", func(t *testing.T) {
+		// given
+		scheduleParams := `{"start":"00 20 * * 1,2,3,4,5","end":"00 08 * * 1,2,3,4,5","location":"Europe/Berlin"}`
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			ServiceID:       "",
+			PlanID:          AzurePlanID,
+			RawParameters:   json.RawMessage("{\"hibernationSchedules\":[" + scheduleParams + "]}"),
+			PreviousValues:  domain.PreviousValues{},
+			RawContext:      json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+			MaintenanceInfo: nil,
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		inst, err := st.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.Equal(t, []internal.HibernationScheduleDTO{
+			{Start: "00 20 * * 1,2,3,4,5", End: "00 08 * * 1,2,3,4,5", Location: "Europe/Berlin"},
+		}, inst.Parameters.Parameters.HibernationSchedules)
+	})
+}
+
+func TestUpdateEndpoint_UpdateWorkerPools(t *testing.T) {
+	// given
+	instance := fixture.FixInstance(instanceID)
+	st := storage.NewMemoryStorage()
+	st.Instances().Insert(instance)
+	st.Operations().InsertProvisioningOperation(fixProvisioningOperation("provisioning01"))
+
+	handler := &handler{}
+	q := process.NewQueue(nil, logrus.New())
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, true, q, planDefaults, logrus.New(), enabledDashboardConfig)
+
+	t.Run("Should fail on invalid worker pool", func(t *testing.T) {
+		// given
+		poolParams := `{"name":"gpu","machineType":"Standard_NC6s_v3","autoScalerMin":3,"autoScalerMax":1}`
+		errMsg := errors.New("worker pool gpu: autoScalerMax must be greater than or equal to autoScalerMin")
+		expectedErr := apiresponses.NewFailureResponse(errMsg, http.StatusUnprocessableEntity, errMsg.Error())
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			ServiceID:       "",
+			PlanID:          AzurePlanID,
+			RawParameters:   json.RawMessage("{\"workerPools\":[" + poolParams + "]}"),
+			PreviousValues:  domain.PreviousValues{},
+			RawContext:      json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+			MaintenanceInfo: nil,
+		}, true)
+
+		// then
+		require.Error(t, err)
+		assert.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, expectedErr.ValidatedStatusCode(nil), apierr.ValidatedStatusCode(nil))
+		assert.EqualError(t, apierr, errMsg.Error())
+	})
+
+	t.Run("Should store worker pools in the instance", func(t *testing.T) {
+		// given
+		poolParams := `{"name":"gpu","machineType":"Standard_NC6s_v3","autoScalerMin":0,"autoScalerMax":2,"taints":[{"key":"nvidia.com/gpu","effect":"NoSchedule"}]}`
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			ServiceID:       "",
+			PlanID:          AzurePlanID,
+			RawParameters:   json.RawMessage("{\"workerPools\":[" + poolParams + "]}"),
+			PreviousValues:  domain.PreviousValues{},
+			RawContext:      json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+			MaintenanceInfo: nil,
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		inst, err := st.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.Equal(t, []internal.WorkerPoolDTO{
+			{
+				Name:          "gpu",
+				MachineType:   "Standard_NC6s_v3",
+				AutoScalerMin: 0,
+				AutoScalerMax: 2,
+				Taints:        []internal.TaintDTO{{Key: "nvidia.com/gpu", Effect: "NoSchedule"}},
+			},
+		}, inst.Parameters.Parameters.WorkerPools)
+	})
+}
+
 func TestUpdateEndpoint_UpdateWithEnabledDashboard(t *testing.T) {
 	// given
 	instance := internal.Instance{
@@ -1,6 +1,7 @@
 package broker
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/jsonschema"
@@ -148,6 +149,8 @@ func AzureSchema(machineTypes []string, additionalParams, update bool) *map[stri
 func AzureLiteSchema(machineTypes []string, additionalParams, update bool) *map[string]interface{} {
 	properties := NewProvisioningProperties(machineTypes, AzureRegions(), update)
 	properties.AutoScalerMax.Maximum = 40
+	properties.HibernationSchedules = NewHibernationSchedulesSchema()
+	properties.WorkerPools = NewWorkerPoolsSchema()
 
 	if !update {
 		properties.AutoScalerMax.Default = 10
@@ -223,6 +226,8 @@ func empty() *map[string]interface{} {
 
 func createSchema(machineTypes, regions []string, additionalParams, update bool) *map[string]interface{} {
 	properties := NewProvisioningProperties(machineTypes, regions, update)
+	properties.HibernationSchedules = NewHibernationSchedulesSchema()
+	properties.WorkerPools = NewWorkerPoolsSchema()
 	return createSchemaWithProperties(properties, additionalParams, update)
 }
 
@@ -364,6 +369,61 @@ func IsFreemiumPlan(planID string) bool {
 	}
 }
 
+// SupportsHibernationSchedules returns true for plans which allow to configure hibernation schedules
+func SupportsHibernationSchedules(planID string) bool {
+	switch planID {
+	case AWSPlanID, GCPPlanID, AzurePlanID, AzureLitePlanID, OpenStackPlanID:
+		return true
+	default:
+		return false
+	}
+}
+
+func validateHibernationSchedules(planID string, schedules []internal.HibernationScheduleDTO) error {
+	if len(schedules) == 0 {
+		return nil
+	}
+	if !SupportsHibernationSchedules(planID) {
+		return fmt.Errorf("hibernation schedules are not supported for the plan %s", PlanNamesMapping[planID])
+	}
+	for i, schedule := range schedules {
+		if err := schedule.Validate(); err != nil {
+			return fmt.Errorf("hibernation schedule %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// SupportsWorkerPools returns true for plans which allow to configure additional worker pools
+func SupportsWorkerPools(planID string) bool {
+	switch planID {
+	case AWSPlanID, GCPPlanID, AzurePlanID, AzureLitePlanID, OpenStackPlanID:
+		return true
+	default:
+		return false
+	}
+}
+
+func validateWorkerPools(planID string, pools []internal.WorkerPoolDTO) error {
+	if len(pools) == 0 {
+		return nil
+	}
+	if !SupportsWorkerPools(planID) {
+		return fmt.Errorf("worker pools are not supported for the plan %s", PlanNamesMapping[planID])
+	}
+	names := make(map[string]struct{}, len(pools))
+	for _, pool := range pools {
+		if err := pool.Validate(); err != nil {
+			return fmt.Errorf("worker pool %s: %w", pool.Name, err)
+		}
+		if _, found := names[pool.Name]; found {
+			return fmt.Errorf("worker pool %s: name must be unique", pool.Name)
+		}
+		names[pool.Name] = struct{}{}
+	}
+	return nil
+}
+
 func filter(items *[]interface{}, included map[string]interface{}) interface{} {
 	output := make([]interface{}, 0)
 	for i := 0; i < len(*items); i++ {
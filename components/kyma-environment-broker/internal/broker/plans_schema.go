@@ -1,6 +1,10 @@
 package broker
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+)
 
 type RootSchema struct {
 	Schema string `json:"$schema"`
@@ -24,10 +28,12 @@ type ProvisioningProperties struct {
 }
 
 type UpdateProperties struct {
-	AutoScalerMin  *Type     `json:"autoScalerMin,omitempty"`
-	AutoScalerMax  *Type     `json:"autoScalerMax,omitempty"`
-	OIDC           *OIDCType `json:"oidc,omitempty"`
-	Administrators *Type     `json:"administrators,omitempty"`
+	AutoScalerMin        *Type                     `json:"autoScalerMin,omitempty"`
+	AutoScalerMax        *Type                     `json:"autoScalerMax,omitempty"`
+	OIDC                 *OIDCType                 `json:"oidc,omitempty"`
+	Administrators       *Type                     `json:"administrators,omitempty"`
+	HibernationSchedules *HibernationSchedulesType `json:"hibernationSchedules,omitempty"`
+	WorkerPools          *WorkerPoolsType          `json:"workerPools,omitempty"`
 }
 
 func (up *UpdateProperties) IncludeAdditional() {
@@ -50,6 +56,64 @@ type OIDCType struct {
 	Required   []string       `json:"required"`
 }
 
+type HibernationScheduleProperties struct {
+	Start    Type `json:"start"`
+	End      Type `json:"end"`
+	Location Type `json:"location"`
+}
+
+type HibernationScheduleType struct {
+	Type
+	Properties HibernationScheduleProperties `json:"properties"`
+}
+
+type HibernationSchedulesType struct {
+	Type
+	Items HibernationScheduleType `json:"items"`
+}
+
+type WorkerPoolProperties struct {
+	Name          Type       `json:"name"`
+	MachineType   Type       `json:"machineType"`
+	AutoScalerMin Type       `json:"autoScalerMin"`
+	AutoScalerMax Type       `json:"autoScalerMax"`
+	Labels        LabelsType `json:"labels"`
+	Taints        TaintsType `json:"taints"`
+}
+
+type WorkerPoolType struct {
+	Type
+	Properties WorkerPoolProperties `json:"properties"`
+	Required   []string             `json:"required"`
+}
+
+type WorkerPoolsType struct {
+	Type
+	Items WorkerPoolType `json:"items"`
+}
+
+type LabelsType struct {
+	Type
+	AdditionalProperties Type `json:"additionalProperties"`
+}
+
+type TaintProperties struct {
+	Key    Type `json:"key"`
+	Value  Type `json:"value"`
+	Effect Type `json:"effect"`
+}
+
+type TaintType struct {
+	Type
+	Properties TaintProperties `json:"properties"`
+	Required   []string        `json:"required"`
+}
+
+type TaintsType struct {
+	Type
+	Items TaintType `json:"items"`
+}
+
 type Type struct {
 	Type        string `json:"type"`
 	Title       string `json:"title,omitempty"`
@@ -155,6 +219,61 @@ func NewOIDCSchema() *OIDCType {
 	}
 }
 
+func NewHibernationSchedulesSchema() *HibernationSchedulesType {
+	cronPattern := `^\S+\s+\S+\s+\S+\s+\S+\s+\S+$`
+	return &HibernationSchedulesType{
+		Type: Type{
+			Type:        "array",
+			Title:       "Hibernation Schedules",
+			Description: "Specifies when the cluster is hibernated and woken up",
+		},
+		Items: HibernationScheduleType{
+			Type: Type{Type: "object"},
+			Properties: HibernationScheduleProperties{
+				Start:    Type{Type: "string", Pattern: cronPattern, Description: "The cron expression at which the cluster is hibernated, for example '00 20 * * 1,2,3,4,5'."},
+				End:      Type{Type: "string", Pattern: cronPattern, Description: "The cron expression at which the cluster is woken up, for example '00 08 * * 1,2,3,4,5'."},
+				Location: Type{Type: "string", Description: "The time zone in which the cron expressions are evaluated, for example 'Europe/Berlin'. If not provided, UTC is used."},
+			},
+		},
+	}
+}
+
+func NewWorkerPoolsSchema() *WorkerPoolsType {
+	return &WorkerPoolsType{
+		Type: Type{
+			Type:        "array",
+			Title:       "Worker Pools",
+			Description: "Specifies the additional worker pools created next to the main one",
+		},
+		Items: WorkerPoolType{
+			Type: Type{Type: "object"},
+			Properties: WorkerPoolProperties{
+				Name:          Type{Type: "string", Pattern: "^[a-z0-9]([a-z0-9-]{0,13}[a-z0-9])?$", Description: "The name of the worker pool, at most 15 lower case alphanumeric characters or '-'."},
+				MachineType:   Type{Type: "string", MinLength: 1, Description: "The machine type of the worker pool nodes."},
+				AutoScalerMin: Type{Type: "integer", Description: "Specifies the minimum number of virtual machines in the worker pool."},
+				AutoScalerMax: Type{Type: "integer", Description: "Specifies the maximum number of virtual machines in the worker pool."},
+				Labels: LabelsType{
+					Type:                 Type{Type: "object", Description: "The labels set on the worker pool nodes."},
+					AdditionalProperties: Type{Type: "string"},
+				},
+				Taints: TaintsType{
+					Type: Type{Type: "array", Description: "The taints set on the worker pool nodes."},
+					Items: TaintType{
+						Type: Type{Type: "object"},
+						Properties: TaintProperties{
+							Key:    Type{Type: "string"},
+							Value:  Type{Type: "string"},
+							Effect: Type{Type: "string", Enum: ToInterfaceSlice(internal.TaintEffects())},
+						},
+						Required: []string{"key", "effect"},
+					},
+				},
+			},
+			Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
+		},
+	}
+}
+
 func NewSchema(properties interface{}, update bool) *RootSchema {
 	schema := &RootSchema{
 		Schema: "http://json-schema.org/draft-04/schema#",
@@ -185,7 +304,7 @@ func unmarshalOrPanic(from, to interface{}) interface{} {
 }
 
 func DefaultControlsOrder() []string {
-	return []string{"name", "region", "machineType", "autoScalerMin", "autoScalerMax", "zonesCount", "oidc", "administrators"}
+	return []string{"name", "region", "machineType", "autoScalerMin", "autoScalerMax", "zonesCount", "oidc", "administrators", "hibernationSchedules", "workerPools"}
 }
 
 func ToInterfaceSlice(input []string) []interface{} {
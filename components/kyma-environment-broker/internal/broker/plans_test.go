@@ -171,3 +171,122 @@ func readJsonFile(t *testing.T, file string) string {
 
 	return string(yamlFile)
 }
+
+func TestValidateHibernationSchedules(t *testing.T) {
+	validSchedules := []internal.HibernationScheduleDTO{
+		{Start: "00 20 * * 1,2,3,4,5", End: "00 08 * * 1,2,3,4,5", Location: "Europe/Berlin"},
+	}
+
+	for name, tc := range map[string]struct {
+		planID    string
+		schedules []internal.HibernationScheduleDTO
+		expectErr string
+	}{
+		"no schedules": {
+			planID: TrialPlanID,
+		},
+		"valid schedules": {
+			planID:    AWSPlanID,
+			schedules: validSchedules,
+		},
+		"plan not supporting schedules": {
+			planID:    TrialPlanID,
+			schedules: validSchedules,
+			expectErr: "hibernation schedules are not supported for the plan trial",
+		},
+		"schedule without start and end": {
+			planID:    GCPPlanID,
+			schedules: append(validSchedules, internal.HibernationScheduleDTO{Location: "UTC"}),
+			expectErr: "hibernation schedule 1: start or end must not be empty",
+		},
+		"invalid cron and location": {
+			planID:    AzurePlanID,
+			schedules: []internal.HibernationScheduleDTO{{Start: "* * *", Location: "Nowhere"}},
+			expectErr: "hibernation schedule 0: start must be a valid cron expression, location must be a valid time zone",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := validateHibernationSchedules(tc.planID, tc.schedules)
+
+			// then
+			if tc.expectErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectErr)
+			}
+		})
+	}
+}
+
+func TestValidateWorkerPools(t *testing.T) {
+	gpuPool := internal.WorkerPoolDTO{
+		Name:          "gpu",
+		MachineType:   "n1-highmem-8",
+		AutoScalerMin: 0,
+		AutoScalerMax: 2,
+		Labels:        map[string]string{"accelerator": "gpu"},
+		Taints:        []internal.TaintDTO{{Key: "nvidia.com/gpu", Effect: "NoSchedule"}},
+	}
+
+	for name, tc := range map[string]struct {
+		planID    string
+		pools     []internal.WorkerPoolDTO
+		expectErr string
+	}{
+		"no worker pools": {
+			planID: TrialPlanID,
+		},
+		"valid worker pools": {
+			planID: GCPPlanID,
+			pools:  []internal.WorkerPoolDTO{gpuPool},
+		},
+		"plan not supporting worker pools": {
+			planID:    TrialPlanID,
+			pools:     []internal.WorkerPoolDTO{gpuPool},
+			expectErr: "worker pools are not supported for the plan trial",
+		},
+		"duplicated name": {
+			planID:    AWSPlanID,
+			pools:     []internal.WorkerPoolDTO{gpuPool, gpuPool},
+			expectErr: "worker pool gpu: name must be unique",
+		},
+		"name of the main worker pool": {
+			planID:    AzurePlanID,
+			pools:     []internal.WorkerPoolDTO{{Name: "cpu-worker-0", MachineType: "Standard_D8_v3", AutoScalerMax: 1}},
+			expectErr: "worker pool cpu-worker-0: name cpu-worker-0 is reserved for the main worker pool",
+		},
+		"invalid machine type, range and taint": {
+			planID: OpenStackPlanID,
+			pools: []internal.WorkerPoolDTO{{
+				Name:          "memory",
+				AutoScalerMin: 3,
+				AutoScalerMax: 1,
+				Taints:        []internal.TaintDTO{{Key: "dedicated", Effect: "Sometimes"}},
+			}},
+			expectErr: "worker pool memory: machineType must not be empty, autoScalerMax must be greater than or equal to autoScalerMin, taint effect must be one of NoSchedule, PreferNoSchedule, NoExecute",
+		},
+		"invalid label key": {
+			planID: AzureLitePlanID,
+			pools: []internal.WorkerPoolDTO{{
+				Name:          "memory",
+				MachineType:   "Standard_D4_v3",
+				AutoScalerMax: 1,
+				Labels:        map[string]string{"node.kubernetes.io/role": "memory"},
+			}},
+			expectErr: "worker pool memory: label key node.kubernetes.io/role must consist of alphanumeric characters or '_'",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := validateWorkerPools(tc.planID, tc.pools)
+
+			// then
+			if tc.expectErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectErr)
+			}
+		})
+	}
+}
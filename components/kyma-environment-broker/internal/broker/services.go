@@ -65,7 +65,8 @@ func (b *ServicesEndpoint) Services(ctx context.Context) ([]domain.Service, erro
 			ID:                   KymaServiceID,
 			Name:                 KymaServiceName,
 			Description:          class.Description,
-			Bindable:             false,
+			Bindable:             b.cfg.Binding.Enabled,
+			BindingsRetrievable:  b.cfg.Binding.Enabled,
 			InstancesRetrievable: true,
 			Tags: []string{
 				"SAP",
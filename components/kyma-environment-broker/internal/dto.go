@@ -4,7 +4,11 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"regexp"
 	"strings"
+	"time"
+	// hibernation schedule locations are validated in images without the system time zone database
+	_ "time/tzdata"
 )
 
 const (
@@ -75,6 +79,120 @@ func (o *OIDCConfigDTO) validSigningAlgsSet() map[string]bool {
 	return signingAlgsSet
 }
 
+var cronFieldRegexp = regexp.MustCompile(`^[0-9A-Za-z*?,/-]+$`)
+
+// HibernationScheduleDTO defines when the cluster is hibernated (start) and woken up (end),
+// both are cron expressions evaluated in the given location (UTC if not provided)
+type HibernationScheduleDTO struct {
+	Start    string `json:"start,omitempty"`
+	End      string `json:"end,omitempty"`
+	Location string `json:"location,omitempty"`
+}
+
+func (s HibernationScheduleDTO) Validate() error {
+	errs := make([]string, 0)
+	if len(s.Start) == 0 && len(s.End) == 0 {
+		errs = append(errs, "start or end must not be empty")
+	}
+	if len(s.Start) != 0 && !isCronExpression(s.Start) {
+		errs = append(errs, "start must be a valid cron expression")
+	}
+	if len(s.End) != 0 && !isCronExpression(s.End) {
+		errs = append(errs, "end must be a valid cron expression")
+	}
+	if len(s.Location) != 0 {
+		if _, err := time.LoadLocation(s.Location); err != nil {
+			errs = append(errs, "location must be a valid time zone")
+		}
+	}
+
+	if len(errs) > 0 {
+		err := fmt.Errorf(strings.Join(errs, ", "))
+		return err
+	}
+	return nil
+}
+
+// isCronExpression checks if the expression consists of the five standard cron fields
+func isCronExpression(expression string) bool {
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return false
+	}
+	for _, field := range fields {
+		if !cronFieldRegexp.MatchString(field) {
+			return false
+		}
+	}
+	return true
+}
+
+var workerPoolNameRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,13}[a-z0-9])?$`)
+
+// label keys are passed to the provisioner as GraphQL object fields, so they must be valid GraphQL names
+var labelKeyRegexp = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// WorkerPoolDTO defines an additional worker pool created next to the main one,
+// the values which are not provided (for example the machine image) are taken from the main worker pool
+type WorkerPoolDTO struct {
+	Name          string            `json:"name"`
+	MachineType   string            `json:"machineType"`
+	AutoScalerMin int               `json:"autoScalerMin"`
+	AutoScalerMax int               `json:"autoScalerMax"`
+	Labels        map[string]string `json:"labels,omitempty"`
+	Taints        []TaintDTO        `json:"taints,omitempty"`
+}
+
+type TaintDTO struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
+func (p WorkerPoolDTO) Validate() error {
+	errs := make([]string, 0)
+	if !workerPoolNameRegexp.MatchString(p.Name) {
+		errs = append(errs, "name must consist of at most 15 lower case alphanumeric characters or '-'")
+	}
+	if p.Name == mainWorkerPoolName {
+		errs = append(errs, fmt.Sprintf("name %s is reserved for the main worker pool", mainWorkerPoolName))
+	}
+	if len(p.MachineType) == 0 {
+		errs = append(errs, "machineType must not be empty")
+	}
+	if p.AutoScalerMin < 0 || p.AutoScalerMax < p.AutoScalerMin {
+		errs = append(errs, "autoScalerMax must be greater than or equal to autoScalerMin")
+	}
+	for key := range p.Labels {
+		if !labelKeyRegexp.MatchString(key) {
+			errs = append(errs, fmt.Sprintf("label key %s must consist of alphanumeric characters or '_'", key))
+		}
+	}
+	for _, taint := range p.Taints {
+		if len(taint.Key) == 0 {
+			errs = append(errs, "taint key must not be empty")
+		}
+		if !taintEffects[taint.Effect] {
+			errs = append(errs, fmt.Sprintf("taint effect must be one of %s", strings.Join(TaintEffects(), ", ")))
+		}
+	}
+
+	if len(errs) > 0 {
+		err := fmt.Errorf(strings.Join(errs, ", "))
+		return err
+	}
+	return nil
+}
+
+const mainWorkerPoolName = "cpu-worker-0"
+
+var taintEffects = map[string]bool{"NoSchedule": true, "PreferNoSchedule": true, "NoExecute": true}
+
+// TaintEffects returns the Kubernetes taint effects which can be set on the worker pool nodes
+func TaintEffects() []string {
+	return []string{"NoSchedule", "PreferNoSchedule", "NoExecute"}
+}
+
 type ProvisioningParameters struct {
 	PlanID     string                    `json:"plan_id"`
 	ServiceID  string                    `json:"service_id"`
@@ -178,7 +296,9 @@ type ProvisioningParametersDTO struct {
 	//Provider - used in Trial plan to determine which cloud provider to use during provisioning
 	Provider *CloudProvider `json:"provider"`
 
-	OIDC *OIDCConfigDTO `json:"oidc,omitempty"`
+	OIDC                 *OIDCConfigDTO           `json:"oidc,omitempty"`
+	HibernationSchedules []HibernationScheduleDTO `json:"hibernationSchedules,omitempty"`
+	WorkerPools          []WorkerPoolDTO          `json:"workerPools,omitempty"`
 }
 
 type UpdatingParametersDTO struct {
@@ -186,6 +306,10 @@ type UpdatingParametersDTO struct {
 
 	OIDC                  *OIDCConfigDTO `json:"oidc,omitempty"`
 	RuntimeAdministrators []string       `json:"administrators,omitempty"`
+	// HibernationSchedules replace the current schedules if provided, an empty list removes them
+	HibernationSchedules []HibernationScheduleDTO `json:"hibernationSchedules"`
+	// WorkerPools replace the current additional worker pools if provided, an empty list removes them
+	WorkerPools []WorkerPoolDTO `json:"workerPools"`
 }
 
 func (u UpdatingParametersDTO) UpdateAutoScaler(p *ProvisioningParametersDTO) bool {
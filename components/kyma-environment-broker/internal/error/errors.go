@@ -29,6 +29,8 @@ type ErrReason string
 const (
 	ErrKEBInternal              ErrReason = "err_keb_internal"
 	ErrKEBTimeOut               ErrReason = "err_keb_timeout"
+	ErrKEBStepTimeOut           ErrReason = "err_keb_step_timeout"
+	ErrKEBStepRetriesExceeded   ErrReason = "err_keb_step_retries_exceeded"
 	ErrProvisionerNilLastError  ErrReason = "err_provisioner_nil_last_error"
 	ErrHttpStatusCode           ErrReason = "err_http_status_code"
 	ErrReconcilerNilFailures    ErrReason = "err_reconciler_nil_failures"
@@ -0,0 +1,34 @@
+package error
+
+import (
+	"fmt"
+	"time"
+)
+
+// StepError is returned when a step exceeds its time limit or the number of retries
+type StepError struct {
+	step    string
+	message string
+	reason  ErrReason
+}
+
+func NewStepTimeoutError(step string, timeLimit time.Duration) *StepError {
+	return &StepError{
+		step:    step,
+		message: fmt.Sprintf("step %s has reached the time limit of %s", step, timeLimit),
+		reason:  ErrKEBStepTimeOut,
+	}
+}
+
+func NewStepRetriesExceededError(step string, maxRetries int) *StepError {
+	return &StepError{
+		step:    step,
+		message: fmt.Sprintf("step %s has reached the limit of %d retries", step, maxRetries),
+		reason:  ErrKEBStepRetriesExceeded,
+	}
+}
+
+func (se StepError) Error() string        { return se.message }
+func (se StepError) Step() string         { return se.step }
+func (se StepError) Reason() ErrReason    { return se.reason }
+func (StepError) Component() ErrComponent { return ErrKEB }
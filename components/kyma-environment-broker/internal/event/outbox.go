@@ -0,0 +1,353 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type OutboxConfig struct {
+	Enabled      bool          `envconfig:"default=false"`
+	PollInterval time.Duration `envconfig:"default=1s"`
+	BatchSize    int           `envconfig:"default=100"`
+	// MaxDeliveryAttempts is the number of polls which deliver the event to the subscriber before it is saved
+	// as a dead letter and skipped, 0 delivers the event until it succeeds
+	MaxDeliveryAttempts int `envconfig:"default=10"`
+}
+
+// EventDeadLettered is published when the dispatcher saves the event as a dead letter of the durable subscriber
+type EventDeadLettered struct {
+	Subscriber string
+	Sequence   int64
+	EventType  string
+	Attempts   int
+}
+
+// DurableEvent is implemented by events which can be delivered to durable subscribers.
+// OutboxEvent returns the serializable form of the event, which is kept in the outbox and passed to the durable handler.
+type DurableEvent interface {
+	OutboxEvent() interface{}
+}
+
+// TransactionalEvent is implemented by durable events which the storage of operations saves in the outbox in the same
+// transaction as the operation, see NewDurablePubSub. Publishing such an event does not save it again.
+type TransactionalEvent interface {
+	DurableEvent
+	SavedWithOperation()
+}
+
+type DurableSubscriber interface {
+	SubscribeDurable(name string, evType DurableEvent, evHandler Handler)
+	Durable() bool
+}
+
+// deliveryFailure counts failed deliveries of the first pending event of the subscriber
+type deliveryFailure struct {
+	sequence int64
+	attempts int
+}
+
+type durableSubscriber struct {
+	name      string
+	eventType string
+	dataType  reflect.Type
+	handler   Handler
+}
+
+// NewDurablePubSub creates the event broker which, apart from the in-memory dispatching, saves events with durable subscribers
+// in the outbox. The events function returns transactional events of the operation, they are saved by the storage of operations
+// in the same transaction as the operation. Other durable events are saved when they are published, after the operation
+// is saved, so they are lost if the outbox cannot be written. The dispatcher delivers events saved in the outbox at least once
+// and in order to every durable subscriber.
+func NewDurablePubSub(outbox storage.Outbox, events func(op internal.Operation) []DurableEvent, cfg OutboxConfig, log logrus.FieldLogger) *PubSub {
+	b := NewPubSub(log)
+	b.outbox = outbox
+	b.outboxConfig = cfg
+	b.failures = make(map[string]deliveryFailure)
+	outbox.SetOperationEvents(func(op internal.Operation) ([]internal.OutboxEvent, error) {
+		return b.outboxEvents(events(op))
+	})
+	return b
+}
+
+// Durable returns true if the events are saved in the outbox. Without the outbox transactional events are not published,
+// because they are created only by the storage of operations.
+func (b *PubSub) Durable() bool {
+	return b.outbox != nil
+}
+
+// SubscribeDurable registers the handler under the unique name, the name identifies the checkpoint of the subscriber.
+// The handler gets the value returned by OutboxEvent of the event. Returning an error makes the dispatcher
+// deliver the event again in the next poll. After MaxDeliveryAttempts failed polls the event is saved as a dead letter
+// and the checkpoint moves past it, so later events are not blocked.
+func (b *PubSub) SubscribeDurable(name string, evType DurableEvent, evHandler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.outbox == nil {
+		b.log.Warnf("outbox is not configured, the durable subscriber %s gets events only in memory", name)
+		b.handlers[reflect.TypeOf(evType)] = append(b.handlers[reflect.TypeOf(evType)], func(ctx context.Context, ev interface{}) error {
+			return evHandler(ctx, ev.(DurableEvent).OutboxEvent())
+		})
+		return
+	}
+
+	b.durable = append(b.durable, durableSubscriber{
+		name:      name,
+		eventType: eventTypeName(evType),
+		dataType:  reflect.TypeOf(evType.OutboxEvent()),
+		handler:   evHandler,
+	})
+}
+
+// RunDispatcher starts delivering events from the outbox when the ready channel is closed, nil starts it immediately.
+// Every durable subscriber is delivered by its own worker, so a slow subscriber does not delay others.
+func (b *PubSub) RunDispatcher(ready <-chan struct{}, stop <-chan struct{}) {
+	if b.outbox == nil {
+		return
+	}
+	go func() {
+		if ready != nil {
+			select {
+			case <-ready:
+			case <-stop:
+				return
+			}
+		}
+		for name, handlers := range b.durableSubscribers() {
+			go func(name string, handlers []durableSubscriber) {
+				wait.Until(func() { b.dispatchSubscriber(name, handlers) }, b.outboxConfig.PollInterval, stop)
+			}(name, handlers)
+		}
+		wait.Until(b.deleteDelivered, b.outboxConfig.PollInterval, stop)
+	}()
+}
+
+// Dispatch delivers all pending events to durable subscribers and removes events delivered to all of them
+func (b *PubSub) Dispatch() {
+	subscribers := b.durableSubscribers()
+	if len(subscribers) == 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for name, handlers := range subscribers {
+		wg.Add(1)
+		go func(name string, handlers []durableSubscriber) {
+			defer wg.Done()
+			b.dispatchSubscriber(name, handlers)
+		}(name, handlers)
+	}
+	wg.Wait()
+	b.deleteDelivered()
+}
+
+func (b *PubSub) durableSubscribers() map[string][]durableSubscriber {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	subscribers := make(map[string][]durableSubscriber)
+	for _, s := range b.durable {
+		subscribers[s.name] = append(subscribers[s.name], s)
+	}
+	return subscribers
+}
+
+func (b *PubSub) dispatchSubscriber(name string, handlers []durableSubscriber) {
+	if _, err := b.dispatchTo(name, handlers); err != nil {
+		b.log.Errorf("while dispatching events to the subscriber %s: %s", name, err)
+	}
+}
+
+// deleteDelivered removes events delivered to all durable subscribers
+func (b *PubSub) deleteDelivered() {
+	var minCheckpoint int64 = -1
+	for name := range b.durableSubscribers() {
+		checkpoint, err := b.outbox.GetCheckpoint(name)
+		if err != nil {
+			b.log.Errorf("while getting checkpoint of the subscriber %s: %s", name, err)
+			return
+		}
+		if minCheckpoint == -1 || checkpoint < minCheckpoint {
+			minCheckpoint = checkpoint
+		}
+	}
+
+	if minCheckpoint > 0 {
+		if err := b.outbox.DeleteUpTo(minCheckpoint); err != nil {
+			b.log.Errorf("while deleting delivered events from the outbox: %s", err)
+		}
+	}
+}
+
+// dispatchTo delivers pending events to the subscriber and returns its last checkpoint
+func (b *PubSub) dispatchTo(name string, handlers []durableSubscriber) (int64, error) {
+	checkpoint, err := b.outbox.GetCheckpoint(name)
+	if err != nil {
+		return 0, fmt.Errorf("while getting checkpoint: %w", err)
+	}
+
+	for {
+		events, err := b.outbox.ListAfter(checkpoint, b.outboxConfig.BatchSize)
+		if err != nil {
+			return checkpoint, fmt.Errorf("while listing events: %w", err)
+		}
+		if len(events) == 0 {
+			return checkpoint, nil
+		}
+
+		delivered := checkpoint
+		var deliveryErr error
+		for _, ev := range events {
+			if err := b.deliverToHandlers(ev, handlers); err != nil {
+				attempts, exhausted := b.failedDelivery(name, ev.Sequence)
+				if !exhausted {
+					deliveryErr = fmt.Errorf("attempt %d: %w", attempts, err)
+					break
+				}
+				if err := b.saveDeadLetter(name, ev, attempts, err); err != nil {
+					deliveryErr = err
+					break
+				}
+			}
+			b.clearFailedDelivery(name)
+			delivered = ev.Sequence
+		}
+
+		if delivered > checkpoint {
+			if err := b.outbox.SaveCheckpoint(name, delivered); err != nil {
+				return checkpoint, fmt.Errorf("while saving checkpoint: %w", err)
+			}
+			checkpoint = delivered
+		}
+		if deliveryErr != nil {
+			return checkpoint, deliveryErr
+		}
+	}
+}
+
+// failedDelivery counts the failed attempt to deliver the event and returns true when no attempts are left
+func (b *PubSub) failedDelivery(name string, sequence int64) (int, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	failure := b.failures[name]
+	if failure.sequence != sequence {
+		failure = deliveryFailure{sequence: sequence}
+	}
+	failure.attempts++
+	b.failures[name] = failure
+
+	max := b.outboxConfig.MaxDeliveryAttempts
+	return failure.attempts, max > 0 && failure.attempts >= max
+}
+
+func (b *PubSub) clearFailedDelivery(name string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.failures, name)
+}
+
+func (b *PubSub) saveDeadLetter(name string, ev internal.OutboxEvent, attempts int, deliveryErr error) error {
+	b.log.Errorf("unable to deliver event %d of the type %s to the subscriber %s after %d attempts, saving it as a dead letter: %s",
+		ev.Sequence, ev.EventType, name, attempts, deliveryErr)
+	err := b.outbox.SaveDeadLetter(internal.OutboxDeadLetter{
+		Subscriber: name,
+		Sequence:   ev.Sequence,
+		EventType:  ev.EventType,
+		Payload:    ev.Payload,
+		Error:      deliveryErr.Error(),
+		Attempts:   attempts,
+	})
+	if err != nil {
+		return fmt.Errorf("while saving dead letter %d: %w", ev.Sequence, err)
+	}
+	b.Publish(context.Background(), EventDeadLettered{
+		Subscriber: name,
+		Sequence:   ev.Sequence,
+		EventType:  ev.EventType,
+		Attempts:   attempts,
+	})
+	return nil
+}
+
+func (b *PubSub) deliverToHandlers(ev internal.OutboxEvent, handlers []durableSubscriber) error {
+	for _, h := range handlers {
+		if h.eventType != ev.EventType {
+			continue
+		}
+		if err := b.deliver(ev, h); err != nil {
+			return fmt.Errorf("while delivering event %d: %w", ev.Sequence, err)
+		}
+	}
+	return nil
+}
+
+func (b *PubSub) deliver(ev internal.OutboxEvent, subscriber durableSubscriber) error {
+	data := reflect.New(subscriber.dataType)
+	if err := json.Unmarshal(ev.Payload, data.Interface()); err != nil {
+		// the event cannot be delivered anyway, so it is skipped
+		b.log.Errorf("unable to decode event %d of the type %s, skipping: %s", ev.Sequence, ev.EventType, err)
+		return nil
+	}
+	return subscriber.handler(context.Background(), data.Elem().Interface())
+}
+
+// appendToOutbox saves the published event with durable subscribers in the outbox, unless the storage of operations
+// saves it together with the operation
+func (b *PubSub) appendToOutbox(ev interface{}) {
+	durableEv, ok := ev.(DurableEvent)
+	if !ok {
+		return
+	}
+	if _, ok := ev.(TransactionalEvent); ok {
+		return
+	}
+	events, err := b.outboxEvents([]DurableEvent{durableEv})
+	if err != nil {
+		b.log.Errorf("unable to encode event %s: %s", eventTypeName(ev), err)
+		return
+	}
+	for _, outboxEvent := range events {
+		if err := b.outbox.Append(outboxEvent); err != nil {
+			b.log.Errorf("unable to save event %s in the outbox: %s", outboxEvent.EventType, err)
+		}
+	}
+}
+
+// outboxEvents returns the outbox form of events with durable subscribers
+func (b *PubSub) outboxEvents(events []DurableEvent) ([]internal.OutboxEvent, error) {
+	b.mu.Lock()
+	subscribed := make(map[string]bool)
+	for _, s := range b.durable {
+		subscribed[s.eventType] = true
+	}
+	b.mu.Unlock()
+
+	var result []internal.OutboxEvent
+	for _, ev := range events {
+		eventType := eventTypeName(ev)
+		if !subscribed[eventType] {
+			continue
+		}
+		payload, err := json.Marshal(ev.OutboxEvent())
+		if err != nil {
+			return nil, fmt.Errorf("while encoding event %s: %w", eventType, err)
+		}
+		result = append(result, internal.OutboxEvent{EventType: eventType, Payload: payload})
+	}
+	return result, nil
+}
+
+func eventTypeName(ev interface{}) string {
+	return reflect.TypeOf(ev).String()
+}
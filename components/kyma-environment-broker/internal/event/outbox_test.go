@@ -0,0 +1,274 @@
+package event_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/memory"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDurablePubSub(t *testing.T) {
+	t.Run("should deliver events in order to every durable subscriber", func(t *testing.T) {
+		// given
+		outbox := memory.NewOutbox()
+		operations := memory.NewOperationWithOutbox(outbox)
+		svc := event.NewDurablePubSub(outbox, fixOperationEvents, event.OutboxConfig{BatchSize: 2}, logrus.New())
+
+		var gotFirst, gotSecond []durableData
+		svc.SubscribeDurable("first", durableEvent{}, func(ctx context.Context, ev interface{}) error {
+			gotFirst = append(gotFirst, ev.(durableData))
+			return nil
+		})
+		svc.SubscribeDurable("second", durableEvent{}, func(ctx context.Context, ev interface{}) error {
+			gotSecond = append(gotSecond, ev.(durableData))
+			return nil
+		})
+
+		// when
+		insertOperation(t, operations, "a")
+		svc.Publish(context.TODO(), eventA{msg: "not durable"})
+		insertOperation(t, operations, "b")
+		insertOperation(t, operations, "c")
+		svc.Dispatch()
+
+		// then
+		expected := []durableData{{Msg: "a"}, {Msg: "b"}, {Msg: "c"}}
+		assert.Equal(t, expected, gotFirst)
+		assert.Equal(t, expected, gotSecond)
+
+		events, err := outbox.ListAfter(0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, events)
+		checkpoint, err := outbox.GetCheckpoint("first")
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), checkpoint)
+	})
+
+	t.Run("should deliver the event again when the handler fails", func(t *testing.T) {
+		// given
+		outbox := memory.NewOutbox()
+		operations := memory.NewOperationWithOutbox(outbox)
+		svc := event.NewDurablePubSub(outbox, fixOperationEvents, event.OutboxConfig{BatchSize: 10}, logrus.New())
+
+		fail := true
+		var got []durableData
+		svc.SubscribeDurable("failing", durableEvent{}, func(ctx context.Context, ev interface{}) error {
+			data := ev.(durableData)
+			if data.Msg == "b" && fail {
+				return errors.New("temporary error")
+			}
+			got = append(got, data)
+			return nil
+		})
+		insertOperation(t, operations, "a")
+		insertOperation(t, operations, "b")
+
+		// when
+		svc.Dispatch()
+
+		// then
+		assert.Equal(t, []durableData{{Msg: "a"}}, got)
+		events, err := outbox.ListAfter(0, 10)
+		require.NoError(t, err)
+		assert.Len(t, events, 1)
+
+		// when
+		fail = false
+		svc.Dispatch()
+
+		// then
+		assert.Equal(t, []durableData{{Msg: "a"}, {Msg: "b"}}, got)
+	})
+
+	t.Run("should save the event as a dead letter when delivery attempts are exhausted", func(t *testing.T) {
+		// given
+		outbox := memory.NewOutbox()
+		operations := memory.NewOperationWithOutbox(outbox)
+		svc := event.NewDurablePubSub(outbox, fixOperationEvents, event.OutboxConfig{BatchSize: 10, MaxDeliveryAttempts: 2}, logrus.New())
+
+		var got []durableData
+		svc.SubscribeDurable("failing", durableEvent{}, func(ctx context.Context, ev interface{}) error {
+			data := ev.(durableData)
+			if data.Msg == "a" {
+				return errors.New("permanent error")
+			}
+			got = append(got, data)
+			return nil
+		})
+		deadLettered := make(chan event.EventDeadLettered, 1)
+		svc.Subscribe(event.EventDeadLettered{}, func(ctx context.Context, ev interface{}) error {
+			deadLettered <- ev.(event.EventDeadLettered)
+			return nil
+		})
+		insertOperation(t, operations, "a")
+		insertOperation(t, operations, "b")
+
+		// when
+		svc.Dispatch()
+
+		// then
+		assert.Empty(t, got)
+		letters, err := outbox.ListDeadLetters("failing")
+		require.NoError(t, err)
+		assert.Empty(t, letters)
+
+		// when
+		svc.Dispatch()
+
+		// then
+		assert.Equal(t, []durableData{{Msg: "b"}}, got)
+		letters, err = outbox.ListDeadLetters("failing")
+		require.NoError(t, err)
+		require.Len(t, letters, 1)
+		assert.Equal(t, int64(1), letters[0].Sequence)
+		assert.Equal(t, 2, letters[0].Attempts)
+		assert.Contains(t, letters[0].Error, "permanent error")
+		checkpoint, err := outbox.GetCheckpoint("failing")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), checkpoint)
+		events, err := outbox.ListAfter(0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, events)
+
+		select {
+		case ev := <-deadLettered:
+			assert.Equal(t, "failing", ev.Subscriber)
+			assert.Equal(t, int64(1), ev.Sequence)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the dead letter event was not published")
+		}
+	})
+
+	t.Run("should deliver events to other subscribers while one subscriber is blocked", func(t *testing.T) {
+		// given
+		outbox := memory.NewOutbox()
+		operations := memory.NewOperationWithOutbox(outbox)
+		svc := event.NewDurablePubSub(outbox, fixOperationEvents, event.OutboxConfig{BatchSize: 10, PollInterval: 10 * time.Millisecond}, logrus.New())
+
+		unblock := make(chan struct{})
+		svc.SubscribeDurable("blocked", durableEvent{}, func(ctx context.Context, ev interface{}) error {
+			<-unblock
+			return nil
+		})
+		delivered := make(chan durableData, 1)
+		svc.SubscribeDurable("fast", durableEvent{}, func(ctx context.Context, ev interface{}) error {
+			delivered <- ev.(durableData)
+			return nil
+		})
+		insertOperation(t, operations, "a")
+
+		stop := make(chan struct{})
+		defer close(stop)
+		defer close(unblock)
+
+		// when
+		svc.RunDispatcher(nil, stop)
+
+		// then
+		select {
+		case got := <-delivered:
+			assert.Equal(t, durableData{Msg: "a"}, got)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the event was not delivered to the fast subscriber")
+		}
+	})
+
+	t.Run("should save events only of saved operations", func(t *testing.T) {
+		// given
+		outbox := memory.NewOutbox()
+		operations := memory.NewOperationWithOutbox(outbox)
+		svc := event.NewDurablePubSub(outbox, fixOperationEvents, event.OutboxConfig{BatchSize: 10}, logrus.New())
+
+		var got []durableData
+		svc.SubscribeDurable("subscriber", durableEvent{}, func(ctx context.Context, ev interface{}) error {
+			got = append(got, ev.(durableData))
+			return nil
+		})
+		insertOperation(t, operations, "a")
+		op, err := operations.GetProvisioningOperationByID("a")
+		require.NoError(t, err)
+		op.Description = "updated"
+		_, err = operations.UpdateProvisioningOperation(*op)
+		require.NoError(t, err)
+
+		// when
+		_, err = operations.UpdateProvisioningOperation(*op)
+		svc.Dispatch()
+
+		// then
+		assert.Error(t, err)
+		assert.Equal(t, []durableData{{Msg: "a"}, {Msg: "a"}}, got)
+	})
+
+	t.Run("should deliver published step events to durable subscribers", func(t *testing.T) {
+		// given
+		outbox := memory.NewOutbox()
+		svc := event.NewDurablePubSub(outbox, fixOperationEvents, event.OutboxConfig{BatchSize: 10}, logrus.New())
+
+		var got []process.OperationEvent
+		svc.SubscribeDurable("steps", process.ProvisioningStepProcessed{}, func(ctx context.Context, ev interface{}) error {
+			got = append(got, ev.(process.OperationEvent))
+			return nil
+		})
+		svc.SubscribeDurable("operations", durableEvent{}, func(ctx context.Context, ev interface{}) error {
+			return nil
+		})
+
+		// when
+		svc.Publish(context.TODO(), process.ProvisioningStepProcessed{
+			StepProcessed: process.StepProcessed{StepName: "Create_Runtime", Duration: time.Second},
+			Operation:     internal.ProvisioningOperation{Operation: internal.Operation{ID: "op-1"}},
+		})
+		svc.Publish(context.TODO(), durableEvent{msg: "saved with the operation"})
+		events, err := outbox.ListAfter(0, 10)
+		require.NoError(t, err)
+		svc.Dispatch()
+
+		// then
+		assert.Len(t, events, 1)
+		require.Len(t, got, 1)
+		assert.Equal(t, "op-1", got[0].OperationID)
+		assert.Equal(t, "Create_Runtime", got[0].StepName)
+		assert.Equal(t, time.Second, got[0].Duration)
+	})
+}
+
+func insertOperation(t *testing.T, operations storage.Provisioning, id string) {
+	err := operations.InsertProvisioningOperation(internal.ProvisioningOperation{Operation: internal.Operation{ID: id}})
+	require.NoError(t, err)
+}
+
+func fixOperationEvents(op internal.Operation) []event.DurableEvent {
+	return []event.DurableEvent{durableEvent{msg: op.ID}, otherDurableEvent{}}
+}
+
+type otherDurableEvent struct{}
+
+func (e otherDurableEvent) OutboxEvent() interface{} {
+	return durableData{}
+}
+
+func (e otherDurableEvent) SavedWithOperation() {}
+
+type durableEvent struct {
+	msg string
+}
+
+type durableData struct {
+	Msg string `json:"msg"`
+}
+
+func (e durableEvent) OutboxEvent() interface{} {
+	return durableData{Msg: e.msg}
+}
+
+func (e durableEvent) SavedWithOperation() {}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"sync"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/sirupsen/logrus"
 )
 
@@ -24,6 +25,12 @@ type PubSub struct {
 	log logrus.FieldLogger
 
 	handlers map[reflect.Type][]Handler
+
+	// outbox is set in the durable mode only
+	outbox       storage.Outbox
+	outboxConfig OutboxConfig
+	durable      []durableSubscriber
+	failures     map[string]deliveryFailure
 }
 
 func NewPubSub(log logrus.FieldLogger) *PubSub {
@@ -34,8 +41,14 @@ func NewPubSub(log logrus.FieldLogger) *PubSub {
 }
 
 func (b *PubSub) Publish(ctx context.Context, ev interface{}) {
+	if b.outbox != nil {
+		b.appendToOutbox(ev)
+	}
+
 	tt := reflect.TypeOf(ev)
+	b.mu.Lock()
 	hList, found := b.handlers[tt]
+	b.mu.Unlock()
 	if found {
 		for _, handler := range hList {
 			go func(h Handler) {
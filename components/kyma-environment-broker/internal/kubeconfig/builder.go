@@ -31,6 +31,7 @@ type kubeconfigData struct {
 	ServerURL     string
 	OIDCIssuerURL string
 	OIDCClientID  string
+	Token         string
 }
 
 func (b *Builder) Build(instance *internal.Instance) (string, error) {
@@ -58,10 +59,35 @@ func (b *Builder) Build(instance *internal.Instance) (string, error) {
 	})
 }
 
+// buildWithToken creates a kubeconfig which authenticates with the given token
+// against the cluster described by the admin kubeconfig
+func (b *Builder) buildWithToken(adminKubeconfig, token string) (string, error) {
+	var kubeCfg kubeconfig
+	err := yaml.Unmarshal([]byte(adminKubeconfig), &kubeCfg)
+	if err != nil {
+		return "", errors.Wrapf(err, "while unmarshaling kubeconfig")
+	}
+
+	if err := b.validKubeconfig(kubeCfg); err != nil {
+		return "", errors.Wrap(err, "while validation kubeconfig fetched by provisioner")
+	}
+
+	return b.parseTemplateWith(tokenKubeconfigTemplate, kubeconfigData{
+		ContextName: kubeCfg.CurrentContext,
+		CAData:      kubeCfg.Clusters[0].Cluster.CertificateAuthorityData,
+		ServerURL:   kubeCfg.Clusters[0].Cluster.Server,
+		Token:       token,
+	})
+}
+
 func (b *Builder) parseTemplate(payload kubeconfigData) (string, error) {
+	return b.parseTemplateWith(kubeconfigTemplate, payload)
+}
+
+func (b *Builder) parseTemplateWith(tmpl string, payload kubeconfigData) (string, error) {
 	var result bytes.Buffer
 	t := template.New("kubeconfigParser")
-	t, err := t.Parse(kubeconfigTemplate)
+	t, err := t.Parse(tmpl)
 	if err != nil {
 		return "", errors.Wrap(err, "while parsing kubeconfig template")
 	}
@@ -0,0 +1,185 @@
+package kubeconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
+
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	bindingNamespace       = "kyma-system"
+	bindingNamePrefix      = "kyma-binding-"
+	bindingIDLabel         = "operator.kyma-project.io/binding-id"
+	managedByLabel         = "app.kubernetes.io/managed-by"
+	managedByLabelValue    = "kyma-environment-broker"
+	defaultBindingRoleName = "view"
+)
+
+// ClientProvider creates a Kubernetes client for the cluster described by the given kubeconfig
+type ClientProvider func(kubeconfig string) (kubernetes.Interface, error)
+
+// NewClientsetFromKubeconfig is the default ClientProvider
+func NewClientsetFromKubeconfig(kubeconfig string) (kubernetes.Interface, error) {
+	restCfg, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeconfig))
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating rest config from kubeconfig")
+	}
+	return kubernetes.NewForConfig(restCfg)
+}
+
+// ServiceAccountManager creates and revokes service accounts in SKRs, which are used as credentials of service bindings.
+// Every binding gets its own service account bound to the configured cluster role and a time-limited token.
+type ServiceAccountManager struct {
+	builder           *Builder
+	provisionerClient provisioner.Client
+	clientProvider    ClientProvider
+	clusterRole       string
+}
+
+func NewServiceAccountManager(provisionerClient provisioner.Client, clientProvider ClientProvider, clusterRole string) *ServiceAccountManager {
+	if clusterRole == "" {
+		clusterRole = defaultBindingRoleName
+	}
+	return &ServiceAccountManager{
+		builder:           NewBuilder(provisionerClient),
+		provisionerClient: provisionerClient,
+		clientProvider:    clientProvider,
+		clusterRole:       clusterRole,
+	}
+}
+
+// Create creates a service account for the given binding and returns a kubeconfig with a token valid for expirationSeconds
+func (m *ServiceAccountManager) Create(ctx context.Context, instance *internal.Instance, bindingID string, expirationSeconds int64) (string, time.Time, error) {
+	adminKubeconfig, err := m.adminKubeconfig(instance)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	cli, err := m.clientProvider(adminKubeconfig)
+	if err != nil {
+		return "", time.Time{}, errors.Wrap(err, "while creating SKR client")
+	}
+
+	if err := ValidateBindingID(bindingID); err != nil {
+		return "", time.Time{}, err
+	}
+	name := ServiceAccountName(bindingID)
+	labels := map[string]string{
+		bindingIDLabel: bindingID,
+		managedByLabel: managedByLabelValue,
+	}
+
+	_, err = cli.CoreV1().ServiceAccounts(bindingNamespace).Create(ctx, &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: bindingNamespace,
+			Labels:    labels,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", time.Time{}, errors.Wrapf(err, "while creating service account %s", name)
+	}
+
+	_, err = cli.RbacV1().ClusterRoleBindings().Create(ctx, &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     m.clusterRole,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      name,
+				Namespace: bindingNamespace,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", time.Time{}, errors.Wrapf(err, "while creating cluster role binding %s", name)
+	}
+
+	tokenRequest, err := cli.CoreV1().ServiceAccounts(bindingNamespace).CreateToken(ctx, name, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", time.Time{}, errors.Wrapf(err, "while creating token for service account %s", name)
+	}
+
+	kubeconfig, err := m.builder.buildWithToken(adminKubeconfig, tokenRequest.Status.Token)
+	if err != nil {
+		return "", time.Time{}, errors.Wrap(err, "while building kubeconfig")
+	}
+
+	return kubeconfig, tokenRequest.Status.ExpirationTimestamp.Time, nil
+}
+
+// Revoke removes the service account of the given binding, which invalidates all tokens issued for it
+func (m *ServiceAccountManager) Revoke(ctx context.Context, instance *internal.Instance, bindingID string) error {
+	adminKubeconfig, err := m.adminKubeconfig(instance)
+	if err != nil {
+		return err
+	}
+	cli, err := m.clientProvider(adminKubeconfig)
+	if err != nil {
+		return errors.Wrap(err, "while creating SKR client")
+	}
+
+	name := ServiceAccountName(bindingID)
+	err = cli.RbacV1().ClusterRoleBindings().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "while deleting cluster role binding %s", name)
+	}
+	err = cli.CoreV1().ServiceAccounts(bindingNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "while deleting service account %s", name)
+	}
+
+	return nil
+}
+
+func (m *ServiceAccountManager) adminKubeconfig(instance *internal.Instance) (string, error) {
+	status, err := m.provisionerClient.RuntimeStatus(instance.GlobalAccountID, instance.RuntimeID)
+	if err != nil {
+		return "", errors.Wrapf(err, "while fetching runtime status from provisioner")
+	}
+	if status.RuntimeConfiguration == nil || status.RuntimeConfiguration.Kubeconfig == nil {
+		return "", fmt.Errorf("kubeconfig for runtime %s does not exist", instance.RuntimeID)
+	}
+	return *status.RuntimeConfiguration.Kubeconfig, nil
+}
+
+// ServiceAccountName returns the name of the service account created in the SKR for the given binding
+func ServiceAccountName(bindingID string) string {
+	return bindingNamePrefix + strings.ToLower(bindingID)
+}
+
+// ValidateBindingID checks that the binding ID can be used in the name of the service account, which must be
+// a DNS-1123 label, and in the value of its label
+func ValidateBindingID(bindingID string) error {
+	if errs := validation.IsDNS1123Label(ServiceAccountName(bindingID)); len(errs) > 0 {
+		return fmt.Errorf("binding ID %q cannot be used in the service account name: %s", bindingID, strings.Join(errs, ", "))
+	}
+	if errs := validation.IsValidLabelValue(bindingID); len(errs) > 0 {
+		return fmt.Errorf("binding ID %q cannot be used as a label value: %s", bindingID, strings.Join(errs, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package kubeconfig
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner/automock"
+	schema "github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const (
+	bindingID    = "ea6ba6f5-5b2b-4d6b-9a45-d1f1b93dbb21"
+	bindingToken = "binding-token"
+)
+
+func TestValidateBindingID(t *testing.T) {
+	assert.NoError(t, ValidateBindingID("7D2A4C4E-6B0F-4C53-9C3A-0B3F1A2E5D71"))
+	assert.NoError(t, ValidateBindingID(bindingID))
+	assert.Error(t, ValidateBindingID("binding/001"))
+	assert.Error(t, ValidateBindingID("binding.001"))
+	assert.Error(t, ValidateBindingID(strings.Repeat("a", 51)))
+}
+
+func TestServiceAccountManager(t *testing.T) {
+	t.Run("should create service account and return kubeconfig with token", func(t *testing.T) {
+		// given
+		provisionerClient := fixProvisionerClientWithKubeconfig()
+		defer provisionerClient.AssertExpectations(t)
+
+		expiresAt := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+		clientset := fake.NewSimpleClientset()
+		clientset.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "token" {
+				return false, nil, nil
+			}
+			tokenRequest := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+			assert.Equal(t, int64(600), *tokenRequest.Spec.ExpirationSeconds)
+			tokenRequest.Status.Token = bindingToken
+			tokenRequest.Status.ExpirationTimestamp = metav1.NewTime(expiresAt)
+			return true, tokenRequest, nil
+		})
+
+		manager := NewServiceAccountManager(provisionerClient, fixClientProvider(clientset), "")
+
+		// when
+		kubeconfig, gotExpiresAt, err := manager.Create(context.TODO(), fixBindingInstance(), bindingID, 600)
+
+		// then
+		require.NoError(t, err)
+		assert.Contains(t, kubeconfig, "token: "+bindingToken)
+		assert.Contains(t, kubeconfig, "server: https://api.ac0d8d9.kyma-dev.shoot.canary.k8s-hana.ondemand.com")
+		assert.True(t, expiresAt.Equal(gotExpiresAt))
+
+		sa, err := clientset.CoreV1().ServiceAccounts(bindingNamespace).Get(context.TODO(), ServiceAccountName(bindingID), metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, bindingID, sa.Labels[bindingIDLabel])
+
+		crb, err := clientset.RbacV1().ClusterRoleBindings().Get(context.TODO(), ServiceAccountName(bindingID), metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, defaultBindingRoleName, crb.RoleRef.Name)
+	})
+
+	t.Run("should remove service account on revoke", func(t *testing.T) {
+		// given
+		provisionerClient := fixProvisionerClientWithKubeconfig()
+		defer provisionerClient.AssertExpectations(t)
+
+		clientset := fake.NewSimpleClientset()
+		manager := NewServiceAccountManager(provisionerClient, fixClientProvider(clientset), "view")
+		_, err := clientset.CoreV1().ServiceAccounts(bindingNamespace).Create(context.TODO(), fixServiceAccount(), metav1.CreateOptions{})
+		require.NoError(t, err)
+
+		// when
+		err = manager.Revoke(context.TODO(), fixBindingInstance(), bindingID)
+
+		// then
+		require.NoError(t, err)
+		_, err = clientset.CoreV1().ServiceAccounts(bindingNamespace).Get(context.TODO(), ServiceAccountName(bindingID), metav1.GetOptions{})
+		assert.Error(t, err)
+	})
+}
+
+func fixProvisionerClientWithKubeconfig() *automock.Client {
+	provisionerClient := &automock.Client{}
+	provisionerClient.On("RuntimeStatus", globalAccountID, runtimeID).Return(schema.RuntimeStatus{
+		RuntimeConfiguration: &schema.RuntimeConfig{
+			Kubeconfig: skrKubeconfig(),
+		},
+	}, nil)
+	return provisionerClient
+}
+
+func fixClientProvider(clientset kubernetes.Interface) ClientProvider {
+	return func(string) (kubernetes.Interface, error) {
+		return clientset, nil
+	}
+}
+
+func fixServiceAccount() *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ServiceAccountName(bindingID),
+			Namespace: bindingNamespace,
+		},
+	}
+}
+
+func fixBindingInstance() *internal.Instance {
+	return &internal.Instance{
+		RuntimeID:       runtimeID,
+		GlobalAccountID: globalAccountID,
+	}
+}
@@ -0,0 +1,111 @@
+package leaderelection
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+type Config struct {
+	Enabled bool `envconfig:"default=false"`
+
+	LeaseName string `envconfig:"default=kyma-environment-broker-workers"`
+	Namespace string `envconfig:"default=kcp-system"`
+	// Identity distinguishes replicas, the hostname is used if it is not set
+	Identity string `envconfig:"optional"`
+
+	LeaseDuration time.Duration `envconfig:"default=15s"`
+	RenewDeadline time.Duration `envconfig:"default=10s"`
+	RetryPeriod   time.Duration `envconfig:"default=2s"`
+}
+
+// Elector elects one replica of KEB, which runs the workers of the processing queues.
+// The election uses the Kubernetes Lease, replicas which are not elected only serve the API.
+type Elector struct {
+	cfg     Config
+	client  kubernetes.Interface
+	leading chan struct{}
+	log     logrus.FieldLogger
+
+	// onStoppedLeading is called when the replica loses the leadership
+	onStoppedLeading func()
+}
+
+func NewElector(cfg Config, client kubernetes.Interface, log logrus.FieldLogger) *Elector {
+	return &Elector{
+		cfg:     cfg,
+		client:  client,
+		leading: make(chan struct{}),
+		log:     log,
+		onStoppedLeading: func() {
+			// the workers cannot be safely stopped in the middle of the step, the replica restarts instead
+			log.Fatal("leadership lost, exiting")
+		},
+	}
+}
+
+// Leading returns a channel which is closed when the replica becomes the leader
+func (e *Elector) Leading() <-chan struct{} {
+	return e.leading
+}
+
+// Run takes part in the election until the context is canceled. If the election is disabled,
+// the replica becomes the leader immediately.
+func (e *Elector) Run(ctx context.Context) error {
+	if !e.cfg.Enabled {
+		close(e.leading)
+		return nil
+	}
+
+	identity := e.cfg.Identity
+	if identity == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return err
+		}
+		identity = hostname
+	}
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      e.cfg.LeaseName,
+				Namespace: e.cfg.Namespace,
+			},
+			Client: e.client.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{
+				Identity: identity,
+			},
+		},
+		LeaseDuration:   e.cfg.LeaseDuration,
+		RenewDeadline:   e.cfg.RenewDeadline,
+		RetryPeriod:     e.cfg.RetryPeriod,
+		ReleaseOnCancel: true,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(context.Context) {
+				e.log.Infof("%s elected as the leader, starting workers", identity)
+				close(e.leading)
+			},
+			OnStoppedLeading: func() {
+				if ctx.Err() == nil {
+					e.onStoppedLeading()
+				}
+			},
+			OnNewLeader: func(leader string) {
+				e.log.Infof("the current leader is %s", leader)
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	go elector.Run(ctx)
+	return nil
+}
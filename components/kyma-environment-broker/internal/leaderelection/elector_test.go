@@ -0,0 +1,71 @@
+package leaderelection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestElector(t *testing.T) {
+	cfg := Config{
+		Enabled:       true,
+		LeaseName:     "workers",
+		Namespace:     "kcp-system",
+		LeaseDuration: 15 * time.Second,
+		RenewDeadline: 10 * time.Second,
+		RetryPeriod:   100 * time.Millisecond,
+	}
+
+	t.Run("should lead immediately when the election is disabled", func(t *testing.T) {
+		// given
+		elector := NewElector(Config{}, fake.NewSimpleClientset(), logrus.New())
+
+		// when
+		err := elector.Run(context.Background())
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, isClosed(elector.Leading()))
+	})
+
+	t.Run("should elect only one replica", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		client := fake.NewSimpleClientset()
+
+		firstCfg := cfg
+		firstCfg.Identity = "replica-1"
+		first := NewElector(firstCfg, client, logrus.New())
+		secondCfg := cfg
+		secondCfg.Identity = "replica-2"
+		second := NewElector(secondCfg, client, logrus.New())
+
+		// when
+		require.NoError(t, first.Run(ctx))
+		select {
+		case <-first.Leading():
+		case <-time.After(5 * time.Second):
+			t.Fatal("first replica was not elected")
+		}
+		require.NoError(t, second.Run(ctx))
+
+		// then
+		time.Sleep(500 * time.Millisecond)
+		assert.False(t, isClosed(second.Leading()))
+	})
+}
+
+func isClosed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
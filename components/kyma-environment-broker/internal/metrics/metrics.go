@@ -10,7 +10,8 @@ func RegisterAll(sub event.Subscriber, operationStatsGetter OperationsStatsGette
 	opResultCollector := NewOperationResultCollector()
 	opDurationCollector := NewOperationDurationCollector()
 	stepResultCollector := NewStepResultCollector()
-	prometheus.MustRegister(opResultCollector, opDurationCollector, stepResultCollector)
+	outboxCollector := NewOutboxCollector()
+	prometheus.MustRegister(opResultCollector, opDurationCollector, stepResultCollector, outboxCollector)
 	prometheus.MustRegister(NewOperationsCollector(operationStatsGetter))
 	prometheus.MustRegister(NewInstancesCollector(instanceStatsGetter))
 
@@ -23,4 +24,5 @@ func RegisterAll(sub event.Subscriber, operationStatsGetter OperationsStatsGette
 	sub.Subscribe(process.DeprovisioningStepProcessed{}, opDurationCollector.OnDeprovisioningStepProcessed)
 	sub.Subscribe(process.ProvisioningStepProcessed{}, stepResultCollector.OnProvisioningStepProcessed)
 	sub.Subscribe(process.DeprovisioningStepProcessed{}, stepResultCollector.OnDeprovisioningStepProcessed)
+	sub.Subscribe(event.EventDeadLettered{}, outboxCollector.OnEventDeadLettered)
 }
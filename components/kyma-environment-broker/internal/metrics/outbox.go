@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// OutboxCollector provides the counter of events which the outbox dispatcher saved as dead letters:
+// - compass_keb_outbox_dead_letters_total{"subscriber", "event_type"}
+type OutboxCollector struct {
+	deadLettersCounter *prometheus.CounterVec
+}
+
+func NewOutboxCollector() *OutboxCollector {
+	return &OutboxCollector{
+		deadLettersCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prometheusNamespace,
+			Subsystem: prometheusSubsystem,
+			Name:      "outbox_dead_letters_total",
+			Help:      "The number of events which could not be delivered to the durable subscriber",
+		}, []string{"subscriber", "event_type"}),
+	}
+}
+
+func (c *OutboxCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.deadLettersCounter.Describe(ch)
+}
+
+func (c *OutboxCollector) Collect(ch chan<- prometheus.Metric) {
+	c.deadLettersCounter.Collect(ch)
+}
+
+func (c *OutboxCollector) OnEventDeadLettered(ctx context.Context, ev interface{}) error {
+	deadLettered, ok := ev.(event.EventDeadLettered)
+	if !ok {
+		return fmt.Errorf("expected event.EventDeadLettered but got %+v", ev)
+	}
+
+	c.deadLettersCounter.WithLabelValues(deadLettered.Subscriber, deadLettered.EventType).Inc()
+	return nil
+}
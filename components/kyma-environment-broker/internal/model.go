@@ -75,6 +75,7 @@ const (
 	Parameters     RuntimeVersionOrigin = "parameters"
 	Defaults       RuntimeVersionOrigin = "defaults"
 	AccountMapping RuntimeVersionOrigin = "account-mapping"
+	Rollback       RuntimeVersionOrigin = "rollback"
 )
 
 // RuntimeVersionData describes the Kyma Version used for the cluster
@@ -169,12 +170,25 @@ const (
 	OperationTypeUpdate OperationType = "update"
 	// OperationTypeUpgradeCluster means upgrade cluster (shoot) OperationType
 	OperationTypeUpgradeCluster OperationType = "upgradeCluster"
+	// OperationTypeRunTask means run task OperationType
+	OperationTypeRunTask OperationType = "runTask"
+	// OperationTypeHibernate means hibernate (trial suspension without deprovisioning) OperationType
+	OperationTypeHibernate OperationType = "hibernate"
+	// OperationTypeWakeUp means wake up (unsuspension of the hibernated trial) OperationType
+	OperationTypeWakeUp OperationType = "wakeUp"
 )
 
 type Operation struct {
 	// following fields are serialized to JSON and stored in the storage
 	InstanceDetails
 
+	// StepAttempts holds the attempts of steps which have a time limit or a retry limit, keyed by the step name
+	StepAttempts map[string]StepAttempts `json:"step_attempts,omitempty"`
+	// SkippedSteps holds the names of steps which were skipped manually when the operation was resumed
+	SkippedSteps map[string]struct{} `json:"skipped_steps,omitempty"`
+	// ResumedAt is the time the operation was resumed manually, the operation timeout is counted from it
+	ResumedAt time.Time `json:"resumed_at"`
+
 	ID        string        `json:"-"`
 	Version   int           `json:"-"`
 	CreatedAt time.Time     `json:"-"`
@@ -193,12 +207,52 @@ type Operation struct {
 	LastError       kebError.LastError  `json:"-"`
 }
 
+// TimeoutBase returns the time the operation timeout is counted from, which is the time of the last resume
+// or the creation time of the operation
+func (o *Operation) TimeoutBase() time.Time {
+	if o.ResumedAt.After(o.CreatedAt) {
+		return o.ResumedAt
+	}
+	return o.CreatedAt
+}
+
+// StepAttempts holds the number of runs of a step and the time of the first run
+type StepAttempts struct {
+	Count     int       `json:"count"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// OperationStepResult is the result of a single run of a step
+type OperationStepResult string
+
+const (
+	OperationStepSucceeded OperationStepResult = "succeeded"
+	OperationStepRetry     OperationStepResult = "retry"
+	OperationStepFailed    OperationStepResult = "failed"
+)
+
+// OperationStep is an entry of the operation step journal, one entry is saved for every run of a step
+type OperationStep struct {
+	ID          string
+	OperationID string
+	StepName    string
+	Stage       string
+
+	StartedAt  time.Time
+	FinishedAt time.Time
+
+	Result       OperationStepResult
+	RetryDelay   time.Duration
+	ErrorReason  string
+	ErrorMessage string
+}
+
 func (o *Operation) IsFinished() bool {
 	return o.State != orchestration.InProgress && o.State != orchestration.Pending && o.State != orchestration.Canceling && o.State != orchestration.Retrying
 }
 
 // Orchestration holds all information about an orchestration.
-// Orchestration performs operations of a specific type (UpgradeKymaOperation, UpgradeClusterOperation)
+// Orchestration performs operations of a specific type (UpgradeKymaOperation, UpgradeClusterOperation, RunTaskOperation)
 // on specific targets of SKRs.
 type Orchestration struct {
 	OrchestrationID string
@@ -219,6 +273,11 @@ func (o *Orchestration) IsCanceled() bool {
 	return o.State == orchestration.Canceling || o.State == orchestration.Canceled
 }
 
+// IsPaused returns true if pending operations of the orchestration must not be started
+func (o *Orchestration) IsPaused() bool {
+	return o.State == orchestration.Paused
+}
+
 type InstanceWithOperation struct {
 	Instance
 
@@ -304,6 +363,12 @@ type UpdatingOperation struct {
 	K8sClient                client.Client `json:"-"`
 }
 
+// HibernationOperation holds all information about hibernate and wake up operations,
+// the Type of the operation tells if the cluster is hibernated or woken up
+type HibernationOperation struct {
+	Operation
+}
+
 // UpgradeKymaOperation holds all information about upgrade Kyma operation
 type UpgradeKymaOperation struct {
 	Operation
@@ -314,6 +379,15 @@ type UpgradeKymaOperation struct {
 	RuntimeVersion RuntimeVersionData `json:"runtime_version"`
 
 	ClusterConfigurationApplied bool `json:"cluster_configuration_applied"`
+
+	// Rollback is set for operations of rollback kyma orchestrations
+	Rollback *KymaRollback `json:"rollback,omitempty"`
+}
+
+// KymaRollback points to the runtime state from before the rolled back upgrade, which the operation restores
+type KymaRollback struct {
+	OrchestrationID string `json:"orchestration_id"`
+	RuntimeStateID  string `json:"runtime_state_id"`
 }
 
 // UpgradeClusterOperation holds all information about upgrade cluster (shoot) operation
@@ -324,6 +398,23 @@ type UpgradeClusterOperation struct {
 	InputCreator                   ProvisionerInputCreator `json:"-"`
 }
 
+// RunTaskOperation holds all information about run task operation
+type RunTaskOperation struct {
+	Operation
+
+	orchestration.RuntimeOperation `json:"runtime_operation"`
+	Task                           orchestration.TaskParameters `json:"task"`
+
+	// ManifestsApplied is set when all manifests of the task are applied in the runtime
+	ManifestsApplied bool `json:"manifests_applied"`
+	// JobName and JobNamespace identify the Job created in the runtime
+	JobName      string `json:"job_name,omitempty"`
+	JobNamespace string `json:"job_namespace,omitempty"`
+
+	// following fields are not stored in the storage
+	K8sClient client.Client `json:"-"`
+}
+
 func NewRuntimeState(runtimeID, operationID string, kymaConfig *gqlschema.KymaConfigInput, clusterConfig *gqlschema.GardenerConfigInput) RuntimeState {
 	var (
 		kymaConfigInput    gqlschema.KymaConfigInput
@@ -420,6 +511,42 @@ func (r *RuntimeState) buildKymaConfigFromClusterSetup() gqlschema.KymaConfigInp
 	return kymaConfig
 }
 
+// Binding holds information about a service binding. Credentials of a binding are a kubeconfig
+// of a service account created in the runtime, valid until ExpiresAt.
+type Binding struct {
+	ID         string
+	InstanceID string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ExpiresAt time.Time
+
+	Kubeconfig        string
+	ExpirationSeconds int64
+}
+
+// IsExpired returns true if the credentials of the binding are no longer valid
+func (b *Binding) IsExpired() bool {
+	return time.Now().After(b.ExpiresAt)
+}
+
+// QueueItem holds the state of an item of a durable processing queue.
+// An item is processed by the owner of its lease, other owners can take it over once the lease expires.
+type QueueItem struct {
+	Queue  string
+	ItemID string
+
+	DueAt time.Time
+	// Generation is increased every time the item is added to the queue again
+	Generation int
+
+	LeaseOwner     string
+	LeaseExpiresAt time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
 // OperationStats provide number of operations per type and state
 type OperationStats struct {
 	Provisioning   map[domain.LastOperationState]int
@@ -508,6 +635,14 @@ func NewUpdateOperation(operationID string, instance *Instance, updatingParams U
 		op.ProvisioningParameters.Parameters.RuntimeAdministrators = updatingParams.RuntimeAdministrators
 	}
 
+	if updatingParams.HibernationSchedules != nil {
+		op.ProvisioningParameters.Parameters.HibernationSchedules = updatingParams.HibernationSchedules
+	}
+
+	if updatingParams.WorkerPools != nil {
+		op.ProvisioningParameters.Parameters.WorkerPools = updatingParams.WorkerPools
+	}
+
 	updatingParams.UpdateAutoScaler(&op.ProvisioningParameters.Parameters)
 
 	return op
@@ -532,6 +667,34 @@ func NewSuspensionOperationWithID(operationID string, instance *Instance) Deprov
 	}
 }
 
+// NewHibernationOperationWithID creates the operation which suspends the instance by hibernating its cluster
+func NewHibernationOperationWithID(operationID string, instance *Instance) HibernationOperation {
+	return newHibernationOperation(operationID, OperationTypeHibernate, instance)
+}
+
+// NewWakeUpOperationWithID creates the operation which unsuspends the instance by waking up its hibernated cluster
+func NewWakeUpOperationWithID(operationID string, instance *Instance) HibernationOperation {
+	return newHibernationOperation(operationID, OperationTypeWakeUp, instance)
+}
+
+func newHibernationOperation(operationID string, opType OperationType, instance *Instance) HibernationOperation {
+	return HibernationOperation{
+		Operation: Operation{
+			ID:                     operationID,
+			Version:                0,
+			Description:            "Operation created",
+			InstanceID:             instance.InstanceID,
+			State:                  orchestration.Pending,
+			CreatedAt:              time.Now(),
+			UpdatedAt:              time.Now(),
+			Type:                   opType,
+			InstanceDetails:        instance.InstanceDetails,
+			FinishedStages:         make(map[string]struct{}, 0),
+			ProvisioningParameters: instance.Parameters,
+		},
+	}
+}
+
 func (o *Operation) FinishStage(stageName string) {
 	o.FinishedStages[stageName] = struct{}{}
 }
@@ -541,6 +704,18 @@ func (o *Operation) IsStageFinished(stage string) bool {
 	return found
 }
 
+func (o *Operation) SkipStep(stepName string) {
+	if o.SkippedSteps == nil {
+		o.SkippedSteps = make(map[string]struct{})
+	}
+	o.SkippedSteps[stepName] = struct{}{}
+}
+
+func (o *Operation) IsStepSkipped(stepName string) bool {
+	_, found := o.SkippedSteps[stepName]
+	return found
+}
+
 type ComponentConfigurationInputList []*gqlschema.ComponentConfigurationInput
 
 func (l ComponentConfigurationInputList) DeepCopy() []*gqlschema.ComponentConfigurationInput {
@@ -567,3 +742,24 @@ func (l ComponentConfigurationInputList) DeepCopy() []*gqlschema.ComponentConfig
 	}
 	return copiedList
 }
+
+// OutboxEvent is an event kept in the outbox until it is delivered to all durable subscribers.
+// Events are delivered in the order of their sequence numbers.
+type OutboxEvent struct {
+	Sequence  int64
+	EventType string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// OutboxDeadLetter is an event which the dispatcher stopped delivering to the durable subscriber
+// after the maximum number of failed attempts.
+type OutboxDeadLetter struct {
+	Subscriber string
+	Sequence   int64
+	EventType  string
+	Payload    []byte
+	Error      string
+	Attempts   int
+	CreatedAt  time.Time
+}
@@ -0,0 +1,111 @@
+package operation
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	pkg "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type Handler struct {
+	operations storage.Operations
+	resumer    *Resumer
+	log        logrus.FieldLogger
+}
+
+func NewHandler(operations storage.Operations, resumer *Resumer, log logrus.FieldLogger) *Handler {
+	return &Handler{
+		operations: operations,
+		resumer:    resumer,
+		log:        log,
+	}
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/operations/{operation_id}/steps", h.listSteps).Methods(http.MethodGet)
+	router.HandleFunc("/operations/{operation_id}/resume", h.resume).Methods(http.MethodPost)
+}
+
+func (h *Handler) resume(w http.ResponseWriter, r *http.Request) {
+	operationID := mux.Vars(r)["operation_id"]
+
+	params := pkg.ResumeParameters{}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrap(err, "while decoding resume parameters"))
+			return
+		}
+	}
+
+	op, err := h.resumer.Resume(operationID, params)
+	switch {
+	case dberr.IsNotFound(errors.Cause(err)):
+		httputil.WriteErrorResponse(w, http.StatusNotFound, err)
+		return
+	case IsNotResumable(err):
+		httputil.WriteErrorResponse(w, http.StatusConflict, err)
+		return
+	case IsUnknownSteps(err):
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, err)
+		return
+	case err != nil:
+		h.log.Errorf("while resuming operation %s: %v", operationID, err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	httputil.WriteResponse(w, http.StatusAccepted, pkg.ResumeResponse{
+		OperationID: op.ID,
+		Type:        string(op.Type),
+		State:       string(op.State),
+		Description: op.Description,
+	})
+}
+
+func (h *Handler) listSteps(w http.ResponseWriter, r *http.Request) {
+	operationID := mux.Vars(r)["operation_id"]
+
+	_, err := h.operations.GetOperationByID(operationID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if dberr.IsNotFound(err) {
+			status = http.StatusNotFound
+		}
+		httputil.WriteErrorResponse(w, status, errors.Wrapf(err, "while getting operation %s", operationID))
+		return
+	}
+
+	steps, err := h.operations.ListOperationSteps(operationID)
+	if err != nil {
+		h.log.Errorf("while getting steps of operation %s: %v", operationID, err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, errors.Wrapf(err, "while getting steps of operation %s", operationID))
+		return
+	}
+
+	response := pkg.StepsResponse{
+		OperationID: operationID,
+		Data:        make([]pkg.StepDTO, 0, len(steps)),
+		Count:       len(steps),
+	}
+	for _, step := range steps {
+		response.Data = append(response.Data, pkg.StepDTO{
+			StepName:     step.StepName,
+			Stage:        step.Stage,
+			StartedAt:    step.StartedAt,
+			FinishedAt:   step.FinishedAt,
+			Duration:     step.FinishedAt.Sub(step.StartedAt),
+			Result:       pkg.StepResult(step.Result),
+			RetryDelay:   step.RetryDelay,
+			ErrorReason:  step.ErrorReason,
+			ErrorMessage: step.ErrorMessage,
+		})
+	}
+
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
@@ -0,0 +1,156 @@
+package operation_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	pkg "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/operation"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/memory"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_ListSteps(t *testing.T) {
+	t.Run("should return the journal of steps", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		err := operations.InsertProvisioningOperation(fixture.FixProvisioningOperation("op-1", "inst-1"))
+		require.NoError(t, err)
+
+		start := time.Now()
+		err = operations.InsertOperationStep(internal.OperationStep{
+			ID: "step-2", OperationID: "op-1", StepName: "EDP_Registration", Stage: "create_runtime",
+			StartedAt: start.Add(time.Minute), FinishedAt: start.Add(2 * time.Minute),
+			Result: internal.OperationStepFailed, ErrorReason: "err_edp_internal", ErrorMessage: "edp failed",
+		})
+		require.NoError(t, err)
+		err = operations.InsertOperationStep(internal.OperationStep{
+			ID: "step-1", OperationID: "op-1", StepName: "AVS_Create", Stage: "create_runtime",
+			StartedAt: start, FinishedAt: start.Add(time.Second),
+			Result: internal.OperationStepRetry, RetryDelay: 10 * time.Second,
+		})
+		require.NoError(t, err)
+
+		router := mux.NewRouter()
+		operation.NewHandler(operations, nil, logrus.New()).AttachRoutes(router)
+		req, err := http.NewRequest(http.MethodGet, "/operations/op-1/steps", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		var out pkg.StepsResponse
+		err = json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+		assert.Equal(t, "op-1", out.OperationID)
+		require.Equal(t, 2, out.Count)
+		assert.Equal(t, "AVS_Create", out.Data[0].StepName)
+		assert.Equal(t, pkg.StepRetry, out.Data[0].Result)
+		assert.Equal(t, 10*time.Second, out.Data[0].RetryDelay)
+		assert.Equal(t, time.Second, out.Data[0].Duration)
+		assert.Equal(t, "EDP_Registration", out.Data[1].StepName)
+		assert.Equal(t, pkg.StepFailed, out.Data[1].Result)
+		assert.Equal(t, "err_edp_internal", out.Data[1].ErrorReason)
+	})
+
+	t.Run("should return 404 for not existing operation", func(t *testing.T) {
+		// given
+		router := mux.NewRouter()
+		operation.NewHandler(memory.NewOperation(), nil, logrus.New()).AttachRoutes(router)
+		req, err := http.NewRequest(http.MethodGet, "/operations/not-existing/steps", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestHandler_Resume(t *testing.T) {
+	t.Run("should resume failed operation", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		op := fixture.FixProvisioningOperation("op-1", "inst-1")
+		op.State = domain.Failed
+		require.NoError(t, operations.InsertProvisioningOperation(op))
+		queue := &fakeQueue{}
+		resumer := operation.NewResumer(operations, memory.NewOrchestrations(), operation.ResumeQueues{Provisioning: queue}, operation.ResumeSteps{Provisioning: fixProvisioningSteps()}, logrus.New())
+
+		router := mux.NewRouter()
+		operation.NewHandler(operations, resumer, logrus.New()).AttachRoutes(router)
+		req, err := http.NewRequest(http.MethodPost, "/operations/op-1/resume", strings.NewReader(`{"skipSteps":["EDP_Registration"]}`))
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		var out pkg.ResumeResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &out))
+		assert.Equal(t, "op-1", out.OperationID)
+		assert.Equal(t, string(domain.InProgress), out.State)
+		assert.Equal(t, []string{"op-1"}, queue.ids)
+	})
+
+	t.Run("should return conflict for operation in progress", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		op := fixture.FixProvisioningOperation("op-1", "inst-1")
+		op.State = domain.InProgress
+		require.NoError(t, operations.InsertProvisioningOperation(op))
+		resumer := operation.NewResumer(operations, memory.NewOrchestrations(), operation.ResumeQueues{Provisioning: &fakeQueue{}}, operation.ResumeSteps{Provisioning: fixProvisioningSteps()}, logrus.New())
+
+		router := mux.NewRouter()
+		operation.NewHandler(operations, resumer, logrus.New()).AttachRoutes(router)
+		req, err := http.NewRequest(http.MethodPost, "/operations/op-1/resume", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("should return bad request for unknown step to skip", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		op := fixture.FixProvisioningOperation("op-1", "inst-1")
+		op.State = domain.Failed
+		require.NoError(t, operations.InsertProvisioningOperation(op))
+		queue := &fakeQueue{}
+		resumer := operation.NewResumer(operations, memory.NewOrchestrations(), operation.ResumeQueues{Provisioning: queue}, operation.ResumeSteps{Provisioning: fixProvisioningSteps()}, logrus.New())
+
+		router := mux.NewRouter()
+		operation.NewHandler(operations, resumer, logrus.New()).AttachRoutes(router)
+		req, err := http.NewRequest(http.MethodPost, "/operations/op-1/resume", strings.NewReader(`{"skipSteps":["Not_Existing"]}`))
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Empty(t, queue.ids)
+	})
+}
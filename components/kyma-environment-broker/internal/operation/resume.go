@@ -0,0 +1,313 @@
+package operation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pkg "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	commonOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Queue is the processing queue of operations or orchestrations
+type Queue interface {
+	Add(processId string)
+}
+
+// ResumeQueues holds the queues which process resumed operations. Upgrade operations are processed
+// by their orchestrations, so the orchestration is added to the queue.
+type ResumeQueues struct {
+	Provisioning   Queue
+	Deprovisioning Queue
+	Update         Queue
+	UpgradeKyma    Queue
+	UpgradeCluster Queue
+	RunTask        Queue
+}
+
+// Steps returns the names of stages and steps registered in the manager which processes operations of one type
+type Steps interface {
+	GetAllStages() []string
+	GetAllSteps() []string
+}
+
+// ResumeSteps holds the stages and steps of every operation type, names of skipped stages and steps are validated against them
+type ResumeSteps struct {
+	Provisioning   Steps
+	Deprovisioning Steps
+	Update         Steps
+	UpgradeKyma    Steps
+	UpgradeCluster Steps
+	RunTask        Steps
+}
+
+// Resumer sets failed operations back to in progress and adds them to the processing queue
+type Resumer struct {
+	operations     storage.Operations
+	orchestrations storage.Orchestrations
+	queues         ResumeQueues
+	steps          ResumeSteps
+	log            logrus.FieldLogger
+}
+
+func NewResumer(operations storage.Operations, orchestrations storage.Orchestrations, queues ResumeQueues, steps ResumeSteps, log logrus.FieldLogger) *Resumer {
+	return &Resumer{
+		operations:     operations,
+		orchestrations: orchestrations,
+		queues:         queues,
+		steps:          steps,
+		log:            log,
+	}
+}
+
+// ErrNotResumable is returned if the operation cannot be resumed in its current state
+type ErrNotResumable struct {
+	msg string
+}
+
+func (e ErrNotResumable) Error() string {
+	return e.msg
+}
+
+func IsNotResumable(err error) bool {
+	_, ok := errors.Cause(err).(ErrNotResumable)
+	return ok
+}
+
+// ErrUnknownSteps is returned if the stages or steps to skip are not defined for the type of the operation
+type ErrUnknownSteps struct {
+	msg string
+}
+
+func (e ErrUnknownSteps) Error() string {
+	return e.msg
+}
+
+func IsUnknownSteps(err error) bool {
+	_, ok := errors.Cause(err).(ErrUnknownSteps)
+	return ok
+}
+
+// Resume resumes the failed operation. The given steps and stages are marked as finished, so they are not run again.
+func (r *Resumer) Resume(operationID string, params pkg.ResumeParameters) (internal.Operation, error) {
+	op, err := r.operations.GetOperationByID(operationID)
+	if err != nil {
+		return internal.Operation{}, errors.Wrapf(err, "while getting operation %s", operationID)
+	}
+	if op.State != domain.Failed {
+		return internal.Operation{}, ErrNotResumable{msg: fmt.Sprintf("operation %s is in the %s state, only failed operations can be resumed", operationID, op.State)}
+	}
+
+	if err := r.validateSkipped(op.Type, params); err != nil {
+		return internal.Operation{}, err
+	}
+
+	log := r.log.WithField("operation", operationID).WithField("type", op.Type)
+	switch op.Type {
+	case internal.OperationTypeProvision:
+		operation, err := r.operations.GetProvisioningOperationByID(operationID)
+		if err != nil {
+			return internal.Operation{}, errors.Wrapf(err, "while getting provisioning operation %s", operationID)
+		}
+		resumeOperation(&operation.Operation, domain.InProgress, params)
+		updated, err := r.operations.UpdateProvisioningOperation(*operation)
+		if err != nil {
+			return internal.Operation{}, errors.Wrapf(err, "while updating provisioning operation %s", operationID)
+		}
+		r.queues.Provisioning.Add(operationID)
+		op = &updated.Operation
+
+	case internal.OperationTypeDeprovision:
+		operation, err := r.operations.GetDeprovisioningOperationByID(operationID)
+		if err != nil {
+			return internal.Operation{}, errors.Wrapf(err, "while getting deprovisioning operation %s", operationID)
+		}
+		resumeOperation(&operation.Operation, domain.InProgress, params)
+		updated, err := r.operations.UpdateDeprovisioningOperation(*operation)
+		if err != nil {
+			return internal.Operation{}, errors.Wrapf(err, "while updating deprovisioning operation %s", operationID)
+		}
+		r.queues.Deprovisioning.Add(operationID)
+		op = &updated.Operation
+
+	case internal.OperationTypeUpdate:
+		operation, err := r.operations.GetUpdatingOperationByID(operationID)
+		if err != nil {
+			return internal.Operation{}, errors.Wrapf(err, "while getting update operation %s", operationID)
+		}
+		resumeOperation(&operation.Operation, domain.InProgress, params)
+		updated, err := r.operations.UpdateUpdatingOperation(*operation)
+		if err != nil {
+			return internal.Operation{}, errors.Wrapf(err, "while updating update operation %s", operationID)
+		}
+		r.queues.Update.Add(operationID)
+		op = &updated.Operation
+
+	case internal.OperationTypeUpgradeKyma:
+		if err := r.checkOrchestration(op.OrchestrationID); err != nil {
+			return internal.Operation{}, err
+		}
+		operation, err := r.operations.GetUpgradeKymaOperationByID(operationID)
+		if err != nil {
+			return internal.Operation{}, errors.Wrapf(err, "while getting upgrade kyma operation %s", operationID)
+		}
+		// the orchestration sets the retrying operation to pending and runs it again
+		resumeOperation(&operation.Operation, commonOrchestration.Retrying, params)
+		updated, err := r.operations.UpdateUpgradeKymaOperation(*operation)
+		if err != nil {
+			return internal.Operation{}, errors.Wrapf(err, "while updating upgrade kyma operation %s", operationID)
+		}
+		if err := r.resumeOrchestration(op.OrchestrationID, r.queues.UpgradeKyma); err != nil {
+			return internal.Operation{}, err
+		}
+		op = &updated.Operation
+
+	case internal.OperationTypeUpgradeCluster:
+		if err := r.checkOrchestration(op.OrchestrationID); err != nil {
+			return internal.Operation{}, err
+		}
+		operation, err := r.operations.GetUpgradeClusterOperationByID(operationID)
+		if err != nil {
+			return internal.Operation{}, errors.Wrapf(err, "while getting upgrade cluster operation %s", operationID)
+		}
+		resumeOperation(&operation.Operation, commonOrchestration.Retrying, params)
+		updated, err := r.operations.UpdateUpgradeClusterOperation(*operation)
+		if err != nil {
+			return internal.Operation{}, errors.Wrapf(err, "while updating upgrade cluster operation %s", operationID)
+		}
+		if err := r.resumeOrchestration(op.OrchestrationID, r.queues.UpgradeCluster); err != nil {
+			return internal.Operation{}, err
+		}
+		op = &updated.Operation
+
+	case internal.OperationTypeRunTask:
+		if err := r.checkOrchestration(op.OrchestrationID); err != nil {
+			return internal.Operation{}, err
+		}
+		operation, err := r.operations.GetRunTaskOperationByID(operationID)
+		if err != nil {
+			return internal.Operation{}, errors.Wrapf(err, "while getting run task operation %s", operationID)
+		}
+		resumeOperation(&operation.Operation, commonOrchestration.Retrying, params)
+		updated, err := r.operations.UpdateRunTaskOperation(*operation)
+		if err != nil {
+			return internal.Operation{}, errors.Wrapf(err, "while updating run task operation %s", operationID)
+		}
+		if err := r.resumeOrchestration(op.OrchestrationID, r.queues.RunTask); err != nil {
+			return internal.Operation{}, err
+		}
+		op = &updated.Operation
+
+	default:
+		return internal.Operation{}, ErrNotResumable{msg: fmt.Sprintf("operations of the type %s cannot be resumed", op.Type)}
+	}
+
+	log.Infof("Operation resumed, skipped steps: %v, skipped stages: %v", params.SkipSteps, params.SkipStages)
+	return *op, nil
+}
+
+// validateSkipped checks if the stages and steps to skip are registered in the manager of the operation type
+func (r *Resumer) validateSkipped(opType internal.OperationType, params pkg.ResumeParameters) error {
+	if len(params.SkipStages) == 0 && len(params.SkipSteps) == 0 {
+		return nil
+	}
+
+	var steps Steps
+	switch opType {
+	case internal.OperationTypeProvision:
+		steps = r.steps.Provisioning
+	case internal.OperationTypeDeprovision:
+		steps = r.steps.Deprovisioning
+	case internal.OperationTypeUpdate:
+		steps = r.steps.Update
+	case internal.OperationTypeUpgradeKyma:
+		steps = r.steps.UpgradeKyma
+	case internal.OperationTypeUpgradeCluster:
+		steps = r.steps.UpgradeCluster
+	case internal.OperationTypeRunTask:
+		steps = r.steps.RunTask
+	}
+	if steps == nil {
+		return ErrUnknownSteps{msg: fmt.Sprintf("stages and steps of %s operations cannot be skipped", opType)}
+	}
+
+	if unknown := notIn(params.SkipStages, steps.GetAllStages()); len(unknown) > 0 {
+		return ErrUnknownSteps{msg: fmt.Sprintf("unknown stages of %s operations: %s", opType, strings.Join(unknown, ", "))}
+	}
+	if unknown := notIn(params.SkipSteps, steps.GetAllSteps()); len(unknown) > 0 {
+		return ErrUnknownSteps{msg: fmt.Sprintf("unknown steps of %s operations: %s", opType, strings.Join(unknown, ", "))}
+	}
+	return nil
+}
+
+// notIn returns the names which are not in the defined ones
+func notIn(names, defined []string) []string {
+	known := make(map[string]struct{}, len(defined))
+	for _, d := range defined {
+		known[d] = struct{}{}
+	}
+	var unknown []string
+	for _, n := range names {
+		if _, ok := known[n]; !ok {
+			unknown = append(unknown, n)
+		}
+	}
+	return unknown
+}
+
+func (r *Resumer) checkOrchestration(orchestrationID string) error {
+	o, err := r.orchestrations.GetByID(orchestrationID)
+	if err != nil {
+		return errors.Wrapf(err, "while getting orchestration %s", orchestrationID)
+	}
+	if o.State == commonOrchestration.Canceling || o.State == commonOrchestration.Canceled {
+		return ErrNotResumable{msg: fmt.Sprintf("orchestration %s is %s", orchestrationID, o.State)}
+	}
+	return nil
+}
+
+// resumeOrchestration adds the finished orchestration to the queue, the orchestration in progress picks up the retrying operation itself
+func (r *Resumer) resumeOrchestration(orchestrationID string, queue Queue) error {
+	o, err := r.orchestrations.GetByID(orchestrationID)
+	if err != nil {
+		return errors.Wrapf(err, "while getting orchestration %s", orchestrationID)
+	}
+	if o.State != commonOrchestration.Failed && o.State != commonOrchestration.Succeeded {
+		return nil
+	}
+
+	o.State = commonOrchestration.Retrying
+	o.Description += ", retrying"
+	o.UpdatedAt = time.Now()
+	if err := r.orchestrations.Update(*o); err != nil {
+		return errors.Wrapf(err, "while updating orchestration %s", orchestrationID)
+	}
+	queue.Add(orchestrationID)
+	return nil
+}
+
+func resumeOperation(op *internal.Operation, state domain.LastOperationState, params pkg.ResumeParameters) {
+	if op.FinishedStages == nil {
+		op.FinishedStages = make(map[string]struct{})
+	}
+	for _, stage := range params.SkipStages {
+		op.FinishStage(stage)
+	}
+	for _, step := range params.SkipSteps {
+		op.SkipStep(step)
+	}
+
+	op.State = state
+	op.Description = "Operation resumed manually"
+	op.LastError = kebError.LastError{}
+	// limits of steps and the operation timeout are counted again from the resume
+	op.StepAttempts = nil
+	op.ResumedAt = time.Now()
+	op.UpdatedAt = op.ResumedAt
+}
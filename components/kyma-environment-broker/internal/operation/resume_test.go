@@ -0,0 +1,213 @@
+package operation_test
+
+import (
+	"testing"
+	"time"
+
+	pkg "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	commonOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/operation"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/provisioning"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/memory"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeQueue struct {
+	ids []string
+}
+
+func (q *fakeQueue) Add(processId string) {
+	q.ids = append(q.ids, processId)
+}
+
+type fakeSteps struct {
+	stages []string
+	steps  []string
+}
+
+func (s *fakeSteps) GetAllStages() []string {
+	return s.stages
+}
+
+func (s *fakeSteps) GetAllSteps() []string {
+	return s.steps
+}
+
+func fixProvisioningSteps() *fakeSteps {
+	return &fakeSteps{stages: []string{"create_runtime"}, steps: []string{"EDP_Registration"}}
+}
+
+func TestResumer_Resume(t *testing.T) {
+	t.Run("should resume failed provisioning operation and skip the step", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		provisioningQueue := &fakeQueue{}
+		resumer := operation.NewResumer(operations, memory.NewOrchestrations(), operation.ResumeQueues{Provisioning: provisioningQueue}, operation.ResumeSteps{Provisioning: fixProvisioningSteps()}, logrus.New())
+
+		op := fixture.FixProvisioningOperation("op-1", "inst-1")
+		op.State = domain.Failed
+		op.StepAttempts = map[string]internal.StepAttempts{"EDP_Registration": {Count: 10}}
+		require.NoError(t, operations.InsertProvisioningOperation(op))
+
+		// when
+		resumed, err := resumer.Resume("op-1", pkg.ResumeParameters{SkipSteps: []string{"EDP_Registration"}, SkipStages: []string{"create_runtime"}})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, domain.InProgress, resumed.State)
+		assert.Equal(t, []string{"op-1"}, provisioningQueue.ids)
+
+		stored, err := operations.GetProvisioningOperationByID("op-1")
+		require.NoError(t, err)
+		assert.Equal(t, domain.InProgress, stored.State)
+		assert.True(t, stored.IsStepSkipped("EDP_Registration"))
+		assert.True(t, stored.IsStageFinished("create_runtime"))
+		assert.Empty(t, stored.StepAttempts)
+	})
+
+	t.Run("should not resume operation which has not failed", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		provisioningQueue := &fakeQueue{}
+		resumer := operation.NewResumer(operations, memory.NewOrchestrations(), operation.ResumeQueues{Provisioning: provisioningQueue}, operation.ResumeSteps{Provisioning: fixProvisioningSteps()}, logrus.New())
+		require.NoError(t, operations.InsertProvisioningOperation(fixture.FixProvisioningOperation("op-1", "inst-1")))
+
+		// when
+		_, err := resumer.Resume("op-1", pkg.ResumeParameters{})
+
+		// then
+		require.Error(t, err)
+		assert.True(t, operation.IsNotResumable(err))
+		assert.Empty(t, provisioningQueue.ids)
+	})
+
+	t.Run("should not resume operation with unknown steps or stages to skip", func(t *testing.T) {
+		for name, params := range map[string]pkg.ResumeParameters{
+			"unknown step":  {SkipSteps: []string{"EDP_Registration", "Not_Existing"}},
+			"unknown stage": {SkipStages: []string{"not_existing"}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// given
+				operations := memory.NewOperation()
+				provisioningQueue := &fakeQueue{}
+				resumer := operation.NewResumer(operations, memory.NewOrchestrations(), operation.ResumeQueues{Provisioning: provisioningQueue}, operation.ResumeSteps{Provisioning: fixProvisioningSteps()}, logrus.New())
+
+				op := fixture.FixProvisioningOperation("op-1", "inst-1")
+				op.State = domain.Failed
+				require.NoError(t, operations.InsertProvisioningOperation(op))
+
+				// when
+				_, err := resumer.Resume("op-1", params)
+
+				// then
+				require.Error(t, err)
+				assert.True(t, operation.IsUnknownSteps(err))
+				assert.Empty(t, provisioningQueue.ids)
+				stored, err := operations.GetProvisioningOperationByID("op-1")
+				require.NoError(t, err)
+				assert.Equal(t, domain.Failed, stored.State)
+			})
+		}
+	})
+
+	t.Run("should retry upgrade kyma operation of the failed orchestration", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		orchestrations := memory.NewOrchestrations()
+		kymaQueue := &fakeQueue{}
+		resumer := operation.NewResumer(operations, orchestrations, operation.ResumeQueues{UpgradeKyma: kymaQueue}, operation.ResumeSteps{UpgradeKyma: &fakeSteps{stages: []string{"1"}, steps: []string{"Send_Notification"}}}, logrus.New())
+
+		op := fixture.FixUpgradeKymaOperation("op-1", "inst-1")
+		op.State = domain.Failed
+		require.NoError(t, operations.InsertUpgradeKymaOperation(op))
+		o := fixture.FixOrchestration(op.OrchestrationID)
+		o.State = commonOrchestration.Failed
+		require.NoError(t, orchestrations.Insert(o))
+
+		// when
+		resumed, err := resumer.Resume("op-1", pkg.ResumeParameters{SkipSteps: []string{"Send_Notification"}})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, domain.LastOperationState(commonOrchestration.Retrying), resumed.State)
+		assert.Equal(t, []string{op.OrchestrationID}, kymaQueue.ids)
+
+		storedOrchestration, err := orchestrations.GetByID(op.OrchestrationID)
+		require.NoError(t, err)
+		assert.Equal(t, commonOrchestration.Retrying, storedOrchestration.State)
+	})
+
+	t.Run("should not resume upgrade operation of the canceled orchestration", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		orchestrations := memory.NewOrchestrations()
+		kymaQueue := &fakeQueue{}
+		resumer := operation.NewResumer(operations, orchestrations, operation.ResumeQueues{UpgradeKyma: kymaQueue}, operation.ResumeSteps{UpgradeKyma: &fakeSteps{stages: []string{"1"}, steps: []string{"Send_Notification"}}}, logrus.New())
+
+		op := fixture.FixUpgradeKymaOperation("op-1", "inst-1")
+		op.State = domain.Failed
+		require.NoError(t, operations.InsertUpgradeKymaOperation(op))
+		o := fixture.FixOrchestration(op.OrchestrationID)
+		o.State = commonOrchestration.Canceled
+		require.NoError(t, orchestrations.Insert(o))
+
+		// when
+		_, err := resumer.Resume("op-1", pkg.ResumeParameters{})
+
+		// then
+		assert.True(t, operation.IsNotResumable(err))
+		assert.Empty(t, kymaQueue.ids)
+	})
+
+	t.Run("should process resumed operation created before the operation timeout", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		provisioningQueue := &fakeQueue{}
+		resumer := operation.NewResumer(operations, memory.NewOrchestrations(), operation.ResumeQueues{Provisioning: provisioningQueue}, operation.ResumeSteps{Provisioning: fixProvisioningSteps()}, logrus.New())
+
+		op := fixture.FixProvisioningOperation("op-1", "inst-1")
+		op.State = domain.Failed
+		op.CreatedAt = time.Now().Add(-25 * time.Hour)
+		require.NoError(t, operations.InsertProvisioningOperation(op))
+
+		manager := provisioning.NewStagedManager(operations, event.NewPubSub(nil), 24*time.Hour, logrus.New())
+		manager.DefineStages([]string{"stage-1"})
+		require.NoError(t, manager.AddStep("stage-1", &succeedingStep{operations: operations}, nil))
+
+		// when
+		_, err := resumer.Resume("op-1", pkg.ResumeParameters{})
+		require.NoError(t, err)
+		_, err = manager.Execute("op-1")
+
+		// then
+		require.NoError(t, err)
+		stored, err := operations.GetProvisioningOperationByID("op-1")
+		require.NoError(t, err)
+		assert.Equal(t, domain.Succeeded, stored.State)
+		assert.WithinDuration(t, time.Now(), stored.ResumedAt, time.Minute)
+	})
+}
+
+type succeedingStep struct {
+	operations storage.Provisioning
+}
+
+func (s *succeedingStep) Name() string {
+	return "Succeeding_Step"
+}
+
+func (s *succeedingStep) Run(operation internal.ProvisioningOperation, _ logrus.FieldLogger) (internal.ProvisioningOperation, time.Duration, error) {
+	operation.State = domain.Succeeded
+	updated, err := s.operations.UpdateProvisioningOperation(operation)
+	if err != nil {
+		return operation, time.Second, nil
+	}
+	return *updated, 0, nil
+}
@@ -11,12 +11,14 @@ import (
 
 type Canceler struct {
 	orchestrations storage.Orchestrations
+	queues         orchestrationQueues
 	log            logrus.FieldLogger
 }
 
-func NewCanceler(orchestrations storage.Orchestrations, logger logrus.FieldLogger) *Canceler {
+func NewCanceler(orchestrations storage.Orchestrations, queues orchestrationQueues, logger logrus.FieldLogger) *Canceler {
 	return &Canceler{
 		orchestrations: orchestrations,
+		queues:         queues,
 		log:            logger,
 	}
 }
@@ -31,6 +33,7 @@ func (c *Canceler) CancelForID(orchestrationID string) error {
 		return nil
 	}
 
+	paused := o.State == orchestrationExt.Paused
 	o.UpdatedAt = time.Now()
 	o.Description = "Orchestration was canceled"
 	o.State = orchestrationExt.Canceling
@@ -38,5 +41,9 @@ func (c *Canceler) CancelForID(orchestrationID string) error {
 	if err != nil {
 		return errors.Wrap(err, "while updating orchestration")
 	}
+	// paused orchestration is not processed, so it is queued to cancel its pending operations
+	if paused {
+		return errors.Wrap(c.queues.add(o), "while queueing orchestration")
+	}
 	return nil
 }
@@ -2,10 +2,12 @@ package handlers
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -22,7 +24,7 @@ func TestCanceler_CancelForID(t *testing.T) {
 		err := s.Orchestrations().Insert(fixOrchestration())
 		require.NoError(t, err)
 
-		c := NewCanceler(s.Orchestrations(), logrus.New())
+		c := NewCanceler(s.Orchestrations(), nil, logrus.New())
 
 		err = c.CancelForID(fixOrchestrationID)
 		require.NoError(t, err)
@@ -39,7 +41,7 @@ func TestCanceler_CancelForID(t *testing.T) {
 		err := s.Orchestrations().Insert(o)
 		require.NoError(t, err)
 
-		c := NewCanceler(s.Orchestrations(), logrus.New())
+		c := NewCanceler(s.Orchestrations(), nil, logrus.New())
 
 		err = c.CancelForID(fixOrchestrationID)
 		require.NoError(t, err)
@@ -56,7 +58,7 @@ func TestCanceler_CancelForID(t *testing.T) {
 		err := s.Orchestrations().Insert(o)
 		require.NoError(t, err)
 
-		c := NewCanceler(s.Orchestrations(), logrus.New())
+		c := NewCanceler(s.Orchestrations(), nil, logrus.New())
 
 		err = c.CancelForID(fixOrchestrationID)
 		require.NoError(t, err)
@@ -66,9 +68,38 @@ func TestCanceler_CancelForID(t *testing.T) {
 
 		assert.False(t, isCanceling)
 	})
+	t.Run("should queue canceled paused orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.Type = orchestration.RunTaskOrchestration
+		o.State = orchestration.Paused
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		logs := logrus.New()
+		executor := &queuedExecutor{executed: make(chan string, 1)}
+		taskQueue := process.NewQueue(executor, logs)
+		stop := make(chan struct{})
+		defer close(stop)
+		taskQueue.Run(stop, 1)
+		c := NewCanceler(s.Orchestrations(), newOrchestrationQueues(nil, nil, taskQueue), logs)
+
+		err = c.CancelForID(fixOrchestrationID)
+		require.NoError(t, err)
+
+		isCanceling, err := isCanceling(s.Orchestrations())
+		require.NoError(t, err)
+		assert.True(t, isCanceling)
+		select {
+		case id := <-executor.executed:
+			assert.Equal(t, fixOrchestrationID, id)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the canceled orchestration was not queued")
+		}
+	})
 	t.Run("should return error when orchestration not found", func(t *testing.T) {
 		s := storage.NewMemoryStorage()
-		c := NewCanceler(s.Orchestrations(), logrus.New())
+		c := NewCanceler(s.Orchestrations(), nil, logrus.New())
 
 		err := c.CancelForID(fixOrchestrationID)
 		assert.Error(t, err)
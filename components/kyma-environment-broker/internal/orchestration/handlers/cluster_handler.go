@@ -56,6 +56,14 @@ func (h *clusterHandler) createOrchestration(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// validate strategy
+	err = validateStrategy(params.Strategy)
+	if err != nil {
+		h.log.Errorf("while validating strategy: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrapf(err, "while validating strategy"))
+		return
+	}
+
 	// defaults strategy if not specified to Parallel with Immediate schedule
 	defaultOrchestrationStrategy(&params.Strategy)
 
@@ -134,3 +134,49 @@ func (c *Converter) UpgradeClusterOperationToDetailDTO(op internal.UpgradeCluste
 		ClusterConfig:     clusterConfig,
 	}, nil
 }
+
+func (c *Converter) RunTaskOperationToDTO(op internal.RunTaskOperation) (orchestration.OperationResponse, error) {
+	return orchestration.OperationResponse{
+		OperationID:            op.Operation.ID,
+		RuntimeID:              op.RuntimeOperation.RuntimeID,
+		GlobalAccountID:        op.RuntimeOperation.GlobalAccountID,
+		SubAccountID:           op.RuntimeOperation.SubAccountID,
+		OrchestrationID:        op.OrchestrationID,
+		ServicePlanID:          op.ProvisioningParameters.PlanID,
+		ServicePlanName:        broker.PlanNamesMapping[op.ProvisioningParameters.PlanID],
+		DryRun:                 op.DryRun,
+		ShootName:              op.RuntimeOperation.ShootName,
+		MaintenanceWindowBegin: op.MaintenanceWindowBegin,
+		MaintenanceWindowEnd:   op.MaintenanceWindowEnd,
+		State:                  string(op.Operation.State),
+		Description:            op.Operation.Description,
+	}, nil
+}
+
+func (c *Converter) RunTaskOperationListToDTO(ops []internal.RunTaskOperation, count, totalCount int) (orchestration.OperationResponseList, error) {
+	data := make([]orchestration.OperationResponse, 0, len(ops))
+
+	for _, op := range ops {
+		o, err := c.RunTaskOperationToDTO(op)
+		if err != nil {
+			return orchestration.OperationResponseList{}, errors.Wrap(err, "while converting operation to DTO")
+		}
+		data = append(data, o)
+	}
+
+	return orchestration.OperationResponseList{
+		Data:       data,
+		Count:      count,
+		TotalCount: totalCount,
+	}, nil
+}
+
+func (c *Converter) RunTaskOperationToDetailDTO(op internal.RunTaskOperation) (orchestration.OperationDetailResponse, error) {
+	resp, err := c.RunTaskOperationToDTO(op)
+	if err != nil {
+		return orchestration.OperationDetailResponse{}, errors.Wrap(err, "while converting operation to DTO")
+	}
+	return orchestration.OperationDetailResponse{
+		OperationResponse: resp,
+	}, nil
+}
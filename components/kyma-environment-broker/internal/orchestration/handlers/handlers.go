@@ -3,6 +3,7 @@ package handlers
 import (
 	"github.com/gorilla/mux"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration/strategies"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/pkg/errors"
@@ -17,12 +18,14 @@ type handler struct {
 	handlers []Handler
 }
 
-func NewOrchestrationHandler(db storage.BrokerStorage, kymaQueue *process.Queue, clusterQueue *process.Queue, defaultMaxPage int, log logrus.FieldLogger) Handler {
+func NewOrchestrationHandler(db storage.BrokerStorage, kymaQueue *process.Queue, clusterQueue *process.Queue, taskQueue *process.Queue, previewer Previewer, defaultMaxPage int, log logrus.FieldLogger) Handler {
 	return &handler{
 		handlers: []Handler{
 			NewKymaHandler(db.Orchestrations(), kymaQueue, log),
 			NewClusterHandler(db.Orchestrations(), clusterQueue, log),
-			NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), kymaQueue, clusterQueue, defaultMaxPage, log),
+			NewTaskHandler(db.Orchestrations(), taskQueue, log),
+			NewPreviewHandler(previewer, log),
+			NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), kymaQueue, clusterQueue, taskQueue, defaultMaxPage, log),
 		},
 	}
 }
@@ -37,6 +40,24 @@ func validateTarget(spec orchestration.TargetSpec) error {
 	if spec.Include == nil || len(spec.Include) == 0 {
 		return errors.New("targets.include array must be not empty")
 	}
+	for _, rt := range append(spec.Include, spec.Exclude...) {
+		if err := orchestration.ValidateRuntimeTarget(rt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateStrategy(spec orchestration.StrategySpec) error {
+	if spec.MaxFailures < 0 {
+		return errors.New("maxFailures must not be negative")
+	}
+	if spec.MaxFailureRatio < 0 || spec.MaxFailureRatio > 1 {
+		return errors.New("maxFailureRatio must be between 0 and 1")
+	}
+	if spec.Type == orchestration.WavesStrategy {
+		return strategies.ValidateWavesStrategySpec(spec.Waves)
+	}
 	return nil
 }
 
@@ -47,6 +68,17 @@ func defaultOrchestrationStrategy(spec *orchestration.StrategySpec) {
 
 	switch spec.Type {
 	case orchestration.ParallelStrategy:
+	case orchestration.WavesStrategy:
+		if spec.Waves.Canary == "" {
+			spec.Waves.Canary = "1"
+		}
+		if spec.Waves.Factor == 0 {
+			spec.Waves.Factor = strategies.DefaultWaveFactor
+		}
+		if spec.Waves.SuccessThreshold == nil {
+			threshold := strategies.DefaultWaveSuccessThreshold
+			spec.Waves.SuccessThreshold = &threshold
+		}
 	default:
 		spec.Type = orchestration.ParallelStrategy
 	}
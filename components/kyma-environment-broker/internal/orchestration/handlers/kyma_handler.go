@@ -74,6 +74,14 @@ func (h *kymaHandler) createOrchestration(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	// validate strategy
+	err = validateStrategy(params.Strategy)
+	if err != nil {
+		h.log.Errorf("while validating strategy: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrapf(err, "while validating strategy"))
+		return
+	}
+
 	// defaults strategy if not specified to Parallel with Immediate schedule
 	defaultOrchestrationStrategy(&params.Strategy)
 
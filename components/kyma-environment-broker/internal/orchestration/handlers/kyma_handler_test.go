@@ -65,6 +65,43 @@ func TestKymaHandler_AttachRoutes(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotEmpty(t, out.OrchestrationID)
 	})
+
+	t.Run("upgrade with invalid strategy", func(t *testing.T) {
+		// given
+		kHandler := fixKymaHandler(t)
+
+		params := orchestration.Parameters{
+			Targets: orchestration.TargetSpec{
+				Include: []orchestration.RuntimeTarget{
+					{
+						RuntimeID: "test",
+					},
+				},
+			},
+			Kyma: &orchestration.KymaParameters{
+				Version: "",
+			},
+			Strategy: orchestration.StrategySpec{
+				Type:            orchestration.ParallelStrategy,
+				MaxFailureRatio: 2,
+			},
+		}
+		p, err := json.Marshal(&params)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/upgrade/kyma", bytes.NewBuffer(p))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		kHandler.AttachRoutes(router)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
 }
 
 // Testing Kyma Version is disabled due to GitHub API RATE limits
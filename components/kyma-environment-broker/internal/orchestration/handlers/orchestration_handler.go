@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -32,6 +33,9 @@ type orchestrationHandler struct {
 	canceler       *Canceler
 	kymaRetryer    *kymaRetryer
 	clusterRetryer *clusterRetryer
+	taskRetryer    *taskRetryer
+	pauser         *Pauser
+	rollbacker     *RollbackCreator
 
 	defaultMaxPage int
 }
@@ -42,8 +46,10 @@ func NewOrchestrationStatusHandler(operations storage.Operations,
 	runtimeStates storage.RuntimeStates,
 	kymaQueue *process.Queue,
 	clusterQueue *process.Queue,
+	taskQueue *process.Queue,
 	defaultMaxPage int,
 	log logrus.FieldLogger) *orchestrationHandler {
+	queues := newOrchestrationQueues(kymaQueue, clusterQueue, taskQueue)
 	return &orchestrationHandler{
 		operations:     operations,
 		orchestrations: orchestrations,
@@ -51,9 +57,12 @@ func NewOrchestrationStatusHandler(operations storage.Operations,
 		log:            log,
 		defaultMaxPage: defaultMaxPage,
 		converter:      Converter{},
-		canceler:       NewCanceler(orchestrations, log),
+		canceler:       NewCanceler(orchestrations, queues, log),
+		pauser:         NewPauser(orchestrations, operations, queues, log),
+		rollbacker:     NewRollbackCreator(orchestrations, operations, kymaQueue, log),
 		kymaRetryer:    NewKymaRetryer(orchestrations, operations, kymaQueue, log),
 		clusterRetryer: NewClusterRetryer(orchestrations, operations, clusterQueue, log),
+		taskRetryer:    NewTaskRetryer(orchestrations, operations, taskQueue, log),
 	}
 }
 
@@ -61,9 +70,12 @@ func (h *orchestrationHandler) AttachRoutes(router *mux.Router) {
 	router.HandleFunc("/orchestrations", h.listOrchestration).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}", h.getOrchestration).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/cancel", h.cancelOrchestrationByID).Methods(http.MethodPut)
+	router.HandleFunc("/orchestrations/{orchestration_id}/pause", h.pauseOrchestrationByID).Methods(http.MethodPut)
+	router.HandleFunc("/orchestrations/{orchestration_id}/resume", h.resumeOrchestrationByID).Methods(http.MethodPut)
 	router.HandleFunc("/orchestrations/{orchestration_id}/operations", h.listOperations).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/operations/{operation_id}", h.getOperation).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/retry", h.retryOrchestrationByID).Methods(http.MethodPost)
+	router.HandleFunc("/orchestrations/{orchestration_id}/rollback", h.rollbackOrchestrationByID).Methods(http.MethodPost)
 }
 
 func (h *orchestrationHandler) getOrchestration(w http.ResponseWriter, r *http.Request) {
@@ -108,6 +120,61 @@ func (h *orchestrationHandler) cancelOrchestrationByID(w http.ResponseWriter, r
 	httputil.WriteResponse(w, http.StatusOK, response)
 }
 
+func (h *orchestrationHandler) pauseOrchestrationByID(w http.ResponseWriter, r *http.Request) {
+	orchestrationID := mux.Vars(r)["orchestration_id"]
+
+	err := h.pauser.PauseForID(orchestrationID)
+	if err != nil {
+		h.log.Errorf("while pausing orchestration %s: %v", orchestrationID, err)
+		httputil.WriteErrorResponse(w, h.resolveErrorStatus(err), errors.Wrapf(err, "while pausing orchestration %s", orchestrationID))
+		return
+	}
+
+	response := commonOrchestration.UpgradeResponse{OrchestrationID: orchestrationID}
+
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
+
+func (h *orchestrationHandler) resumeOrchestrationByID(w http.ResponseWriter, r *http.Request) {
+	orchestrationID := mux.Vars(r)["orchestration_id"]
+
+	err := h.pauser.ResumeForID(orchestrationID)
+	if err != nil {
+		h.log.Errorf("while resuming orchestration %s: %v", orchestrationID, err)
+		httputil.WriteErrorResponse(w, h.resolveErrorStatus(err), errors.Wrapf(err, "while resuming orchestration %s", orchestrationID))
+		return
+	}
+
+	response := commonOrchestration.UpgradeResponse{OrchestrationID: orchestrationID}
+
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
+
+func (h *orchestrationHandler) rollbackOrchestrationByID(w http.ResponseWriter, r *http.Request) {
+	orchestrationID := mux.Vars(r)["orchestration_id"]
+
+	request := commonOrchestration.RollbackRequest{}
+	if r.Body != nil && r.ContentLength != 0 {
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			h.log.Errorf("while decoding request body: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrapf(err, "while decoding request body"))
+			return
+		}
+	}
+
+	rollbackID, err := h.rollbacker.RollbackForID(orchestrationID, request)
+	if err != nil {
+		h.log.Errorf("while rolling back orchestration %s: %v", orchestrationID, err)
+		httputil.WriteErrorResponse(w, h.resolveErrorStatus(err), errors.Wrapf(err, "while rolling back orchestration %s", orchestrationID))
+		return
+	}
+
+	response := commonOrchestration.UpgradeResponse{OrchestrationID: rollbackID}
+
+	httputil.WriteResponse(w, http.StatusAccepted, response)
+}
+
 func (h *orchestrationHandler) retryOrchestrationByID(w http.ResponseWriter, r *http.Request) {
 	contentType := r.Header.Get("Content-type")
 	if contentType != "application/x-www-form-urlencoded" {
@@ -142,7 +209,7 @@ func (h *orchestrationHandler) retryOrchestrationByID(w http.ResponseWriter, r *
 
 	var response commonOrchestration.RetryResponse
 	switch o.Type {
-	case commonOrchestration.UpgradeKymaOrchestration:
+	case commonOrchestration.UpgradeKymaOrchestration, commonOrchestration.RollbackKymaOrchestration:
 		allOps, _, _, err := h.operations.ListUpgradeKymaOperationsByOrchestrationID(o.OrchestrationID, filter)
 		if err != nil {
 			h.log.Errorf("while getting operations: %v", err)
@@ -170,6 +237,20 @@ func (h *orchestrationHandler) retryOrchestrationByID(w http.ResponseWriter, r *
 			return
 		}
 
+	case commonOrchestration.RunTaskOrchestration:
+		allOps, _, _, err := h.operations.ListRunTaskOperationsByOrchestrationID(o.OrchestrationID, filter)
+		if err != nil {
+			h.log.Errorf("while getting operations: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, errors.Wrapf(err, "while getting operations"))
+			return
+		}
+
+		response, err = h.taskRetryer.orchestrationRetry(o, allOps, operationIDs)
+		if err != nil {
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, err)
+			return
+		}
+
 	default:
 		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("unsupported orchestration type: %s", o.Type))
 		return
@@ -233,7 +314,7 @@ func (h *orchestrationHandler) listOperations(w http.ResponseWriter, r *http.Req
 
 	var response commonOrchestration.OperationResponseList
 	switch o.Type {
-	case commonOrchestration.UpgradeKymaOrchestration:
+	case commonOrchestration.UpgradeKymaOrchestration, commonOrchestration.RollbackKymaOrchestration:
 		operations, count, totalCount, err := h.operations.ListUpgradeKymaOperationsByOrchestrationID(orchestrationID, filter)
 		if err != nil {
 			h.log.Errorf("while getting operations: %v", err)
@@ -261,6 +342,20 @@ func (h *orchestrationHandler) listOperations(w http.ResponseWriter, r *http.Req
 			return
 		}
 
+	case commonOrchestration.RunTaskOrchestration:
+		operations, count, totalCount, err := h.operations.ListRunTaskOperationsByOrchestrationID(orchestrationID, filter)
+		if err != nil {
+			h.log.Errorf("while getting operations: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, errors.Wrapf(err, "while getting operations"))
+			return
+		}
+		response, err = h.converter.RunTaskOperationListToDTO(operations, count, totalCount)
+		if err != nil {
+			h.log.Errorf("while converting operations: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, errors.Wrapf(err, "while converting operations"))
+			return
+		}
+
 	default:
 		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("unsupported orchestration type: %s", o.Type))
 		return
@@ -289,7 +384,7 @@ func (h *orchestrationHandler) getOperation(w http.ResponseWriter, r *http.Reque
 
 	var response commonOrchestration.OperationDetailResponse
 	switch o.Type {
-	case commonOrchestration.UpgradeKymaOrchestration:
+	case commonOrchestration.UpgradeKymaOrchestration, commonOrchestration.RollbackKymaOrchestration:
 		operation, err := h.operations.GetUpgradeKymaOperationByID(operationID)
 		if err != nil {
 			h.log.Errorf("while getting upgrade operation %s: %v", operationID, err)
@@ -319,6 +414,21 @@ func (h *orchestrationHandler) getOperation(w http.ResponseWriter, r *http.Reque
 			return
 		}
 
+	case commonOrchestration.RunTaskOrchestration:
+		operation, err := h.operations.GetRunTaskOperationByID(operationID)
+		if err != nil {
+			h.log.Errorf("while getting run task operation %s: %v", operationID, err)
+			httputil.WriteErrorResponse(w, h.resolveErrorStatus(err), errors.Wrapf(err, "while getting operation %s", operationID))
+			return
+		}
+
+		response, err = h.converter.RunTaskOperationToDetailDTO(*operation)
+		if err != nil {
+			h.log.Errorf("while converting operation: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, errors.Wrapf(err, "while converting operation"))
+			return
+		}
+
 	default:
 		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("unsupported orchestration type: %s", o.Type))
 		return
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	orchestrationExt "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+type Pauser struct {
+	orchestrations storage.Orchestrations
+	operations     storage.Operations
+	queues         orchestrationQueues
+	log            logrus.FieldLogger
+}
+
+func NewPauser(orchestrations storage.Orchestrations, operations storage.Operations, queues orchestrationQueues, logger logrus.FieldLogger) *Pauser {
+	return &Pauser{
+		orchestrations: orchestrations,
+		operations:     operations,
+		queues:         queues,
+		log:            logger,
+	}
+}
+
+// orchestrationQueues are the queues of orchestrations by their type. The paused orchestration does not hold
+// a worker of its queue, so it is queued again when it is resumed or canceled.
+type orchestrationQueues map[orchestrationExt.Type]*process.Queue
+
+func newOrchestrationQueues(kymaQueue, clusterQueue, taskQueue *process.Queue) orchestrationQueues {
+	return orchestrationQueues{
+		orchestrationExt.UpgradeKymaOrchestration:    kymaQueue,
+		orchestrationExt.RollbackKymaOrchestration:   kymaQueue,
+		orchestrationExt.UpgradeClusterOrchestration: clusterQueue,
+		orchestrationExt.RunTaskOrchestration:        taskQueue,
+	}
+}
+
+func (q orchestrationQueues) add(o *internal.Orchestration) error {
+	queue := q[o.Type]
+	if queue == nil {
+		return errors.Errorf("unsupported orchestration type %s", o.Type)
+	}
+	queue.Add(o.OrchestrationID)
+	return nil
+}
+
+// PauseForID pauses orchestration in progress by ID. Operations in progress are finished, pending operations are not started.
+func (p *Pauser) PauseForID(orchestrationID string) error {
+	o, err := p.orchestrations.GetByID(orchestrationID)
+	if err != nil {
+		return errors.Wrap(err, "while getting orchestration")
+	}
+	if o.State == orchestrationExt.Paused {
+		return nil
+	}
+	if o.State != orchestrationExt.InProgress {
+		return apiErrors.NewBadRequest(fmt.Sprintf("orchestration in %s state cannot be paused", o.State))
+	}
+
+	o.UpdatedAt = time.Now()
+	o.Description = "Orchestration was paused"
+	o.State = orchestrationExt.Paused
+	err = p.orchestrations.Update(*o)
+	if err != nil {
+		return errors.Wrap(err, "while updating orchestration")
+	}
+	return nil
+}
+
+// ResumeForID resumes paused orchestration by ID and queues it for processing of the pending operations. Failure thresholds
+// which are already exceeded are raised to the current values, so the orchestration is paused again when the next operation fails.
+func (p *Pauser) ResumeForID(orchestrationID string) error {
+	o, err := p.orchestrations.GetByID(orchestrationID)
+	if err != nil {
+		return errors.Wrap(err, "while getting orchestration")
+	}
+	if o.State == orchestrationExt.InProgress {
+		return nil
+	}
+	if o.State != orchestrationExt.Paused {
+		return apiErrors.NewBadRequest(fmt.Sprintf("orchestration in %s state cannot be resumed", o.State))
+	}
+
+	stats, err := p.operations.GetOperationStatsForOrchestration(orchestrationID)
+	if err != nil {
+		return errors.Wrap(err, "while getting operation stats")
+	}
+	failed, total := stats[orchestrationExt.Failed], 0
+	for _, count := range stats {
+		total += count
+	}
+	strategy := &o.Parameters.Strategy
+	if strategy.MaxFailures > 0 && failed > strategy.MaxFailures {
+		p.log.Infof("raising maxFailures of orchestration %s to %d", orchestrationID, failed)
+		strategy.MaxFailures = failed
+	}
+	if strategy.MaxFailureRatio > 0 && total > 0 && float64(failed)/float64(total) > strategy.MaxFailureRatio {
+		p.log.Infof("raising maxFailureRatio of orchestration %s to %d/%d", orchestrationID, failed, total)
+		strategy.MaxFailureRatio = float64(failed) / float64(total)
+	}
+
+	o.UpdatedAt = time.Now()
+	o.Description = "Orchestration was resumed"
+	o.State = orchestrationExt.InProgress
+	err = p.orchestrations.Update(*o)
+	if err != nil {
+		return errors.Wrap(err, "while updating orchestration")
+	}
+
+	return errors.Wrap(p.queues.add(o), "while queueing orchestration")
+}
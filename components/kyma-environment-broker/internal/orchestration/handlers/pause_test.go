@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestPauser_PauseForID(t *testing.T) {
+	t.Run("should pause orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		err := s.Orchestrations().Insert(fixOrchestration())
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), s.Operations(), nil, logrus.New())
+
+		err = p.PauseForID(fixOrchestrationID)
+		require.NoError(t, err)
+
+		o, err := s.Orchestrations().GetByID(fixOrchestrationID)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Paused, o.State)
+	})
+	t.Run("should not pause finished orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Succeeded
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), s.Operations(), nil, logrus.New())
+
+		err = p.PauseForID(fixOrchestrationID)
+		assert.True(t, apiErrors.IsBadRequest(err))
+	})
+	t.Run("should return error when orchestration not found", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		p := NewPauser(s.Orchestrations(), s.Operations(), nil, logrus.New())
+
+		err := p.PauseForID(fixOrchestrationID)
+		assert.Error(t, err)
+	})
+}
+
+func TestPauser_ResumeForID(t *testing.T) {
+	t.Run("should resume orchestration and queue it", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.Type = orchestration.UpgradeClusterOrchestration
+		o.State = orchestration.Paused
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		logs := logrus.New()
+		executor := &queuedExecutor{executed: make(chan string, 1)}
+		clusterQueue := process.NewQueue(executor, logs)
+		stop := make(chan struct{})
+		defer close(stop)
+		clusterQueue.Run(stop, 1)
+		p := NewPauser(s.Orchestrations(), s.Operations(), newOrchestrationQueues(nil, clusterQueue, nil), logs)
+
+		err = p.ResumeForID(fixOrchestrationID)
+		require.NoError(t, err)
+
+		resumed, err := s.Orchestrations().GetByID(fixOrchestrationID)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.InProgress, resumed.State)
+		select {
+		case id := <-executor.executed:
+			assert.Equal(t, fixOrchestrationID, id)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the resumed orchestration was not queued")
+		}
+	})
+	t.Run("should raise exceeded failure thresholds", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Paused
+		o.Type = orchestration.UpgradeKymaOrchestration
+		o.Parameters.Strategy.MaxFailures = 1
+		o.Parameters.Strategy.MaxFailureRatio = 0.1
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+		for i, state := range []domain.LastOperationState{orchestration.Failed, orchestration.Failed, orchestration.Succeeded, orchestration.Pending} {
+			err = s.Operations().InsertUpgradeKymaOperation(internal.UpgradeKymaOperation{
+				Operation: internal.Operation{
+					ID:              string(rune('a' + i)),
+					OrchestrationID: fixOrchestrationID,
+					State:           state,
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		logs := logrus.New()
+		p := NewPauser(s.Orchestrations(), s.Operations(), newOrchestrationQueues(process.NewQueue(&testExecutor{}, logs), nil, nil), logs)
+
+		err = p.ResumeForID(fixOrchestrationID)
+		require.NoError(t, err)
+
+		resumed, err := s.Orchestrations().GetByID(fixOrchestrationID)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.InProgress, resumed.State)
+		assert.Equal(t, 2, resumed.Parameters.Strategy.MaxFailures)
+		assert.Equal(t, 0.5, resumed.Parameters.Strategy.MaxFailureRatio)
+	})
+	t.Run("should not resume canceled orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Canceled
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), s.Operations(), nil, logrus.New())
+
+		err = p.ResumeForID(fixOrchestrationID)
+		assert.True(t, apiErrors.IsBadRequest(err))
+	})
+}
+
+// queuedExecutor records the IDs of processed orchestrations
+type queuedExecutor struct {
+	executed chan string
+}
+
+func (e *queuedExecutor) Execute(orchestrationID string) (time.Duration, error) {
+	e.executed <- orchestrationID
+	return 0, nil
+}
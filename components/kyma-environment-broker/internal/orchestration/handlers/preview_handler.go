@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Previewer resolves the runtimes targeted by the orchestration and estimates its schedule without creating the orchestration
+type Previewer interface {
+	Preview(request orchestration.PreviewRequest) (orchestration.PreviewResponse, error)
+}
+
+type previewHandler struct {
+	previewer Previewer
+	log       logrus.FieldLogger
+}
+
+func NewPreviewHandler(previewer Previewer, log logrus.FieldLogger) *previewHandler {
+	return &previewHandler{
+		previewer: previewer,
+		log:       log,
+	}
+}
+
+func (h *previewHandler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/orchestrations/preview", h.previewOrchestration).Methods(http.MethodPost)
+}
+
+func (h *previewHandler) previewOrchestration(w http.ResponseWriter, r *http.Request) {
+	request := orchestration.PreviewRequest{}
+	if r.Body != nil {
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			h.log.Errorf("while decoding request body: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrapf(err, "while decoding request body"))
+			return
+		}
+	}
+
+	switch request.Type {
+	case "":
+		request.Type = orchestration.UpgradeKymaOrchestration
+	case orchestration.UpgradeKymaOrchestration, orchestration.UpgradeClusterOrchestration, orchestration.RunTaskOrchestration:
+	default:
+		h.log.Errorf("invalid orchestration type %s", request.Type)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("orchestration of %s type cannot be previewed", request.Type))
+		return
+	}
+
+	err := validateTarget(request.Parameters.Targets)
+	if err != nil {
+		h.log.Errorf("while validating target: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrapf(err, "while validating target"))
+		return
+	}
+
+	err = validateStrategy(request.Parameters.Strategy)
+	if err != nil {
+		h.log.Errorf("while validating strategy: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrapf(err, "while validating strategy"))
+		return
+	}
+	defaultOrchestrationStrategy(&request.Parameters.Strategy)
+
+	response, err := h.previewer.Preview(request)
+	if err != nil {
+		h.log.Errorf("while previewing orchestration: %v", err)
+		status := http.StatusInternalServerError
+		if apiErrors.IsBadRequest(errors.Cause(err)) {
+			status = http.StatusBadRequest
+		}
+		httputil.WriteErrorResponse(w, status, errors.Wrapf(err, "while previewing orchestration"))
+		return
+	}
+
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewHandler_AttachRoutes(t *testing.T) {
+	t.Run("preview", func(t *testing.T) {
+		// given
+		previewer := &testPreviewer{response: orchestration.PreviewResponse{Count: 1}}
+		router := mux.NewRouter()
+		NewPreviewHandler(previewer, logrus.New()).AttachRoutes(router)
+
+		request := orchestration.PreviewRequest{
+			Parameters: orchestration.Parameters{
+				Targets: orchestration.TargetSpec{
+					Include: []orchestration.RuntimeTarget{{Target: orchestration.TargetAll}},
+				},
+			},
+		}
+		p, err := json.Marshal(&request)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/orchestrations/preview", bytes.NewBuffer(p))
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var out orchestration.PreviewResponse
+		err = json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+		assert.Equal(t, 1, out.Count)
+
+		require.Len(t, previewer.requests, 1)
+		assert.Equal(t, orchestration.UpgradeKymaOrchestration, previewer.requests[0].Type)
+		assert.Equal(t, orchestration.ParallelStrategy, previewer.requests[0].Parameters.Strategy.Type)
+		assert.Equal(t, orchestration.Immediate, previewer.requests[0].Parameters.Strategy.Schedule)
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		// given
+		previewer := &testPreviewer{}
+		router := mux.NewRouter()
+		NewPreviewHandler(previewer, logrus.New()).AttachRoutes(router)
+
+		p, err := json.Marshal(&orchestration.PreviewRequest{})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/orchestrations/preview", bytes.NewBuffer(p))
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Empty(t, previewer.requests)
+	})
+}
+
+type testPreviewer struct {
+	requests []orchestration.PreviewRequest
+	response orchestration.PreviewResponse
+}
+
+func (p *testPreviewer) Preview(request orchestration.PreviewRequest) (orchestration.PreviewResponse, error) {
+	p.requests = append(p.requests, request)
+	return p.response, nil
+}
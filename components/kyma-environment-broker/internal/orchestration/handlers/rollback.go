@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	orchestrationExt "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+type RollbackCreator struct {
+	orchestrations storage.Orchestrations
+	operations     storage.Operations
+	queue          *process.Queue
+	log            logrus.FieldLogger
+}
+
+func NewRollbackCreator(orchestrations storage.Orchestrations, operations storage.Operations, q *process.Queue, logger logrus.FieldLogger) *RollbackCreator {
+	return &RollbackCreator{
+		orchestrations: orchestrations,
+		operations:     operations,
+		queue:          q,
+		log:            logger,
+	}
+}
+
+// RollbackForID creates the rollback kyma orchestration, which restores the runtimes upgraded by the finished orchestration
+// to their Kyma version and components from before the upgrade. It returns the ID of the created orchestration.
+func (r *RollbackCreator) RollbackForID(orchestrationID string, request orchestrationExt.RollbackRequest) (string, error) {
+	o, err := r.orchestrations.GetByID(orchestrationID)
+	if err != nil {
+		return "", errors.Wrap(err, "while getting orchestration")
+	}
+	if o.Type != orchestrationExt.UpgradeKymaOrchestration {
+		return "", apiErrors.NewBadRequest(fmt.Sprintf("orchestration of %s type cannot be rolled back", o.Type))
+	}
+	if !o.IsFinished() {
+		return "", apiErrors.NewBadRequest(fmt.Sprintf("orchestration in %s state cannot be rolled back", o.State))
+	}
+	if err := validateStrategy(request.Strategy); err != nil {
+		return "", apiErrors.NewBadRequest(errors.Wrap(err, "while validating strategy").Error())
+	}
+
+	// only operations which were started could change the runtimes
+	ops, _, _, err := r.operations.ListUpgradeKymaOperationsByOrchestrationID(orchestrationID, dbmodel.OperationFilter{
+		States: []string{orchestrationExt.Succeeded, orchestrationExt.Failed},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "while listing upgrade kyma operations")
+	}
+	targets := orchestrationExt.TargetSpec{}
+	runtimeIDs := map[string]bool{}
+	for _, op := range ops {
+		if op.RuntimeOperation.RuntimeID == "" || runtimeIDs[op.RuntimeOperation.RuntimeID] {
+			continue
+		}
+		runtimeIDs[op.RuntimeOperation.RuntimeID] = true
+		targets.Include = append(targets.Include, orchestrationExt.RuntimeTarget{RuntimeID: op.RuntimeOperation.RuntimeID})
+	}
+	if len(targets.Include) == 0 {
+		return "", apiErrors.NewBadRequest(fmt.Sprintf("orchestration %s did not upgrade any runtime", orchestrationID))
+	}
+
+	strategy := request.Strategy
+	defaultOrchestrationStrategy(&strategy)
+
+	now := time.Now()
+	rollback := internal.Orchestration{
+		OrchestrationID: uuid.New().String(),
+		Type:            orchestrationExt.RollbackKymaOrchestration,
+		State:           orchestrationExt.Pending,
+		Description:     "queued for processing",
+		Parameters: orchestrationExt.Parameters{
+			Targets:  targets,
+			Strategy: strategy,
+			DryRun:   request.DryRun,
+			Kyma:     &orchestrationExt.KymaParameters{},
+			Rollback: &orchestrationExt.RollbackParameters{OrchestrationID: orchestrationID},
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	err = r.orchestrations.Insert(rollback)
+	if err != nil {
+		return "", errors.Wrap(err, "while inserting orchestration to storage")
+	}
+	r.log.Infof("Created rollback orchestration %s of orchestration %s for %d runtimes", rollback.OrchestrationID, orchestrationID, len(targets.Include))
+
+	r.queue.Add(rollback.OrchestrationID)
+
+	return rollback.OrchestrationID, nil
+}
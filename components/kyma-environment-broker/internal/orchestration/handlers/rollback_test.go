@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestRollbackCreator_RollbackForID(t *testing.T) {
+	t.Run("should create rollback orchestration for upgraded runtimes", func(t *testing.T) {
+		// given
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.Type = orchestration.UpgradeKymaOrchestration
+		o.State = orchestration.Failed
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+		for id, state := range map[string]domain.LastOperationState{
+			"runtime-1": orchestration.Succeeded,
+			"runtime-2": orchestration.Failed,
+			"runtime-3": orchestration.Canceled,
+		} {
+			err = s.Operations().InsertUpgradeKymaOperation(internal.UpgradeKymaOperation{
+				Operation: internal.Operation{
+					ID:              "op-" + id,
+					OrchestrationID: fixOrchestrationID,
+					State:           state,
+				},
+				RuntimeOperation: orchestration.RuntimeOperation{Runtime: orchestration.Runtime{RuntimeID: id}},
+			})
+			require.NoError(t, err)
+		}
+
+		logs := logrus.New()
+		r := NewRollbackCreator(s.Orchestrations(), s.Operations(), process.NewQueue(&testExecutor{}, logs), logs)
+
+		// when
+		rollbackID, err := r.RollbackForID(fixOrchestrationID, orchestration.RollbackRequest{DryRun: true})
+
+		// then
+		require.NoError(t, err)
+		rollback, err := s.Orchestrations().GetByID(rollbackID)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.RollbackKymaOrchestration, rollback.Type)
+		assert.Equal(t, orchestration.Pending, rollback.State)
+		assert.Equal(t, fixOrchestrationID, rollback.Parameters.Rollback.OrchestrationID)
+		assert.True(t, rollback.Parameters.DryRun)
+		assert.Equal(t, orchestration.ParallelStrategy, rollback.Parameters.Strategy.Type)
+		assert.ElementsMatch(t, []orchestration.RuntimeTarget{{RuntimeID: "runtime-1"}, {RuntimeID: "runtime-2"}}, rollback.Parameters.Targets.Include)
+	})
+	t.Run("should not roll back orchestration in progress", func(t *testing.T) {
+		// given
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.Type = orchestration.UpgradeKymaOrchestration
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		logs := logrus.New()
+		r := NewRollbackCreator(s.Orchestrations(), s.Operations(), process.NewQueue(&testExecutor{}, logs), logs)
+
+		// when
+		_, err = r.RollbackForID(fixOrchestrationID, orchestration.RollbackRequest{})
+
+		// then
+		assert.True(t, apiErrors.IsBadRequest(err))
+	})
+	t.Run("should not roll back cluster upgrade", func(t *testing.T) {
+		// given
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.Type = orchestration.UpgradeClusterOrchestration
+		o.State = orchestration.Succeeded
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		logs := logrus.New()
+		r := NewRollbackCreator(s.Orchestrations(), s.Operations(), process.NewQueue(&testExecutor{}, logs), logs)
+
+		// when
+		_, err = r.RollbackForID(fixOrchestrationID, orchestration.RollbackRequest{})
+
+		// then
+		assert.True(t, apiErrors.IsBadRequest(err))
+	})
+	t.Run("should not roll back orchestration without upgraded runtimes", func(t *testing.T) {
+		// given
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.Type = orchestration.UpgradeKymaOrchestration
+		o.State = orchestration.Canceled
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		logs := logrus.New()
+		r := NewRollbackCreator(s.Orchestrations(), s.Operations(), process.NewQueue(&testExecutor{}, logs), logs)
+
+		// when
+		_, err = r.RollbackForID(fixOrchestrationID, orchestration.RollbackRequest{})
+
+		// then
+		assert.True(t, apiErrors.IsBadRequest(err))
+	})
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/run_task"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type taskHandler struct {
+	orchestrations storage.Orchestrations
+	queue          *process.Queue
+	converter      Converter
+	log            logrus.FieldLogger
+}
+
+func NewTaskHandler(orchestrations storage.Orchestrations, q *process.Queue, log logrus.FieldLogger) *taskHandler {
+	return &taskHandler{
+		orchestrations: orchestrations,
+		queue:          q,
+		log:            log,
+		converter:      Converter{},
+	}
+}
+
+func (h *taskHandler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/run/task", h.createOrchestration).Methods(http.MethodPost)
+}
+
+func (h *taskHandler) createOrchestration(w http.ResponseWriter, r *http.Request) {
+	// validate request body
+	params := orchestration.Parameters{}
+	if r.Body != nil {
+		err := json.NewDecoder(r.Body).Decode(&params)
+		if err != nil {
+			h.log.Errorf("while decoding request body: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrapf(err, "while decoding request body"))
+			return
+		}
+	}
+
+	// validate target
+	err := validateTarget(params.Targets)
+	if err != nil {
+		h.log.Errorf("while validating target: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrapf(err, "while validating target"))
+		return
+	}
+
+	// validate strategy
+	err = validateStrategy(params.Strategy)
+	if err != nil {
+		h.log.Errorf("while validating strategy: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrapf(err, "while validating strategy"))
+		return
+	}
+
+	// validate task, the default timeout does not matter here
+	if params.Task == nil {
+		h.log.Errorf("while validating task: task is not specified")
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.New("while validating task: task must be specified"))
+		return
+	}
+	_, err = run_task.ParseTask(*params.Task, time.Hour)
+	if err != nil {
+		h.log.Errorf("while validating task: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrapf(err, "while validating task"))
+		return
+	}
+
+	// defaults strategy if not specified to Parallel with Immediate schedule
+	defaultOrchestrationStrategy(&params.Strategy)
+
+	now := time.Now()
+	o := internal.Orchestration{
+		OrchestrationID: uuid.New().String(),
+		Type:            orchestration.RunTaskOrchestration,
+		State:           orchestration.Pending,
+		Description:     "queued for processing",
+		Parameters:      params,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	err = h.orchestrations.Insert(o)
+	if err != nil {
+		h.log.Errorf("while inserting orchestration to storage: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, errors.Wrapf(err, "while inserting orchestration to storage"))
+		return
+	}
+
+	h.queue.Add(o.OrchestrationID)
+
+	response := orchestration.UpgradeResponse{OrchestrationID: o.OrchestrationID}
+
+	httputil.WriteResponse(w, http.StatusAccepted, response)
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fixTaskJob = `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: cleanup
+  namespace: kyma-system
+spec:
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: cleanup
+        image: busybox
+`
+
+func TestTaskHandler_AttachRoutes(t *testing.T) {
+	for tn, tc := range map[string]struct {
+		task         *orchestration.TaskParameters
+		expectedCode int
+	}{
+		"job": {
+			task:         &orchestration.TaskParameters{Job: fixTaskJob, Timeout: "30m"},
+			expectedCode: http.StatusAccepted,
+		},
+		"no task": {
+			expectedCode: http.StatusBadRequest,
+		},
+		"empty task": {
+			task:         &orchestration.TaskParameters{},
+			expectedCode: http.StatusBadRequest,
+		},
+		"invalid timeout": {
+			task:         &orchestration.TaskParameters{Job: fixTaskJob, Timeout: "soon"},
+			expectedCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			db := storage.NewMemoryStorage()
+			logs := logrus.New()
+			handler := NewTaskHandler(db.Orchestrations(), process.NewQueue(&testExecutor{}, logs), logs)
+
+			params := orchestration.Parameters{
+				Targets: orchestration.TargetSpec{
+					Include: []orchestration.RuntimeTarget{{RuntimeID: "test"}},
+				},
+				Task: tc.task,
+			}
+			p, err := json.Marshal(&params)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest("POST", "/run/task", bytes.NewBuffer(p))
+			require.NoError(t, err)
+
+			rr := httptest.NewRecorder()
+			router := mux.NewRouter()
+			handler.AttachRoutes(router)
+
+			// when
+			router.ServeHTTP(rr, req)
+
+			// then
+			require.Equal(t, tc.expectedCode, rr.Code)
+			if tc.expectedCode != http.StatusAccepted {
+				return
+			}
+
+			var out orchestration.UpgradeResponse
+			err = json.Unmarshal(rr.Body.Bytes(), &out)
+			require.NoError(t, err)
+
+			o, err := db.Orchestrations().GetByID(out.OrchestrationID)
+			require.NoError(t, err)
+			assert.Equal(t, orchestration.RunTaskOrchestration, o.Type)
+			assert.Equal(t, fixTaskJob, o.Parameters.Task.Job)
+			assert.Equal(t, orchestration.ParallelStrategy, o.Parameters.Strategy.Type)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"time"
+
+	commonOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type taskRetryer Retryer
+
+func NewTaskRetryer(orchestrations storage.Orchestrations, operations storage.Operations, q *process.Queue, logger logrus.FieldLogger) *taskRetryer {
+	return &taskRetryer{
+		orchestrations: orchestrations,
+		operations:     operations,
+		queue:          q,
+		log:            logger,
+	}
+}
+
+// orchestrationRetry runs the task again in the runtimes of the failed operations.
+// Tasks of different orchestrations do not depend on each other, so the operations are retried even if newer operations exist for the same instance.
+func (r *taskRetryer) orchestrationRetry(o *internal.Orchestration, opsByOrch []internal.RunTaskOperation, operationIDs []string) (commonOrchestration.RetryResponse, error) {
+	resp := commonOrchestration.RetryResponse{OrchestrationID: o.OrchestrationID}
+
+	ops, invalidIDs := r.orchestrationOperationsFilter(opsByOrch, operationIDs)
+	resp.InvalidOperations = invalidIDs
+	if len(ops) == 0 {
+		zeroValidOperationInfo(&resp, r.log)
+		return resp, nil
+	}
+
+	for _, op := range ops {
+		resp.RetryOperations = append(resp.RetryOperations, op.Operation.ID)
+	}
+	resp.Msg = "retry operations are queued for processing"
+
+	err := r.OperationsStateUpdate(ops)
+	if err != nil {
+		return resp, err
+	}
+
+	// get orchestration state again in case in progress changed to failed, need to put in queue
+	lastState, err := orchestrationStateUpdate(r.orchestrations, o.OrchestrationID, r.log)
+	if err != nil {
+		return resp, err
+	}
+
+	if lastState == commonOrchestration.Failed {
+		r.queue.Add(o.OrchestrationID)
+	}
+
+	return resp, nil
+}
+
+func (r *taskRetryer) orchestrationOperationsFilter(opsByOrch []internal.RunTaskOperation, opsIDs []string) ([]internal.RunTaskOperation, []string) {
+	if len(opsIDs) <= 0 {
+		return opsByOrch, nil
+	}
+
+	var retOps []internal.RunTaskOperation
+	var invalidIDs []string
+
+	for _, opID := range opsIDs {
+		found := false
+		for _, op := range opsByOrch {
+			if opID == op.Operation.ID {
+				retOps = append(retOps, op)
+				found = true
+				break
+			}
+		}
+		if !found {
+			invalidIDs = append(invalidIDs, opID)
+		}
+	}
+
+	return retOps, invalidIDs
+}
+
+func (r *taskRetryer) OperationsStateUpdate(ops []internal.RunTaskOperation) error {
+	for _, op := range ops {
+		op.State = commonOrchestration.Retrying
+		op.UpdatedAt = time.Now()
+		op.Description = "queued for retrying"
+
+		_, err := r.operations.UpdateRunTaskOperation(op)
+		if err != nil {
+			// one update fail then http return
+			r.log.Errorf("Cannot update operation %s in storage: %s", op.Operation.ID, err)
+			return errors.Wrapf(err, "while updating orchestration %s", op.OrchestrationID)
+		}
+	}
+
+	return nil
+}
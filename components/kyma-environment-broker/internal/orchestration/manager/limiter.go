@@ -0,0 +1,207 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	coreV1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	concurrencyLimitsKeyName = "concurrencyLimits"
+	// concurrencyLimitsRefreshInterval is the time for which the concurrency limits read from the orchestration config are cached
+	concurrencyLimitsRefreshInterval = time.Minute
+)
+
+// GlobalLimiter counts the operations in progress of all orchestrations and caps them with the concurrency limits
+// from the orchestration config. One limiter is shared by the managers of all orchestration types. The limits apply only
+// to operations of orchestrations, operations started by the broker API, like provisioning or update, are not counted.
+type GlobalLimiter struct {
+	k8sClient       client.Client
+	configNamespace string
+	configName      string
+	log             logrus.FieldLogger
+
+	limitsMux    sync.Mutex
+	limits       orchestration.ConcurrencyLimits
+	limitsReadAt time.Time
+
+	mux        sync.Mutex
+	inProgress map[string]limitedOperation
+}
+
+// limitedOperation is the operation in progress which holds a slot of the limiter
+type limitedOperation struct {
+	orchestrationID string
+	runtime         orchestration.Runtime
+}
+
+func NewGlobalLimiter(cli client.Client, cfg internalOrchestration.Config, log logrus.FieldLogger) *GlobalLimiter {
+	return &GlobalLimiter{
+		k8sClient:       cli,
+		configNamespace: cfg.Namespace,
+		configName:      cfg.Name,
+		log:             log,
+		inProgress:      map[string]limitedOperation{},
+	}
+}
+
+// Restore reserves the slots for the operations in progress of orchestrations processed before the restart,
+// so the limits are kept until these operations are resumed and released
+func (l *GlobalLimiter) Restore(orchestrations storage.Orchestrations, operations storage.Operations) error {
+	list, _, _, err := orchestrations.List(dbmodel.OrchestrationFilter{
+		States: []string{orchestration.InProgress, orchestration.Canceling, orchestration.Retrying},
+	})
+	if err != nil {
+		return errors.Wrap(err, "while listing orchestrations")
+	}
+
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	filter := dbmodel.OperationFilter{States: []string{orchestration.InProgress}}
+	for _, o := range list {
+		var ops []orchestration.RuntimeOperation
+		switch o.Type {
+		case orchestration.UpgradeKymaOrchestration, orchestration.RollbackKymaOrchestration:
+			upgrades, _, _, err := operations.ListUpgradeKymaOperationsByOrchestrationID(o.OrchestrationID, filter)
+			if err != nil {
+				return errors.Wrapf(err, "while listing operations of orchestration %s", o.OrchestrationID)
+			}
+			for _, op := range upgrades {
+				ops = append(ops, op.RuntimeOperation)
+			}
+		case orchestration.UpgradeClusterOrchestration:
+			upgrades, _, _, err := operations.ListUpgradeClusterOperationsByOrchestrationID(o.OrchestrationID, filter)
+			if err != nil {
+				return errors.Wrapf(err, "while listing operations of orchestration %s", o.OrchestrationID)
+			}
+			for _, op := range upgrades {
+				ops = append(ops, op.RuntimeOperation)
+			}
+		case orchestration.RunTaskOrchestration:
+			tasks, _, _, err := operations.ListRunTaskOperationsByOrchestrationID(o.OrchestrationID, filter)
+			if err != nil {
+				return errors.Wrapf(err, "while listing operations of orchestration %s", o.OrchestrationID)
+			}
+			for _, op := range tasks {
+				ops = append(ops, op.RuntimeOperation)
+			}
+		}
+
+		for _, op := range ops {
+			l.inProgress[op.ID] = limitedOperation{orchestrationID: o.OrchestrationID, runtime: op.Runtime}
+		}
+		if len(ops) > 0 {
+			l.log.Infof("restored %d operations in progress of orchestration %s", len(ops), o.OrchestrationID)
+		}
+	}
+
+	return nil
+}
+
+// Acquire reserves a slot for the operation of the given orchestration. The operation is throttled if any limit is reached,
+// or if its runtime is processed by another operation. Depending on the conflict policy, the operation for the runtime
+// processed by another orchestration is rejected. The returned message describes why the operation cannot start.
+func (l *GlobalLimiter) Acquire(orchestrationID string, op orchestration.RuntimeOperation) (orchestration.LimitDecision, string) {
+	limits := l.getLimits()
+
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	if _, found := l.inProgress[op.ID]; found {
+		return orchestration.LimitAcquired, ""
+	}
+
+	total, provider, region, seed := 0, 0, 0, 0
+	for id, other := range l.inProgress {
+		if other.runtime.RuntimeID == op.RuntimeID {
+			msg := fmt.Sprintf("runtime %s is processed by operation %s of orchestration %s", op.RuntimeID, id, other.orchestrationID)
+			if limits.RuntimeConflict == orchestration.RejectConflict && other.orchestrationID != orchestrationID {
+				return orchestration.LimitRejected, msg
+			}
+			return orchestration.LimitThrottled, msg
+		}
+		total++
+		if op.Provider != "" && other.runtime.Provider == op.Provider {
+			provider++
+		}
+		if op.Region != "" && other.runtime.Region == op.Region {
+			region++
+		}
+		if op.Seed != "" && other.runtime.Seed == op.Seed {
+			seed++
+		}
+	}
+
+	switch {
+	case reached(total, limits.Total):
+		return orchestration.LimitThrottled, fmt.Sprintf("limit of %d operations in progress is reached", limits.Total)
+	case op.Provider != "" && reached(provider, orchestration.LimitFor(limits.Provider, op.Provider)):
+		return orchestration.LimitThrottled, fmt.Sprintf("limit of operations in progress for provider %s is reached", op.Provider)
+	case op.Region != "" && reached(region, orchestration.LimitFor(limits.Region, op.Region)):
+		return orchestration.LimitThrottled, fmt.Sprintf("limit of operations in progress for region %s is reached", op.Region)
+	case op.Seed != "" && reached(seed, orchestration.LimitFor(limits.Seed, op.Seed)):
+		return orchestration.LimitThrottled, fmt.Sprintf("limit of operations in progress for seed %s is reached", op.Seed)
+	}
+
+	l.inProgress[op.ID] = limitedOperation{orchestrationID: orchestrationID, runtime: op.Runtime}
+	return orchestration.LimitAcquired, ""
+}
+
+// Release frees the slot of the processed operation
+func (l *GlobalLimiter) Release(op orchestration.RuntimeOperation) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	delete(l.inProgress, op.ID)
+}
+
+func reached(count, limit int) bool {
+	return limit > 0 && count >= limit
+}
+
+// getLimits returns the concurrency limits from the orchestration config, there are no limits if they are not configured
+func (l *GlobalLimiter) getLimits() orchestration.ConcurrencyLimits {
+	l.limitsMux.Lock()
+	defer l.limitsMux.Unlock()
+
+	if time.Since(l.limitsReadAt) < concurrencyLimitsRefreshInterval {
+		return l.limits
+	}
+	// the previous limits are used until the config can be read again
+	l.limitsReadAt = time.Now()
+
+	config := &coreV1.ConfigMap{}
+	key := client.ObjectKey{Namespace: l.configNamespace, Name: l.configName}
+	if err := l.k8sClient.Get(context.Background(), key, config); err != nil {
+		if apiErrors.IsNotFound(err) {
+			l.limits = orchestration.ConcurrencyLimits{}
+			return l.limits
+		}
+		l.log.Warnf("while getting orchestration config, using the previous concurrency limits: %s", err)
+		return l.limits
+	}
+
+	limits := orchestration.ConcurrencyLimits{}
+	if config.Data[concurrencyLimitsKeyName] != "" {
+		if err := json.Unmarshal([]byte(config.Data[concurrencyLimitsKeyName]), &limits); err != nil {
+			l.log.Warnf("failed to unmarshal the concurrency limits config, using the previous concurrency limits: %s", err)
+			return l.limits
+		}
+	}
+	l.limits = limits
+
+	return l.limits
+}
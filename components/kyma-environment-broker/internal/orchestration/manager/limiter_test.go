@@ -0,0 +1,216 @@
+package manager_test
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration/manager"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/memory"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGlobalLimiter_Acquire(t *testing.T) {
+	t.Run("should not limit operations without config", func(t *testing.T) {
+		// given
+		limiter := manager.NewGlobalLimiter(fake.NewFakeClient(), limiterConfig(), logrus.New())
+
+		// when
+		first, _ := limiter.Acquire("o1", limitedOperation("op1", "r1", "aws", "eu-west-1", "seed-1"))
+		second, _ := limiter.Acquire("o2", limitedOperation("op2", "r2", "aws", "eu-west-1", "seed-1"))
+
+		// then
+		assert.Equal(t, orchestration.LimitAcquired, first)
+		assert.Equal(t, orchestration.LimitAcquired, second)
+	})
+
+	t.Run("should throttle operations over the total limit", func(t *testing.T) {
+		// given
+		limiter := newLimiter(`{"total":1}`)
+		op := limitedOperation("op1", "r1", "aws", "eu-west-1", "seed-1")
+		limiter.Acquire("o1", op)
+
+		// when
+		decision, msg := limiter.Acquire("o2", limitedOperation("op2", "r2", "gcp", "europe-west1", "seed-2"))
+
+		// then
+		assert.Equal(t, orchestration.LimitThrottled, decision)
+		assert.Equal(t, "limit of 1 operations in progress is reached", msg)
+
+		// when
+		limiter.Release(op)
+		decision, _ = limiter.Acquire("o2", limitedOperation("op2", "r2", "gcp", "europe-west1", "seed-2"))
+
+		// then
+		assert.Equal(t, orchestration.LimitAcquired, decision)
+	})
+
+	t.Run("should throttle operations over the provider, region and seed limits", func(t *testing.T) {
+		// given
+		limiter := newLimiter(`{"provider":{"azure":1,"*":2},"region":{"westeurope":1},"seed":{"seed-1":1}}`)
+		limiter.Acquire("o1", limitedOperation("op1", "r1", "azure", "northeurope", "seed-2"))
+		limiter.Acquire("o1", limitedOperation("op2", "r2", "gcp", "westeurope", "seed-3"))
+		limiter.Acquire("o1", limitedOperation("op3", "r3", "aws", "eu-west-1", "seed-1"))
+
+		// the cases are run in order, the acquired operations count for the following ones
+		for _, tc := range []struct {
+			name     string
+			op       orchestration.RuntimeOperation
+			decision orchestration.LimitDecision
+		}{
+			{
+				name:     "provider limit",
+				op:       limitedOperation("op4", "r4", "azure", "eastus", "seed-4"),
+				decision: orchestration.LimitThrottled,
+			},
+			{
+				name:     "default provider limit not reached",
+				op:       limitedOperation("op5", "r5", "gcp", "us-east1", "seed-5"),
+				decision: orchestration.LimitAcquired,
+			},
+			{
+				name:     "default provider limit",
+				op:       limitedOperation("op6", "r6", "gcp", "us-east1", "seed-5"),
+				decision: orchestration.LimitThrottled,
+			},
+			{
+				name:     "region limit",
+				op:       limitedOperation("op7", "r7", "aws", "westeurope", "seed-6"),
+				decision: orchestration.LimitThrottled,
+			},
+			{
+				name:     "seed limit",
+				op:       limitedOperation("op8", "r8", "openstack", "eu-de-1", "seed-1"),
+				decision: orchestration.LimitThrottled,
+			},
+			{
+				name:     "operation without provider data",
+				op:       limitedOperation("op9", "r9", "", "", ""),
+				decision: orchestration.LimitAcquired,
+			},
+		} {
+			// when
+			decision, _ := limiter.Acquire("o2", tc.op)
+
+			// then
+			assert.Equal(t, tc.decision, decision, tc.name)
+		}
+	})
+
+	t.Run("should queue operation for the runtime processed by another orchestration", func(t *testing.T) {
+		// given
+		limiter := newLimiter(`{"runtimeConflict":"queue"}`)
+		limiter.Acquire("o1", limitedOperation("op1", "r1", "aws", "eu-west-1", "seed-1"))
+
+		// when
+		decision, msg := limiter.Acquire("o2", limitedOperation("op2", "r1", "aws", "eu-west-1", "seed-1"))
+
+		// then
+		assert.Equal(t, orchestration.LimitThrottled, decision)
+		assert.Equal(t, "runtime r1 is processed by operation op1 of orchestration o1", msg)
+	})
+
+	t.Run("should reject operation for the runtime processed by another orchestration", func(t *testing.T) {
+		// given
+		limiter := newLimiter(`{"runtimeConflict":"reject"}`)
+		limiter.Acquire("o1", limitedOperation("op1", "r1", "aws", "eu-west-1", "seed-1"))
+
+		// when
+		decision, _ := limiter.Acquire("o2", limitedOperation("op2", "r1", "aws", "eu-west-1", "seed-1"))
+		sameOrchestration, _ := limiter.Acquire("o1", limitedOperation("op3", "r1", "aws", "eu-west-1", "seed-1"))
+		sameOperation, _ := limiter.Acquire("o1", limitedOperation("op1", "r1", "aws", "eu-west-1", "seed-1"))
+
+		// then
+		assert.Equal(t, orchestration.LimitRejected, decision)
+		assert.Equal(t, orchestration.LimitThrottled, sameOrchestration)
+		assert.Equal(t, orchestration.LimitAcquired, sameOperation)
+	})
+}
+
+func TestGlobalLimiter_Restore(t *testing.T) {
+	// given
+	orchestrations := memory.NewOrchestrations()
+	operations := memory.NewOperation()
+
+	running := fixture.FixOrchestration("o1")
+	running.Type = orchestration.UpgradeKymaOrchestration
+	running.State = orchestration.InProgress
+	require.NoError(t, orchestrations.Insert(running))
+	inProgress := fixUpgradeKymaOperation("op1", "o1", domain.InProgress, limitedOperation("op1", "r1", "aws", "eu-west-1", "seed-1"))
+	require.NoError(t, operations.InsertUpgradeKymaOperation(inProgress))
+	succeeded := fixUpgradeKymaOperation("op2", "o1", domain.Succeeded, limitedOperation("op2", "r2", "aws", "eu-west-1", "seed-1"))
+	require.NoError(t, operations.InsertUpgradeKymaOperation(succeeded))
+
+	finished := fixture.FixOrchestration("o2")
+	finished.Type = orchestration.UpgradeKymaOrchestration
+	require.NoError(t, orchestrations.Insert(finished))
+	stale := fixUpgradeKymaOperation("op3", "o2", domain.InProgress, limitedOperation("op3", "r3", "aws", "eu-west-1", "seed-1"))
+	require.NoError(t, operations.InsertUpgradeKymaOperation(stale))
+
+	limiter := newLimiter(`{"total":2,"runtimeConflict":"reject"}`)
+
+	// when
+	err := limiter.Restore(orchestrations, operations)
+
+	// then
+	require.NoError(t, err)
+	sameRuntime, _ := limiter.Acquire("o3", limitedOperation("op4", "r1", "aws", "eu-west-1", "seed-1"))
+	assert.Equal(t, orchestration.LimitRejected, sameRuntime)
+	first, _ := limiter.Acquire("o3", limitedOperation("op5", "r5", "aws", "eu-west-1", "seed-1"))
+	assert.Equal(t, orchestration.LimitAcquired, first)
+	second, msg := limiter.Acquire("o3", limitedOperation("op6", "r6", "aws", "eu-west-1", "seed-1"))
+	assert.Equal(t, orchestration.LimitThrottled, second)
+	assert.Equal(t, "limit of 2 operations in progress is reached", msg)
+	resumed, _ := limiter.Acquire("o1", limitedOperation("op1", "r1", "aws", "eu-west-1", "seed-1"))
+	assert.Equal(t, orchestration.LimitAcquired, resumed)
+}
+
+func fixUpgradeKymaOperation(id, orchestrationID string, state domain.LastOperationState, runtimeOperation orchestration.RuntimeOperation) internal.UpgradeKymaOperation {
+	op := fixture.FixUpgradeKymaOperation(id, "inst-"+id)
+	op.OrchestrationID = orchestrationID
+	op.State = state
+	op.RuntimeOperation = runtimeOperation
+	return op
+}
+
+func newLimiter(limits string) *manager.GlobalLimiter {
+	cfg := limiterConfig()
+	k8sClient := fake.NewFakeClient(&coreV1.ConfigMap{
+		ObjectMeta: metaV1.ObjectMeta{
+			Namespace: cfg.Namespace,
+			Name:      cfg.Name,
+		},
+		Data: map[string]string{
+			"concurrencyLimits": limits,
+		},
+	})
+
+	return manager.NewGlobalLimiter(k8sClient, cfg, logrus.New())
+}
+
+func limiterConfig() internalOrchestration.Config {
+	return internalOrchestration.Config{
+		Namespace: "kcp-system",
+		Name:      "orchestration-config",
+	}
+}
+
+func limitedOperation(id, runtimeID, provider, region, seed string) orchestration.RuntimeOperation {
+	return orchestration.RuntimeOperation{
+		ID: id,
+		Runtime: orchestration.Runtime{
+			RuntimeID: runtimeID,
+			Provider:  provider,
+			Region:    region,
+			Seed:      seed,
+		},
+	}
+}
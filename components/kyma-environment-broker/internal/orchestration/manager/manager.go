@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	coreV1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -27,6 +29,7 @@ type OperationFactory interface {
 	NewOperation(o internal.Orchestration, r orchestration.Runtime, i internal.Instance) (orchestration.RuntimeOperation, error)
 	ResumeOperations(orchestrationID string) ([]orchestration.RuntimeOperation, error)
 	CancelOperations(orchestrationID string) error
+	CancelOperation(operationID string, description string) error
 	RetryOperations(orchestrationID string, schedule orchestration.ScheduleType, policy orchestration.MaintenancePolicy, updateMWindow bool) ([]orchestration.RuntimeOperation, error)
 }
 
@@ -45,11 +48,24 @@ type orchestrationManager struct {
 	kymaVersion          string
 	kubernetesVersion    string
 	bundleBuilder        notification.BundleBuilder
+	limiter              *GlobalLimiter
 	speedFactor          int
+
+	blackoutMux      sync.Mutex
+	blackoutCalendar orchestration.BlackoutCalendar
+	blackoutReadAt   time.Time
 }
 
 const maintenancePolicyKeyName = "maintenancePolicy"
 const maintenanceWindowFormat = "150405-0700"
+const blackoutCalendarKeyName = "blackoutCalendar"
+
+const (
+	// blackoutCalendarRefreshInterval is the time for which the blackout calendar read from the orchestration config is cached
+	blackoutCalendarRefreshInterval = time.Minute
+	// startAtCheckInterval is the maximal time after which the orchestration waiting for its start time is processed again
+	startAtCheckInterval = time.Minute
+)
 
 func (m *orchestrationManager) SpeedUp(factor int) {
 	m.speedFactor = factor
@@ -67,6 +83,16 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 		return m.failOrchestration(o, errors.Wrap(err, "while getting orchestration"))
 	}
 
+	if o.State == orchestration.Pending && o.Parameters.Strategy.StartAt != nil {
+		if untilStart := time.Until(*o.Parameters.Strategy.StartAt); untilStart > 0 {
+			logger.Infof("Orchestration is scheduled to start at %s", o.Parameters.Strategy.StartAt)
+			if untilStart > startAtCheckInterval {
+				return startAtCheckInterval, nil
+			}
+			return untilStart, nil
+		}
+	}
+
 	maintenancePolicy, err := m.getMaintenancePolicy()
 	if err != nil {
 		m.log.Warnf("while getting maintenance policy: %s", err)
@@ -87,8 +113,13 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 		m.log.Infof("Orchestration was already finished, state: %s", o.State)
 		return 0, nil
 	}
+	// paused orchestration is queued again when it is resumed
+	if o.State == orchestration.Paused {
+		m.log.Infof("Orchestration is paused: %s", o.Description)
+		return 0, nil
+	}
 
-	strategy := m.resolveStrategy(o.Parameters.Strategy.Type, m.executor, logger)
+	strategy := m.resolveStrategy(o.Parameters.Strategy.Type, m.executor, m.concurrencyLimiter(o.OrchestrationID, logger), logger)
 
 	// ctreate notification after orchestration resolved
 	if !m.bundleBuilder.DisabledCheck() {
@@ -104,12 +135,17 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 		return 0, errors.Wrap(err, "while executing upgrade strategy")
 	}
 
+	// the orchestration is read again while waiting, the notification state is kept from the parameters before the wait
+	parameters := o.Parameters
 	o, err = m.waitForCompletion(o, strategy, execID, logger)
 	if err != nil && kebError.IsTemporaryError(err) {
 		return 5 * time.Second, nil
 	} else if err != nil {
 		return 0, errors.Wrap(err, "while waiting for orchestration to finish")
 	}
+	if o.State == orchestration.Paused {
+		return m.releasePausedOrchestration(o.OrchestrationID, parameters, logger), nil
+	}
 
 	o.UpdatedAt = time.Now()
 	err = m.orchestrationStorage.Update(*o)
@@ -142,6 +178,40 @@ func (m *orchestrationManager) getMaintenancePolicy() (orchestration.Maintenance
 	return policy, nil
 }
 
+// GetBlackoutCalendar returns the blackout calendar from the orchestration config, the calendar is empty if it is not configured
+func (m *orchestrationManager) GetBlackoutCalendar() (orchestration.BlackoutCalendar, error) {
+	m.blackoutMux.Lock()
+	defer m.blackoutMux.Unlock()
+
+	if time.Since(m.blackoutReadAt) < blackoutCalendarRefreshInterval {
+		return m.blackoutCalendar, nil
+	}
+	// the previous calendar is used until the config can be read again
+	m.blackoutReadAt = time.Now()
+
+	config := &coreV1.ConfigMap{}
+	key := client.ObjectKey{Namespace: m.configNamespace, Name: m.configName}
+	if err := m.k8sClient.Get(context.Background(), key, config); err != nil {
+		if apiErrors.IsNotFound(err) {
+			m.blackoutCalendar = orchestration.BlackoutCalendar{}
+			return m.blackoutCalendar, nil
+		}
+		m.log.Warnf("while getting orchestration config, using the previous blackout calendar: %s", err)
+		return m.blackoutCalendar, nil
+	}
+
+	calendar := orchestration.BlackoutCalendar{}
+	if config.Data[blackoutCalendarKeyName] != "" {
+		if err := json.Unmarshal([]byte(config.Data[blackoutCalendarKeyName]), &calendar); err != nil {
+			m.log.Warnf("failed to unmarshal the blackout calendar config, using the previous blackout calendar: %s", err)
+			return m.blackoutCalendar, nil
+		}
+	}
+	m.blackoutCalendar = calendar
+
+	return m.blackoutCalendar, nil
+}
+
 func (m *orchestrationManager) resolveOperations(o *internal.Orchestration, policy orchestration.MaintenancePolicy) ([]orchestration.RuntimeOperation, error) {
 	result := []orchestration.RuntimeOperation{}
 	if o.State == orchestration.Pending {
@@ -175,11 +245,14 @@ func (m *orchestrationManager) resolveOperations(o *internal.Orchestration, poli
 			result = append(result, op)
 		}
 
-		if o.Parameters.Kyma == nil || o.Parameters.Kyma.Version == "" {
-			o.Parameters.Kyma = &orchestration.KymaParameters{Version: m.kymaVersion}
-		}
-		if o.Parameters.Kubernetes == nil || o.Parameters.Kubernetes.KubernetesVersion == "" {
-			o.Parameters.Kubernetes = &orchestration.KubernetesParameters{KubernetesVersion: m.kubernetesVersion}
+		// versions of rollback operations are resolved from the runtime states, tasks do not change the versions
+		if o.Type != orchestration.RunTaskOrchestration {
+			if o.Parameters.Rollback == nil && (o.Parameters.Kyma == nil || o.Parameters.Kyma.Version == "") {
+				o.Parameters.Kyma = &orchestration.KymaParameters{Version: m.kymaVersion}
+			}
+			if o.Parameters.Kubernetes == nil || o.Parameters.Kubernetes.KubernetesVersion == "" {
+				o.Parameters.Kubernetes = &orchestration.KubernetesParameters{KubernetesVersion: m.kubernetesVersion}
+			}
 		}
 
 		if len(runtimes) != 0 {
@@ -216,10 +289,16 @@ func (m *orchestrationManager) resolveOperations(o *internal.Orchestration, poli
 	return result, nil
 }
 
-func (m *orchestrationManager) resolveStrategy(sType orchestration.StrategyType, executor orchestration.OperationExecutor, log logrus.FieldLogger) orchestration.Strategy {
+func (m *orchestrationManager) resolveStrategy(sType orchestration.StrategyType, executor orchestration.OperationExecutor, limiter orchestration.ConcurrencyLimiter, log logrus.FieldLogger) orchestration.Strategy {
 	switch sType {
 	case orchestration.ParallelStrategy:
-		s := strategies.NewParallelOrchestrationStrategy(executor, log, 0)
+		s := strategies.NewParallelOrchestrationStrategy(executor, m, limiter, log, 0)
+		if m.speedFactor != 0 {
+			s.SpeedUp(m.speedFactor)
+		}
+		return s
+	case orchestration.WavesStrategy:
+		s := strategies.NewWavesOrchestrationStrategy(executor, &operationStates{operations: m.operationStorage}, m, limiter, log)
 		if m.speedFactor != 0 {
 			s.SpeedUp(m.speedFactor)
 		}
@@ -228,10 +307,54 @@ func (m *orchestrationManager) resolveStrategy(sType orchestration.StrategyType,
 	return nil
 }
 
+// concurrencyLimiter returns the limiter of the operations of the given orchestration, or nil if there are no global limits
+func (m *orchestrationManager) concurrencyLimiter(orchestrationID string, log logrus.FieldLogger) orchestration.ConcurrencyLimiter {
+	if m.limiter == nil {
+		return nil
+	}
+	return &orchestrationLimiter{
+		orchestrationID: orchestrationID,
+		limiter:         m.limiter,
+		factory:         m.factory,
+		log:             log,
+	}
+}
+
+// orchestrationLimiter acquires the slots of the global limiter for the operations of one orchestration
+type orchestrationLimiter struct {
+	orchestrationID string
+	limiter         *GlobalLimiter
+	factory         OperationFactory
+	log             logrus.FieldLogger
+}
+
+// Acquire reserves a slot for the operation, the rejected operation is canceled
+func (l *orchestrationLimiter) Acquire(op orchestration.RuntimeOperation) orchestration.LimitDecision {
+	decision, msg := l.limiter.Acquire(l.orchestrationID, op)
+	log := l.log.WithField("operationID", op.ID)
+	switch decision {
+	case orchestration.LimitThrottled:
+		log.Infof("Operation is throttled: %s", msg)
+	case orchestration.LimitRejected:
+		if err := l.factory.CancelOperation(op.ID, fmt.Sprintf("Operation was canceled: %s", msg)); err != nil {
+			log.Errorf("while canceling operation rejected by the concurrency limiter: %v", err)
+			return orchestration.LimitThrottled
+		}
+		log.Infof("Operation is rejected: %s", msg)
+	}
+	return decision
+}
+
+func (l *orchestrationLimiter) Release(op orchestration.RuntimeOperation) {
+	l.limiter.Release(op)
+}
+
 // waitForCompletion waits until processing of given orchestration ends or if it's canceled
 func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, strategy orchestration.Strategy, execID string, log logrus.FieldLogger) (*internal.Orchestration, error) {
 	orchestrationID := o.OrchestrationID
 	canceled := false
+	paused := false
+	haltReason := ""
 	var err error
 	var stats map[string]int
 	err = wait.PollImmediateInfinite(m.pollingInterval, func() (bool, error) {
@@ -243,6 +366,8 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 				log.Info("Orchestration was canceled")
 				canceled = true
 			}
+			// the orchestration can be resumed while waiting, so the state is checked in every poll
+			paused = o.State == orchestration.Paused
 		case dberr.IsNotFound(err):
 			log.Errorf("while getting orchestration: %v", err)
 			return false, err
@@ -257,6 +382,20 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 		}
 		stats = s
 
+		if o.State == orchestration.InProgress {
+			if reason := failureThresholdExceeded(o.Parameters.Strategy, stats); reason != "" {
+				o.State = orchestration.Paused
+				o.Description = reason
+				o.UpdatedAt = time.Now()
+				if err := m.orchestrationStorage.Update(*o); err != nil {
+					log.Errorf("while pausing orchestration: %v", err)
+					return false, nil
+				}
+				log.Warnf("Orchestration was paused: %s", reason)
+				paused = true
+			}
+		}
+
 		numberOfNotFinished := 0
 		numberOfInProgress, found := stats[orchestration.InProgress]
 		if found {
@@ -275,17 +414,33 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 			if err != nil {
 				// don't block the polling and cancel signal
 				log.Errorf("while handling retrying operations: %v", err)
-			} else {
+			} else if haltReason == "" {
+				// operations of the halted execution stay pending and are canceled when the orchestration is resolved
 				err := strategy.Insert(execID, ops, o.Parameters.Strategy)
-				if err != nil {
+				switch {
+				case errors.Is(err, orchestration.ErrExecutionFinished):
+					log.Infof("Execution %s is finished, starting a new execution of %d retried operations", execID, len(ops))
+					execID, err = strategy.Execute(ops, o.Parameters.Strategy)
+					if err != nil {
+						return false, errors.Wrap(err, "while executing retried operations")
+					}
+				case errors.Is(err, orchestration.ErrExecutionHalted):
+					log.Infof("Execution %s is halted, %d retried operations stay pending", execID, len(ops))
+				case err != nil:
 					return false, errors.Wrap(err, "while inserting operations to queue")
 				}
 			}
 
 		}
 
-		// don't wait for pending operations if orchestration was canceled
-		if canceled {
+		if hs, ok := strategy.(orchestration.HaltingStrategy); ok && haltReason == "" {
+			if haltReason = hs.Halted(execID); haltReason != "" {
+				log.Warnf("Orchestration was halted: %s", haltReason)
+			}
+		}
+
+		// don't wait for pending operations if orchestration was canceled, paused or halted
+		if canceled || paused || haltReason != "" {
 			return numberOfInProgress == 0, nil
 		} else {
 			return numberOfNotFinished == 0, nil
@@ -295,10 +450,10 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 		return nil, errors.Wrap(err, "while waiting for scheduled operations to finish")
 	}
 
-	return m.resolveOrchestration(o, strategy, execID, stats)
+	return m.resolveOrchestration(o, strategy, execID, stats, haltReason)
 }
 
-func (m *orchestrationManager) resolveOrchestration(o *internal.Orchestration, strategy orchestration.Strategy, execID string, stats map[string]int) (*internal.Orchestration, error) {
+func (m *orchestrationManager) resolveOrchestration(o *internal.Orchestration, strategy orchestration.Strategy, execID string, stats map[string]int, haltReason string) (*internal.Orchestration, error) {
 	if o.State == orchestration.Canceling {
 		err := m.factory.CancelOperations(o.OrchestrationID)
 		if err != nil {
@@ -314,6 +469,17 @@ func (m *orchestrationManager) resolveOrchestration(o *internal.Orchestration, s
 			}
 		}
 		o.State = orchestration.Canceled
+	} else if o.State == orchestration.Paused {
+		// pending operations stay pending until the orchestration is resumed
+		strategy.Cancel(execID)
+	} else if haltReason != "" {
+		// pending operations are not processed by the halted strategy
+		err := m.factory.CancelOperations(o.OrchestrationID)
+		if err != nil {
+			return nil, errors.Wrap(err, "while resolving operations of halted orchestration")
+		}
+		o.State = orchestration.Failed
+		o.Description = haltReason
 	} else {
 		state := orchestration.Succeeded
 		if stats[orchestration.Failed] > 0 {
@@ -324,6 +490,31 @@ func (m *orchestrationManager) resolveOrchestration(o *internal.Orchestration, s
 	return o, nil
 }
 
+// releasePausedOrchestration saves the notification state of the paused orchestration and releases the worker, unless
+// the orchestration was resumed in the meantime. The resumed orchestration is processed again after the returned time.
+func (m *orchestrationManager) releasePausedOrchestration(orchestrationID string, parameters orchestration.Parameters, log logrus.FieldLogger) time.Duration {
+	current, err := m.orchestrationStorage.GetByID(orchestrationID)
+	if err != nil {
+		log.Errorf("while getting orchestration: %v", err)
+		return m.pollingInterval
+	}
+	if current.State != orchestration.Paused {
+		log.Infof("Orchestration was resumed, state: %s", current.State)
+		return m.pollingInterval
+	}
+
+	current.UpdatedAt = time.Now()
+	current.Parameters.NotificationState = parameters.NotificationState
+	err = m.orchestrationStorage.Update(*current)
+	if err != nil {
+		log.Errorf("while updating orchestration: %v", err)
+		return m.pollingInterval
+	}
+
+	log.Infof("Orchestration is paused, pending operations are processed when it is resumed")
+	return 0
+}
+
 // resolves the next exact maintenance window time for the runtime
 func resolveMaintenanceWindowTime(r orchestration.Runtime, policy orchestration.MaintenancePolicy) (time.Time, time.Time, []string) {
 	ruleMatched := false
@@ -438,7 +629,7 @@ func (m *orchestrationManager) sendNotificationCreate(o *internal.Orchestration,
 		if o.Parameters.NotificationState == orchestration.NotificationPending {
 			eventType := ""
 			tenants := []notification.NotificationTenant{}
-			if o.Type == orchestration.UpgradeKymaOrchestration {
+			if o.Type == orchestration.UpgradeKymaOrchestration || o.Type == orchestration.RollbackKymaOrchestration {
 				eventType = notification.KymaMaintenanceNumber
 			} else if o.Type == orchestration.UpgradeClusterOrchestration {
 				eventType = notification.KubernetesMaintenanceNumber
@@ -504,6 +695,42 @@ func (m *orchestrationManager) sendNotificationCancel(o *internal.Orchestration)
 	return nil
 }
 
+// failureThresholdExceeded returns the reason of pausing the orchestration if failed operations exceed limits of the strategy
+func failureThresholdExceeded(spec orchestration.StrategySpec, stats map[string]int) string {
+	failed := stats[orchestration.Failed]
+	if failed == 0 {
+		return ""
+	}
+	if spec.MaxFailures > 0 && failed > spec.MaxFailures {
+		return fmt.Sprintf("Paused: %d operations failed, which exceeds the maximum of %d failures", failed, spec.MaxFailures)
+	}
+	total := 0
+	for _, count := range stats {
+		total += count
+	}
+	if spec.MaxFailureRatio > 0 && float64(failed)/float64(total) > spec.MaxFailureRatio {
+		return fmt.Sprintf("Paused: %d of %d operations failed, which exceeds the maximum failure ratio of %g", failed, total, spec.MaxFailureRatio)
+	}
+	return ""
+}
+
+// operationStates provides states of operations to strategies
+type operationStates struct {
+	operations storage.Operations
+}
+
+func (s *operationStates) GetOperationStates(operationIDs []string) (map[string]string, error) {
+	ops, err := s.operations.GetOperationsForIDs(operationIDs)
+	if err != nil && !dberr.IsNotFound(errors.Cause(err)) {
+		return nil, errors.Wrap(err, "while getting operations")
+	}
+	states := make(map[string]string, len(ops))
+	for _, op := range ops {
+		states[op.ID] = string(op.State)
+	}
+	return states, nil
+}
+
 func updateRetryingDescription(desc string, newDesc string) string {
 	if strings.Contains(desc, "retrying") {
 		return strings.Replace(desc, "retrying", newDesc, -1)
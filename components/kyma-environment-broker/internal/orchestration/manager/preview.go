@@ -0,0 +1,206 @@
+package manager
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration/strategies"
+	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// defaultPreviewOperationDuration is the estimated duration of a runtime operation used if the preview request does not specify it
+const defaultPreviewOperationDuration = time.Hour
+
+// maxBlackoutPostpones limits the number of times the estimated start of the operation is moved by the blackout periods and the maintenance windows
+const maxBlackoutPostpones = 100
+
+type Previewer struct {
+	manager *orchestrationManager
+}
+
+// NewPreviewer creates the previewer which uses the same targets resolver, maintenance policy and blackout calendar as the orchestration managers
+func NewPreviewer(resolver orchestration.RuntimeResolver, cli client.Client, cfg *internalOrchestration.Config, log logrus.FieldLogger) *Previewer {
+	return &Previewer{
+		manager: &orchestrationManager{
+			resolver:        resolver,
+			log:             log,
+			k8sClient:       cli,
+			configNamespace: cfg.Namespace,
+			configName:      cfg.Name,
+		},
+	}
+}
+
+// Preview resolves the runtimes targeted by the orchestration and estimates the schedule of their operations processed by the strategy workers.
+// Neither the orchestration nor its operations are stored.
+func (p *Previewer) Preview(request orchestration.PreviewRequest) (orchestration.PreviewResponse, error) {
+	response := orchestration.PreviewResponse{
+		Runtimes:  []orchestration.PreviewRuntime{},
+		Breakdown: []orchestration.PreviewBreakdown{},
+	}
+	duration := defaultPreviewOperationDuration
+	if request.OperationDuration != "" {
+		d, err := time.ParseDuration(request.OperationDuration)
+		if err != nil || d <= 0 {
+			return response, apiErrors.NewBadRequest(fmt.Sprintf("invalid operationDuration %s", request.OperationDuration))
+		}
+		duration = d
+	}
+	response.OperationDuration = duration.String()
+
+	strategy := request.Parameters.Strategy
+	policy := orchestration.MaintenancePolicy{}
+	if strategy.Schedule == orchestration.MaintenanceWindow {
+		var err error
+		policy, err = p.manager.getMaintenancePolicy()
+		if err != nil {
+			p.manager.log.Warnf("while getting maintenance policy: %s", err)
+		}
+	}
+	calendar, err := p.manager.GetBlackoutCalendar()
+	if err != nil {
+		p.manager.log.Warnf("while getting blackout calendar: %s", err)
+	}
+
+	runtimes, err := p.manager.resolver.Resolve(request.Parameters.Targets)
+	if err != nil {
+		return response, errors.Wrap(err, "while resolving targets")
+	}
+
+	breakdown := map[orchestration.PreviewBreakdown]int{}
+	for _, r := range runtimes {
+		windowBegin := time.Time{}
+		windowEnd := time.Time{}
+		days := []string{}
+
+		if strategy.Schedule == orchestration.MaintenanceWindow {
+			windowBegin, windowEnd, days = resolveMaintenanceWindowTime(r, policy)
+		}
+		r.MaintenanceWindowBegin = windowBegin
+		r.MaintenanceWindowEnd = windowEnd
+		r.MaintenanceDays = days
+
+		response.Runtimes = append(response.Runtimes, orchestration.PreviewRuntime{Runtime: r})
+		breakdown[orchestration.PreviewBreakdown{Plan: r.Plan, Region: r.Region}]++
+	}
+	response.Count = len(response.Runtimes)
+
+	for group, count := range breakdown {
+		group.Count = count
+		response.Breakdown = append(response.Breakdown, group)
+	}
+	sort.Slice(response.Breakdown, func(i, j int) bool {
+		if response.Breakdown[i].Plan != response.Breakdown[j].Plan {
+			return response.Breakdown[i].Plan < response.Breakdown[j].Plan
+		}
+		return response.Breakdown[i].Region < response.Breakdown[j].Region
+	})
+
+	start := time.Now()
+	if strategy.StartAt != nil && strategy.StartAt.After(start) {
+		start = *strategy.StartAt
+	}
+	response.EstimatedStart = start
+	response.EstimatedEnd = start
+	if response.Count == 0 {
+		return response, nil
+	}
+
+	sizes := []int{response.Count}
+	var soakTime time.Duration
+	if strategy.Type == orchestration.WavesStrategy {
+		sizes, err = strategies.WaveSizes(response.Count, strategy.Waves)
+		if err != nil {
+			return response, apiErrors.NewBadRequest(errors.Wrap(err, "while calculating sizes of waves").Error())
+		}
+		if strategy.Waves.SoakTime != "" {
+			soakTime, _ = time.ParseDuration(strategy.Waves.SoakTime)
+		}
+	}
+
+	workers := strategy.Parallel.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	waveStart, first := start, 0
+	for i, size := range sizes {
+		if i > 0 {
+			waveStart = waveStart.Add(soakTime)
+		}
+		waveStart = estimateWave(response.Runtimes[first:first+size], workers, waveStart, duration, calendar)
+		first += size
+	}
+	response.EstimatedStart = response.Runtimes[0].EstimatedStart
+	response.EstimatedEnd = waveStart
+	for _, r := range response.Runtimes {
+		if r.EstimatedStart.Before(response.EstimatedStart) {
+			response.EstimatedStart = r.EstimatedStart
+		}
+	}
+
+	return response, nil
+}
+
+// estimateWave assigns the operations to the first free worker in the order of their earliest possible start and returns the time all of them are finished
+func estimateWave(wave []orchestration.PreviewRuntime, workers int, start time.Time, duration time.Duration, calendar orchestration.BlackoutCalendar) time.Time {
+	for i := range wave {
+		wave[i].EstimatedStart = earliestStart(wave[i].Runtime, start, calendar)
+	}
+	sort.SliceStable(wave, func(i, j int) bool {
+		return wave[i].EstimatedStart.Before(wave[j].EstimatedStart)
+	})
+
+	free := make([]time.Time, workers)
+	for w := range free {
+		free[w] = start
+	}
+	end := start
+	for i := range wave {
+		w := 0
+		for j := range free {
+			if free[j].Before(free[w]) {
+				w = j
+			}
+		}
+		opStart := wave[i].EstimatedStart
+		if free[w].After(opStart) {
+			opStart = earliestStart(wave[i].Runtime, free[w], calendar)
+		}
+		wave[i].EstimatedStart = opStart
+		wave[i].EstimatedEnd = opStart.Add(duration)
+		free[w] = wave[i].EstimatedEnd
+		if wave[i].EstimatedEnd.After(end) {
+			end = wave[i].EstimatedEnd
+		}
+	}
+	return end
+}
+
+// earliestStart returns the first time not before the given one, which is in the maintenance window of the runtime (if it is set) and out of the blackout periods
+func earliestStart(r orchestration.Runtime, t time.Time, calendar orchestration.BlackoutCalendar) time.Time {
+	begin, end := r.MaintenanceWindowBegin, r.MaintenanceWindowEnd
+	for i := 0; i < maxBlackoutPostpones; i++ {
+		if !begin.IsZero() {
+			for end.Before(t) {
+				diff := orchestration.NextAvailableDayDiff(begin.Weekday(), orchestration.ConvertSliceOfDaysToMap(r.MaintenanceDays))
+				begin = begin.AddDate(0, 0, diff)
+				end = end.AddDate(0, 0, diff)
+			}
+			if t.Before(begin) {
+				t = begin
+			}
+		}
+		blackoutEnd, inBlackout := calendar.BlackoutEnd(t)
+		if !inBlackout {
+			return t
+		}
+		t = blackoutEnd
+	}
+	return t
+}
@@ -0,0 +1,135 @@
+package manager_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration/automock"
+	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration/manager"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPreviewer_Preview(t *testing.T) {
+	orchestrationConfig := internalOrchestration.Config{
+		Namespace: "default",
+		Name:      "policyConfig",
+	}
+	targets := orchestration.TargetSpec{
+		Include: []orchestration.RuntimeTarget{{Target: orchestration.TargetAll}},
+	}
+	runtimes := []orchestration.Runtime{
+		{RuntimeID: "runtime-1", Plan: "azure", Region: "westeurope", KymaVersion: "2.1.0"},
+		{RuntimeID: "runtime-2", Plan: "azure", Region: "westeurope", KymaVersion: "2.1.0"},
+		{RuntimeID: "runtime-3", Plan: "gcp", Region: "europe-west3", KymaVersion: "2.0.0"},
+	}
+
+	t.Run("should estimate schedule of parallel workers", func(t *testing.T) {
+		// given
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+		resolver.On("Resolve", targets).Return(runtimes, nil).Once()
+
+		start := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+		previewer := manager.NewPreviewer(resolver, fake.NewFakeClient(), &orchestrationConfig, logrus.New())
+
+		// when
+		preview, err := previewer.Preview(orchestration.PreviewRequest{
+			Type: orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Targets: targets,
+				Strategy: orchestration.StrategySpec{
+					Type:     orchestration.ParallelStrategy,
+					Schedule: orchestration.Immediate,
+					Parallel: orchestration.ParallelStrategySpec{Workers: 2},
+					StartAt:  &start,
+				},
+			},
+			OperationDuration: "30m",
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 3, preview.Count)
+		assert.Equal(t, "30m0s", preview.OperationDuration)
+		assert.Equal(t, []orchestration.PreviewBreakdown{
+			{Plan: "azure", Region: "westeurope", Count: 2},
+			{Plan: "gcp", Region: "europe-west3", Count: 1},
+		}, preview.Breakdown)
+		assert.Equal(t, start, preview.EstimatedStart)
+		assert.Equal(t, start.Add(time.Hour), preview.EstimatedEnd)
+		require.Len(t, preview.Runtimes, 3)
+		assert.Equal(t, "2.1.0", preview.Runtimes[0].KymaVersion)
+		assert.Equal(t, start, preview.Runtimes[1].EstimatedStart)
+		assert.Equal(t, start.Add(30*time.Minute), preview.Runtimes[2].EstimatedStart)
+		assert.Equal(t, start.Add(time.Hour), preview.Runtimes[2].EstimatedEnd)
+	})
+
+	t.Run("should estimate schedule of waves out of blackout periods", func(t *testing.T) {
+		// given
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+		resolver.On("Resolve", targets).Return(runtimes, nil).Once()
+
+		start := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+		calendar, err := json.Marshal(orchestration.BlackoutCalendar{
+			Periods: []orchestration.BlackoutPeriod{
+				{Name: "freeze", Start: start.Add(time.Hour), End: start.Add(3 * time.Hour)},
+			},
+		})
+		require.NoError(t, err)
+		k8sClient := fake.NewFakeClient(&coreV1.ConfigMap{
+			ObjectMeta: metaV1.ObjectMeta{Namespace: orchestrationConfig.Namespace, Name: orchestrationConfig.Name},
+			Data:       map[string]string{"blackoutCalendar": string(calendar)},
+		})
+		previewer := manager.NewPreviewer(resolver, k8sClient, &orchestrationConfig, logrus.New())
+
+		// when
+		preview, err := previewer.Preview(orchestration.PreviewRequest{
+			Type: orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Targets: targets,
+				Strategy: orchestration.StrategySpec{
+					Type:     orchestration.WavesStrategy,
+					Schedule: orchestration.Immediate,
+					Parallel: orchestration.ParallelStrategySpec{Workers: 2},
+					Waves:    orchestration.WavesStrategySpec{Canary: "1", Factor: 2, SoakTime: "30m"},
+					StartAt:  &start,
+				},
+			},
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1h0m0s", preview.OperationDuration)
+		require.Len(t, preview.Runtimes, 3)
+		// canary wave
+		assert.Equal(t, start, preview.Runtimes[0].EstimatedStart)
+		// the second wave starts after the soak time, which ends in the blackout period
+		assert.Equal(t, start.Add(3*time.Hour), preview.Runtimes[1].EstimatedStart)
+		assert.Equal(t, start.Add(3*time.Hour), preview.Runtimes[2].EstimatedStart)
+		assert.Equal(t, start.Add(4*time.Hour), preview.EstimatedEnd)
+	})
+
+	t.Run("should reject invalid operation duration", func(t *testing.T) {
+		// given
+		previewer := manager.NewPreviewer(&automock.RuntimeResolver{}, fake.NewFakeClient(), &orchestrationConfig, logrus.New())
+
+		// when
+		_, err := previewer.Preview(orchestration.PreviewRequest{
+			Parameters:        orchestration.Parameters{Targets: targets},
+			OperationDuration: "forever",
+		})
+
+		// then
+		assert.True(t, apiErrors.IsBadRequest(err))
+	})
+}
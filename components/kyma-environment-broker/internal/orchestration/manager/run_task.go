@@ -0,0 +1,195 @@
+package manager
+
+import (
+	"time"
+
+	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/notification"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type runTaskFactory struct {
+	operationStorage storage.Operations
+}
+
+func NewRunTaskManager(orchestrationStorage storage.Orchestrations, operationStorage storage.Operations, instanceStorage storage.Instances,
+	runTaskExecutor orchestration.OperationExecutor, resolver orchestration.RuntimeResolver, pollingInterval time.Duration,
+	log logrus.FieldLogger, cli client.Client, cfg internalOrchestration.Config, limiter *GlobalLimiter, speedFactor int) process.Executor {
+	return &orchestrationManager{
+		orchestrationStorage: orchestrationStorage,
+		operationStorage:     operationStorage,
+		instanceStorage:      instanceStorage,
+		resolver:             resolver,
+		factory: &runTaskFactory{
+			operationStorage: operationStorage,
+		},
+		executor:        runTaskExecutor,
+		pollingInterval: pollingInterval,
+		log:             log,
+		k8sClient:       cli,
+		configNamespace: cfg.Namespace,
+		configName:      cfg.Name,
+		// tasks are not customer maintenance, so the customers are not notified
+		bundleBuilder: notification.NewBundleBuilder(nil, notification.Config{Disabled: true}),
+		limiter:       limiter,
+		speedFactor:   speedFactor,
+	}
+}
+
+func (u *runTaskFactory) NewOperation(o internal.Orchestration, r orchestration.Runtime, i internal.Instance) (orchestration.RuntimeOperation, error) {
+	id := uuid.New().String()
+	op := internal.RunTaskOperation{
+		Operation: internal.Operation{
+			ID:                     id,
+			Version:                0,
+			CreatedAt:              time.Now(),
+			UpdatedAt:              time.Now(),
+			Type:                   internal.OperationTypeRunTask,
+			InstanceID:             r.InstanceID,
+			State:                  orchestration.Pending,
+			Description:            "Operation created",
+			OrchestrationID:        o.OrchestrationID,
+			ProvisioningParameters: i.Parameters,
+			InstanceDetails:        i.InstanceDetails,
+		},
+		RuntimeOperation: orchestration.RuntimeOperation{
+			ID:      id,
+			Runtime: r,
+			DryRun:  o.Parameters.DryRun,
+		},
+	}
+	if o.Parameters.Task != nil {
+		op.Task = *o.Parameters.Task
+	}
+
+	err := u.operationStorage.InsertRunTaskOperation(op)
+	return op.RuntimeOperation, err
+}
+
+func (u *runTaskFactory) ResumeOperations(orchestrationID string) ([]orchestration.RuntimeOperation, error) {
+	ops, _, _, err := u.operationStorage.ListRunTaskOperationsByOrchestrationID(orchestrationID, dbmodel.OperationFilter{States: []string{orchestration.InProgress, orchestration.Retrying, orchestration.Pending}})
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]orchestration.RuntimeOperation, 0)
+	retrying := make([]orchestration.RuntimeOperation, 0)
+	inProgress := make([]orchestration.RuntimeOperation, 0)
+	for _, op := range ops {
+		if op.State == orchestration.Pending {
+			pending = append(pending, op.RuntimeOperation)
+		}
+		if op.State == orchestration.Retrying {
+			runtimeop, err := u.updateRetryingOperation(op)
+			if err != nil {
+				return nil, err
+			}
+			retrying = append(retrying, runtimeop)
+		}
+		if op.State == orchestration.InProgress {
+			inProgress = append(inProgress, op.RuntimeOperation)
+		}
+	}
+
+	return append(inProgress, append(retrying, pending...)...), nil
+}
+
+func (u *runTaskFactory) CancelOperations(orchestrationID string) error {
+	ops, _, _, err := u.operationStorage.ListRunTaskOperationsByOrchestrationID(orchestrationID, dbmodel.OperationFilter{States: []string{orchestration.Pending}})
+	if err != nil {
+		return errors.Wrap(err, "while listing run task operations")
+	}
+	for _, op := range ops {
+		op.State = orchestration.Canceled
+		op.Description = "Operation was canceled"
+		_, err := u.operationStorage.UpdateRunTaskOperation(op)
+		if err != nil {
+			return errors.Wrap(err, "while updating run task operation")
+		}
+	}
+
+	return nil
+}
+
+// CancelOperation cancels the pending operation which must not be processed
+func (u *runTaskFactory) CancelOperation(operationID string, description string) error {
+	op, err := u.operationStorage.GetRunTaskOperationByID(operationID)
+	if err != nil {
+		return errors.Wrapf(err, "while getting run task operation %s", operationID)
+	}
+	if op.State != orchestration.Pending {
+		return errors.Errorf("run task operation %s is %s, only pending operations can be canceled", operationID, op.State)
+	}
+	op.State = orchestration.Canceled
+	op.Description = description
+	op.UpdatedAt = time.Now()
+	_, err = u.operationStorage.UpdateRunTaskOperation(*op)
+	if err != nil {
+		return errors.Wrap(err, "while updating run task operation")
+	}
+
+	return nil
+}
+
+// get current retrying operations, update state to pending and update other required params to storage
+func (u *runTaskFactory) RetryOperations(orchestrationID string, schedule orchestration.ScheduleType, policy orchestration.MaintenancePolicy, updateMWindow bool) ([]orchestration.RuntimeOperation, error) {
+	result := []orchestration.RuntimeOperation{}
+	ops, _, _, err := u.operationStorage.ListRunTaskOperationsByOrchestrationID(orchestrationID, dbmodel.OperationFilter{States: []string{orchestration.Retrying}})
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing retrying operations")
+	}
+
+	for _, op := range ops {
+		if updateMWindow {
+			windowBegin := time.Time{}
+			windowEnd := time.Time{}
+			days := []string{}
+
+			// use the latest policy
+			if schedule == orchestration.MaintenanceWindow {
+				windowBegin, windowEnd, days = resolveMaintenanceWindowTime(op.RuntimeOperation.Runtime, policy)
+			}
+			op.MaintenanceWindowBegin = windowBegin
+			op.MaintenanceWindowEnd = windowEnd
+			op.MaintenanceDays = days
+		}
+
+		runtimeop, err := u.updateRetryingOperation(op)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, runtimeop)
+	}
+
+	return result, nil
+}
+
+// update storage in corresponding upgrade factory to avoid too many storage read and write
+func (u *runTaskFactory) updateRetryingOperation(op internal.RunTaskOperation) (orchestration.RuntimeOperation, error) {
+	op.UpdatedAt = time.Now()
+	op.State = orchestration.Pending
+	op.Description = "Operation retry triggered"
+	// the task is run again from the beginning, with a new Job
+	op.ManifestsApplied = false
+	op.JobName = ""
+	op.JobNamespace = ""
+
+	opUpdated, err := u.operationStorage.UpdateRunTaskOperation(op)
+	if err != nil {
+		return orchestration.RuntimeOperation{}, errors.Wrapf(err, "while updating (retrying) run task operation %s in storage", op.Operation.ID)
+	}
+
+	return opUpdated.RuntimeOperation, nil
+}
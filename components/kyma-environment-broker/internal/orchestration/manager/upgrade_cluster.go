@@ -25,7 +25,7 @@ type upgradeClusterFactory struct {
 
 func NewUpgradeClusterManager(orchestrationStorage storage.Orchestrations, operationStorage storage.Operations, instanceStorage storage.Instances,
 	kymaClusterExecutor orchestration.OperationExecutor, resolver orchestration.RuntimeResolver, pollingInterval time.Duration,
-	log logrus.FieldLogger, cli client.Client, cfg internalOrchestration.Config, bundleBuilder notification.BundleBuilder, speedFactor int) process.Executor {
+	log logrus.FieldLogger, cli client.Client, cfg internalOrchestration.Config, limiter *GlobalLimiter, bundleBuilder notification.BundleBuilder, speedFactor int) process.Executor {
 	return &orchestrationManager{
 		orchestrationStorage: orchestrationStorage,
 		operationStorage:     operationStorage,
@@ -43,6 +43,7 @@ func NewUpgradeClusterManager(orchestrationStorage storage.Orchestrations, opera
 		kymaVersion:       cfg.KymaVersion,
 		kubernetesVersion: cfg.KubernetesVersion,
 		bundleBuilder:     bundleBuilder,
+		limiter:           limiter,
 		speedFactor:       speedFactor,
 	}
 }
@@ -119,6 +120,26 @@ func (u *upgradeClusterFactory) CancelOperations(orchestrationID string) error {
 	return nil
 }
 
+// CancelOperation cancels the pending operation which must not be processed
+func (u *upgradeClusterFactory) CancelOperation(operationID string, description string) error {
+	op, err := u.operationStorage.GetUpgradeClusterOperationByID(operationID)
+	if err != nil {
+		return errors.Wrapf(err, "while getting upgrade cluster operation %s", operationID)
+	}
+	if op.State != orchestration.Pending {
+		return errors.Errorf("upgrade cluster operation %s is %s, only pending operations can be canceled", operationID, op.State)
+	}
+	op.State = orchestration.Canceled
+	op.Description = description
+	op.UpdatedAt = time.Now()
+	_, err = u.operationStorage.UpdateUpgradeClusterOperation(*op)
+	if err != nil {
+		return errors.Wrap(err, "while updating upgrade cluster operation")
+	}
+
+	return nil
+}
+
 // get current retrying operations, update state to pending and update other required params to storage
 func (u *upgradeClusterFactory) RetryOperations(orchestrationID string, schedule orchestration.ScheduleType, policy orchestration.MaintenancePolicy, updateMWindow bool) ([]orchestration.RuntimeOperation, error) {
 	result := []orchestration.RuntimeOperation{}
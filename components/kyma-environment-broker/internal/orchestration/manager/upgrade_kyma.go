@@ -14,27 +14,30 @@ import (
 	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 type upgradeKymaFactory struct {
-	operationStorage   storage.Operations
-	defaultKymaVersion string
+	operationStorage    storage.Operations
+	runtimeStateStorage storage.RuntimeStates
+	defaultKymaVersion  string
 }
 
-func NewUpgradeKymaManager(orchestrationStorage storage.Orchestrations, operationStorage storage.Operations, instanceStorage storage.Instances,
+func NewUpgradeKymaManager(orchestrationStorage storage.Orchestrations, operationStorage storage.Operations, instanceStorage storage.Instances, runtimeStateStorage storage.RuntimeStates,
 	kymaUpgradeExecutor orchestration.OperationExecutor, resolver orchestration.RuntimeResolver, pollingInterval time.Duration,
-	log logrus.FieldLogger, cli client.Client, cfg *internalOrchestration.Config, bundleBuilder notification.BundleBuilder, speedFactor int) process.Executor {
+	log logrus.FieldLogger, cli client.Client, cfg *internalOrchestration.Config, limiter *GlobalLimiter, bundleBuilder notification.BundleBuilder, speedFactor int) process.Executor {
 	return &orchestrationManager{
 		orchestrationStorage: orchestrationStorage,
 		operationStorage:     operationStorage,
 		instanceStorage:      instanceStorage,
 		resolver:             resolver,
 		factory: &upgradeKymaFactory{
-			operationStorage:   operationStorage,
-			defaultKymaVersion: cfg.KymaVersion,
+			operationStorage:    operationStorage,
+			runtimeStateStorage: runtimeStateStorage,
+			defaultKymaVersion:  cfg.KymaVersion,
 		},
 		executor:          kymaUpgradeExecutor,
 		pollingInterval:   pollingInterval,
@@ -45,6 +48,7 @@ func NewUpgradeKymaManager(orchestrationStorage storage.Orchestrations, operatio
 		kymaVersion:       cfg.KymaVersion,
 		kubernetesVersion: cfg.KubernetesVersion,
 		bundleBuilder:     bundleBuilder,
+		limiter:           limiter,
 		speedFactor:       speedFactor,
 	}
 }
@@ -75,7 +79,12 @@ func (u *upgradeKymaFactory) NewOperation(o internal.Orchestration, r orchestrat
 			DryRun:  o.Parameters.DryRun,
 		},
 	}
-	if o.Parameters.Kyma.Version != "" {
+	if o.Type == orchestration.RollbackKymaOrchestration {
+		err := u.configureRollback(&op, o)
+		if err != nil {
+			return orchestration.RuntimeOperation{}, errors.Wrapf(err, "while resolving the state to roll back runtime %s", r.RuntimeID)
+		}
+	} else if o.Parameters.Kyma.Version != "" {
 		var majorVer int
 		var err error
 
@@ -91,6 +100,45 @@ func (u *upgradeKymaFactory) NewOperation(o internal.Orchestration, r orchestrat
 	return op.RuntimeOperation, err
 }
 
+// configureRollback sets the kyma version of the latest runtime state from before the upgrade done by the rolled back orchestration,
+// the state is restored by the upgrade kyma process
+func (u *upgradeKymaFactory) configureRollback(op *internal.UpgradeKymaOperation, o internal.Orchestration) error {
+	if o.Parameters.Rollback == nil || o.Parameters.Rollback.OrchestrationID == "" {
+		return errors.New("rollback parameters are missing")
+	}
+	rolledBackID := o.Parameters.Rollback.OrchestrationID
+
+	upgrades, err := u.operationStorage.ListUpgradeKymaOperationsByInstanceID(op.InstanceID)
+	if err != nil {
+		return errors.Wrap(err, "while listing upgrade kyma operations")
+	}
+	var upgrade *internal.UpgradeKymaOperation
+	for i := range upgrades {
+		if upgrades[i].OrchestrationID == rolledBackID && (upgrade == nil || upgrades[i].CreatedAt.Before(upgrade.CreatedAt)) {
+			upgrade = &upgrades[i]
+		}
+	}
+	if upgrade == nil {
+		return errors.Errorf("runtime was not upgraded by orchestration %s", rolledBackID)
+	}
+
+	// the previous state is the latest one created before the upgrade
+	state, err := u.runtimeStateStorage.GetLatestWithKymaVersionByRuntimeIDBefore(op.RuntimeOperation.RuntimeID, upgrade.CreatedAt)
+	switch {
+	case dberr.IsNotFound(errors.Cause(err)):
+		return errors.Errorf("runtime state with kyma version from before orchestration %s not found", rolledBackID)
+	case err != nil:
+		return errors.Wrap(err, "while getting runtime state from before the upgrade")
+	}
+	majorVer, err := determineMajorVersion(state.GetKymaVersion(), u.defaultKymaVersion)
+	if err != nil {
+		return errors.Wrap(err, "while determining Kyma's major version")
+	}
+	op.RuntimeVersion = internal.RuntimeVersionData{Version: state.GetKymaVersion(), Origin: internal.Rollback, MajorVersion: majorVer}
+	op.Rollback = &internal.KymaRollback{OrchestrationID: rolledBackID, RuntimeStateID: state.ID}
+	return nil
+}
+
 func determineMajorVersion(version string, defaultVersion string) (int, error) {
 	if isCustomVersion(version) {
 		return extractMajorVersionNumberFromVersionString(defaultVersion)
@@ -156,6 +204,26 @@ func (u *upgradeKymaFactory) CancelOperations(orchestrationID string) error {
 	return nil
 }
 
+// CancelOperation cancels the pending operation which must not be processed
+func (u *upgradeKymaFactory) CancelOperation(operationID string, description string) error {
+	op, err := u.operationStorage.GetUpgradeKymaOperationByID(operationID)
+	if err != nil {
+		return errors.Wrapf(err, "while getting upgrade kyma operation %s", operationID)
+	}
+	if op.State != orchestration.Pending {
+		return errors.Errorf("upgrade kyma operation %s is %s, only pending operations can be canceled", operationID, op.State)
+	}
+	op.State = orchestration.Canceled
+	op.Description = description
+	op.UpdatedAt = time.Now()
+	_, err = u.operationStorage.UpdateUpgradeKymaOperation(*op)
+	if err != nil {
+		return errors.Wrap(err, "while updating upgrade kyma operation")
+	}
+
+	return nil
+}
+
 // get current retrying operations, update state to pending and update other required params to storage
 func (u *upgradeKymaFactory) RetryOperations(orchestrationID string, schedule orchestration.ScheduleType, policy orchestration.MaintenancePolicy, updateMWindow bool) ([]orchestration.RuntimeOperation, error) {
 	result := []orchestration.RuntimeOperation{}
@@ -17,7 +17,10 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/notification"
 	notificationAutomock "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/notification/mocks"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration/manager"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -78,8 +81,8 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		notificationBuilder.On("NewBundle", mock.Anything, notificationParas).Return(bundle, nil).Once()
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
-		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), nil,
-			resolver, 20*time.Millisecond, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), store.RuntimeStates(), nil,
+			resolver, 20*time.Millisecond, logrus.New(), k8sClient, &orchestrationConfig, nil, notificationBuilder, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -123,8 +126,8 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		notificationBuilder.On("NewBundle", id, notificationParas).Return(bundle, nil).Once()
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
-		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &testExecutor{},
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), store.RuntimeStates(), &testExecutor{},
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, nil, notificationBuilder, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -159,8 +162,8 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		notificationBuilder := &notificationAutomock.BundleBuilder{}
 		notificationBuilder.On("DisabledCheck").Return(false).Once()
 
-		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), nil,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), store.RuntimeStates(), nil,
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, nil, notificationBuilder, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -232,8 +235,8 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		notificationBuilder.On("NewBundle", id, notificationParas).Return(bundle, nil).Once()
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
-		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &testExecutor{},
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), store.RuntimeStates(), &testExecutor{},
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, nil, notificationBuilder, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -280,8 +283,8 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		notificationBuilder.On("NewBundle", id, notificationParas).Return(bundle, nil).Once()
 		bundle.On("CancelNotificationEvent").Return(nil).Once()
 
-		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &testExecutor{},
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), store.RuntimeStates(), &testExecutor{},
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, nil, notificationBuilder, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -354,8 +357,8 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 			store:       store,
 			upgradeType: orchestration.UpgradeKymaOrchestration,
 		}
-		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), store.RuntimeStates(), &executor,
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, nil, notificationBuilder, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -428,8 +431,8 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 			store:       store,
 			upgradeType: orchestration.UpgradeKymaOrchestration,
 		}
-		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), store.RuntimeStates(), &executor,
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, nil, notificationBuilder, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -445,6 +448,261 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 
 		assert.Equal(t, orchestration.Succeeded, string(op.State))
 	})
+
+	t.Run("Waves halted", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.InProgress,
+			Type:            orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Strategy: orchestration.StrategySpec{
+					Type:     orchestration.WavesStrategy,
+					Schedule: orchestration.Immediate,
+					Parallel: orchestration.ParallelStrategySpec{Workers: 1},
+					Waves:    orchestration.WavesStrategySpec{Canary: "1", Factor: 2, SuccessThreshold: ptr.Integer(100)},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		for _, opID := range []string{"op-1", "op-2", "op-3"} {
+			err = store.Operations().InsertUpgradeKymaOperation(internal.UpgradeKymaOperation{
+				Operation: internal.Operation{
+					ID:              opID,
+					OrchestrationID: id,
+					State:           orchestration.Pending,
+				},
+				RuntimeOperation: orchestration.RuntimeOperation{ID: opID},
+			})
+			require.NoError(t, err)
+		}
+
+		notificationBuilder := &notificationAutomock.BundleBuilder{}
+		bundle := &notificationAutomock.Bundle{}
+		notificationBuilder.On("DisabledCheck").Return(false)
+		notificationBuilder.On("NewBundle", id, mock.Anything).Return(bundle, nil).Once()
+		bundle.On("CreateNotificationEvent").Return(nil).Once()
+
+		executor := &failingTestExecutor{store: store}
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), store.RuntimeStates(), executor,
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, nil, notificationBuilder, 1000)
+
+		// when
+		_, err = svc.Execute(id)
+		require.NoError(t, err)
+
+		// then
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+
+		assert.Equal(t, orchestration.Failed, o.State)
+		assert.Contains(t, o.Description, "wave 1 of 2: 0 of 1 operations succeeded")
+
+		stats, err := store.Operations().GetOperationStatsForOrchestration(id)
+		require.NoError(t, err)
+		assert.Equal(t, 1, stats[orchestration.Failed])
+		assert.Equal(t, 2, stats[orchestration.Canceled])
+	})
+
+	t.Run("Paused after exceeding max failures", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.InProgress,
+			Type:            orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Strategy: orchestration.StrategySpec{
+					Type:        orchestration.ParallelStrategy,
+					Schedule:    orchestration.Immediate,
+					Parallel:    orchestration.ParallelStrategySpec{Workers: 1},
+					MaxFailures: 1,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		for _, opID := range []string{"op-1", "op-2", "op-3"} {
+			err = store.Operations().InsertUpgradeKymaOperation(internal.UpgradeKymaOperation{
+				Operation: internal.Operation{
+					ID:              opID,
+					OrchestrationID: id,
+					State:           orchestration.Pending,
+				},
+				RuntimeOperation: orchestration.RuntimeOperation{ID: opID},
+			})
+			require.NoError(t, err)
+		}
+
+		notificationBuilder := &notificationAutomock.BundleBuilder{}
+		bundle := &notificationAutomock.Bundle{}
+		notificationBuilder.On("DisabledCheck").Return(false)
+		notificationBuilder.On("NewBundle", id, mock.Anything).Return(bundle, nil).Once()
+		bundle.On("CreateNotificationEvent").Return(nil).Once()
+
+		executor := &failingTestExecutor{store: store, delay: 100 * time.Millisecond}
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), store.RuntimeStates(), executor,
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, nil, notificationBuilder, 1000)
+
+		// when
+		_, err = svc.Execute(id)
+		require.NoError(t, err)
+
+		// then
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Paused, o.State)
+		assert.Equal(t, "Paused: 2 operations failed, which exceeds the maximum of 1 failures", o.Description)
+
+		stats, err := store.Operations().GetOperationStatsForOrchestration(id)
+		require.NoError(t, err)
+		assert.Equal(t, 2, stats[orchestration.Failed])
+		assert.Equal(t, 1, stats[orchestration.Pending])
+
+		// when
+		o.State = orchestration.InProgress
+		o.Parameters.Strategy.MaxFailures = 10
+		err = store.Orchestrations().Update(*o)
+		require.NoError(t, err)
+		_, err = svc.Execute(id)
+		require.NoError(t, err)
+
+		// then
+		o, err = store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Failed, o.State)
+
+		stats, err = store.Operations().GetOperationStatsForOrchestration(id)
+		require.NoError(t, err)
+		assert.Equal(t, 3, stats[orchestration.Failed])
+	})
+
+	t.Run("Scheduled start", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		startAt := time.Now().Add(time.Hour)
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.Pending,
+			Type:            orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Strategy: orchestration.StrategySpec{
+					Type:     orchestration.ParallelStrategy,
+					Schedule: orchestration.Immediate,
+					StartAt:  &startAt,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		notificationBuilder := &notificationAutomock.BundleBuilder{}
+		defer notificationBuilder.AssertExpectations(t)
+
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), store.RuntimeStates(), &testExecutor{},
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, nil, notificationBuilder, 1000)
+
+		// when
+		when, err := svc.Execute(id)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, time.Minute, when)
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Pending, o.State)
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+		upgradedAt := time.Now().Add(-time.Hour)
+
+		runtime := orchestration.Runtime{InstanceID: "instance-id", RuntimeID: "runtime-id"}
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+		targets := orchestration.TargetSpec{Include: []orchestration.RuntimeTarget{{RuntimeID: runtime.RuntimeID}}}
+		resolver.On("Resolve", targets).Return([]orchestration.Runtime{runtime}, nil).Once()
+
+		err := store.Instances().Insert(internal.Instance{InstanceID: runtime.InstanceID, RuntimeID: runtime.RuntimeID})
+		require.NoError(t, err)
+		err = store.Operations().InsertUpgradeKymaOperation(internal.UpgradeKymaOperation{
+			Operation: internal.Operation{
+				ID:              "upgrade-op",
+				InstanceID:      runtime.InstanceID,
+				OrchestrationID: "upgrade",
+				State:           orchestration.Succeeded,
+				CreatedAt:       upgradedAt,
+			},
+			RuntimeOperation: orchestration.RuntimeOperation{ID: "upgrade-op", Runtime: runtime},
+		})
+		require.NoError(t, err)
+		for _, state := range []internal.RuntimeState{
+			{ID: "provisioning-state", RuntimeID: runtime.RuntimeID, KymaConfig: gqlschema.KymaConfigInput{Version: "2.1.0"}, CreatedAt: upgradedAt.Add(-2 * time.Hour)},
+			{ID: "update-state", RuntimeID: runtime.RuntimeID, KymaConfig: gqlschema.KymaConfigInput{Version: "2.2.0"}, CreatedAt: upgradedAt.Add(-time.Hour)},
+			{ID: "upgrade-state", RuntimeID: runtime.RuntimeID, OperationID: "upgrade-op", KymaConfig: gqlschema.KymaConfigInput{Version: "2.3.0"}, CreatedAt: upgradedAt.Add(time.Minute)},
+		} {
+			err = store.RuntimeStates().Insert(state)
+			require.NoError(t, err)
+		}
+
+		id := "rollback"
+		err = store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.Pending,
+			Type:            orchestration.RollbackKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Targets: targets,
+				Strategy: orchestration.StrategySpec{
+					Type:     orchestration.ParallelStrategy,
+					Schedule: orchestration.Immediate,
+					Parallel: orchestration.ParallelStrategySpec{Workers: 1},
+				},
+				Kyma:     &orchestration.KymaParameters{},
+				Rollback: &orchestration.RollbackParameters{OrchestrationID: "upgrade"},
+			},
+		})
+		require.NoError(t, err)
+
+		notificationBuilder := &notificationAutomock.BundleBuilder{}
+		notificationBuilder.On("DisabledCheck").Return(true)
+
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), store.RuntimeStates(),
+			&retryTestExecutor{store: store, upgradeType: orchestration.UpgradeKymaOrchestration},
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, nil, notificationBuilder, 1000)
+
+		// when
+		_, err = svc.Execute(id)
+		require.NoError(t, err)
+
+		// then
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Succeeded, o.State)
+
+		ops, _, _, err := store.Operations().ListUpgradeKymaOperationsByOrchestrationID(id, dbmodel.OperationFilter{})
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		assert.Equal(t, "2.2.0", ops[0].RuntimeVersion.Version)
+		assert.Equal(t, internal.Rollback, ops[0].RuntimeVersion.Origin)
+		assert.Equal(t, &internal.KymaRollback{OrchestrationID: "upgrade", RuntimeStateID: "update-state"}, ops[0].Rollback)
+	})
 }
 
 type testExecutor struct{}
@@ -490,3 +748,32 @@ func (t *retryTestExecutor) Execute(opID string) (time.Duration, error) {
 func (t *retryTestExecutor) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
 	return nil
 }
+
+// failingTestExecutor fails every processed operation after the delay, operations are postponed while the orchestration is paused
+type failingTestExecutor struct {
+	store storage.BrokerStorage
+	delay time.Duration
+}
+
+func (t *failingTestExecutor) Execute(opID string) (time.Duration, error) {
+	time.Sleep(t.delay)
+	op, err := t.store.Operations().GetUpgradeKymaOperationByID(opID)
+	if err != nil {
+		return 0, err
+	}
+	o, err := t.store.Orchestrations().GetByID(op.OrchestrationID)
+	if err != nil {
+		return 0, err
+	}
+	if o.IsPaused() {
+		return time.Second, nil
+	}
+	op.State = orchestration.Failed
+	_, err = t.store.Operations().UpdateUpgradeKymaOperation(*op)
+
+	return 0, err
+}
+
+func (t *failingTestExecutor) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
+	return nil
+}
@@ -63,6 +63,20 @@ func (rl RuntimeLister) ListAllRuntimes() ([]runtime.RuntimeDTO, error) {
 
 		rl.converter.ApplySuspensionOperations(&dto, dOprs)
 
+		hOprs, err := rl.operationsDb.ListHibernationOperationsByInstanceID(inst.InstanceID)
+		if err != nil {
+			rl.log.Errorf("while getting hibernation operations for instance %s: %s", inst.InstanceID, err.Error())
+			continue
+		}
+		rl.converter.ApplyHibernationOperations(&dto, hOprs)
+
+		ukOprs, err := rl.operationsDb.ListUpgradeKymaOperationsByInstanceID(inst.InstanceID)
+		if err != nil && !dberr.IsNotFound(err) {
+			rl.log.Errorf("while getting upgrade kyma operations for instance %s: %s", inst.InstanceID, err.Error())
+			continue
+		}
+		dto.KymaVersion = runtimeInt.DetermineKymaVersion(pOprs, ukOprs)
+
 		runtimes = append(runtimes, dto)
 	}
 
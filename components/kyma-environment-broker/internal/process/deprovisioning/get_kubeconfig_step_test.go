@@ -87,6 +87,14 @@ func (f fakeProvisionerClient) UpgradeShoot(accountID, runtimeID string, config
 	panic("not implemented")
 }
 
+func (f fakeProvisionerClient) HibernateRuntime(accountID, runtimeID string) (gqlschema.OperationStatus, error) {
+	panic("not implemented")
+}
+
+func (f fakeProvisionerClient) WakeUpRuntime(accountID, runtimeID string) (gqlschema.OperationStatus, error) {
+	panic("not implemented")
+}
+
 func (f fakeProvisionerClient) ReconnectRuntimeAgent(accountID, runtimeID string) (string, error) {
 	panic("not implemented")
 }
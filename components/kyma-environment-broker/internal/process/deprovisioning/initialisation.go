@@ -76,8 +76,8 @@ func (s *InitialisationStep) Run(operation internal.DeprovisioningOperation, log
 }
 
 func (s *InitialisationStep) run(operation internal.DeprovisioningOperation, log logrus.FieldLogger) (internal.DeprovisioningOperation, time.Duration, error) {
-	if time.Since(operation.CreatedAt) > s.operationTimeout {
-		log.Infof("operation has reached the time limit: operation was created at: %s", operation.CreatedAt)
+	if time.Since(operation.TimeoutBase()) > s.operationTimeout {
+		log.Infof("operation has reached the time limit: operation was created or resumed at: %s", operation.TimeoutBase())
 		return s.operationManager.OperationFailed(operation, fmt.Sprintf("operation has reached the time limit: %s", s.operationTimeout), nil, log)
 	}
 
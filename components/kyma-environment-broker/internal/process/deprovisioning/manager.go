@@ -2,7 +2,6 @@ package deprovisioning
 
 import (
 	"context"
-	"sort"
 	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
@@ -28,7 +27,7 @@ type Step interface {
 
 type Manager struct {
 	log              logrus.FieldLogger
-	steps            map[int][]Step
+	engine           *process.StagedEngine[internal.DeprovisioningOperation]
 	operationStorage storage.Operations
 	operationManager *process.DeprovisionOperationManager
 
@@ -39,7 +38,23 @@ func NewManager(storage storage.Operations, pub event.Publisher, logger logrus.F
 	return &Manager{
 		log:              logger,
 		operationStorage: storage,
-		steps:            make(map[int][]Step, 0),
+		engine: process.NewStagedEngine(process.StagedEngineConfig[internal.DeprovisioningOperation]{
+			Operation: func(op *internal.DeprovisioningOperation) *internal.Operation {
+				return &op.Operation
+			},
+			Update: storage.UpdateDeprovisioningOperation,
+			Steps:  storage,
+			StepProcessedEvent: func(old, processed internal.DeprovisioningOperation, step process.StepProcessed) interface{} {
+				return process.DeprovisioningStepProcessed{
+					StepProcessed: step,
+					OldOperation:  old,
+					Operation:     processed,
+				}
+			},
+			IsFinished: func(op internal.DeprovisioningOperation) bool {
+				return op.State != domain.InProgress && op.State != orchestration.Pending
+			},
+		}, pub),
 		publisher:        pub,
 		operationManager: process.NewDeprovisionOperationManager(storage),
 	}
@@ -53,30 +68,15 @@ func (m *Manager) AddStep(weight int, step Step) {
 	if weight <= 0 {
 		weight = 1
 	}
-	m.steps[weight] = append(m.steps[weight], step)
+	m.engine.AddWeightedStep(weight, step, nil)
 }
 
-func (m *Manager) runStep(step Step, operation internal.DeprovisioningOperation, logger logrus.FieldLogger) (internal.DeprovisioningOperation, time.Duration, error) {
-	start := time.Now()
-	processedOperation, when, err := step.Run(operation, logger)
-	if err != nil {
-		processedOperation.LastError = kebError.ReasonForError(err)
-		log := logger.WithFields(logrus.Fields{"error_component": processedOperation.LastError.Component(), "error_reason": processedOperation.LastError.Reason()})
-		log.Errorf("Last error: %s", processedOperation.LastError.Error())
-		// no saving to storage for deprovisioning
-	}
+func (m *Manager) GetAllStages() []string {
+	return m.engine.GetAllStages()
+}
 
-	m.publisher.Publish(context.TODO(), process.DeprovisioningStepProcessed{
-		StepProcessed: process.StepProcessed{
-			StepName: step.Name(),
-			Duration: time.Since(start),
-			When:     when,
-			Error:    err,
-		},
-		OldOperation: operation,
-		Operation:    processedOperation,
-	})
-	return processedOperation, when, err
+func (m *Manager) GetAllSteps() []string {
+	return m.engine.GetAllSteps()
 }
 
 func (m *Manager) Execute(operationID string) (time.Duration, error) {
@@ -113,48 +113,22 @@ func (m *Manager) Execute(operationID string) (time.Duration, error) {
 
 	logOperation = logOperation.WithField("planID", provisioningOp.ProvisioningParameters.PlanID)
 
-	var when time.Duration
 	logOperation.Info("Start process operation steps")
-
-	for _, weightStep := range m.sortWeight() {
-		steps := m.steps[weightStep]
-		for _, step := range steps {
-			logStep := logOperation.WithField("step", step.Name())
-			logStep.Infof("Start step")
-
-			operation, when, err = m.runStep(step, operation, logStep)
-			if err != nil {
-				logStep.Errorf("Process operation failed: %s", err)
-				return 0, err
-			}
-			if operation.State != domain.InProgress && operation.State != orchestration.Pending {
-				if operation.RuntimeID == "" && operation.State == domain.Succeeded {
-					logStep.Infof("Operation %q has no runtime ID. Process finished.", operation.ID)
-					return when, nil
-				}
-				logStep.Infof("Operation %q got status %s. Process finished.", operation.ID, operation.State)
-				return 0, nil
-			}
-			if when == 0 {
-				logStep.Info("Process operation successful")
-				continue
-			}
-
-			logStep.Infof("Process operation will be repeated in %s ...", when)
+	operation, when, err := m.engine.Run(operation, logOperation)
+	if err != nil {
+		return 0, err
+	}
+	if operation.State != domain.InProgress && operation.State != orchestration.Pending {
+		if operation.RuntimeID == "" && operation.State == domain.Succeeded {
+			logOperation.Infof("Operation %q has no runtime ID. Process finished.", operation.ID)
 			return when, nil
 		}
+		return 0, nil
+	}
+	if when > 0 {
+		return when, nil
 	}
 
 	logOperation.Infof("Operation %q got status %s. All steps finished.", operation.ID, operation.State)
 	return 0, nil
 }
-
-func (m *Manager) sortWeight() []int {
-	var weight []int
-	for w := range m.steps {
-		weight = append(weight, w)
-	}
-	sort.Ints(weight)
-
-	return weight
-}
@@ -0,0 +1,45 @@
+package deprovisioning
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// RemoveBindingsStep removes the service bindings of the instance.
+// The service accounts of the bindings are removed together with the runtime.
+type RemoveBindingsStep struct {
+	operationManager *process.DeprovisionOperationManager
+	bindingsStorage  storage.Bindings
+}
+
+func NewRemoveBindingsStep(os storage.Operations, bindingsStorage storage.Bindings) *RemoveBindingsStep {
+	return &RemoveBindingsStep{
+		operationManager: process.NewDeprovisionOperationManager(os),
+		bindingsStorage:  bindingsStorage,
+	}
+}
+
+func (s *RemoveBindingsStep) Name() string {
+	return "Remove_Bindings"
+}
+
+func (s *RemoveBindingsStep) Run(operation internal.DeprovisioningOperation, log logrus.FieldLogger) (internal.DeprovisioningOperation, time.Duration, error) {
+	bindings, err := s.bindingsStorage.ListByInstanceID(operation.InstanceID)
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to list the bindings of the instance", err, 10*time.Second, 5*time.Minute, log)
+	}
+
+	for _, binding := range bindings {
+		err := s.bindingsStorage.Delete(binding.InstanceID, binding.ID)
+		if err != nil {
+			return s.operationManager.RetryOperation(operation, "unable to remove the binding of the instance", err, 10*time.Second, 5*time.Minute, log)
+		}
+		log.Infof("binding %s removed", binding.ID)
+	}
+
+	return operation, 0, nil
+}
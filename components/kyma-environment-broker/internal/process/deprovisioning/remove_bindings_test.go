@@ -0,0 +1,46 @@
+package deprovisioning
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveBindingsStep_Run(t *testing.T) {
+	// given
+	memoryStorage := storage.NewMemoryStorage()
+
+	operation := fixture.FixDeprovisioningOperation(fixOperationID, fixInstanceID)
+	err := memoryStorage.Operations().InsertDeprovisioningOperation(operation)
+	require.NoError(t, err)
+
+	for _, binding := range []internal.Binding{
+		{ID: "binding-1", InstanceID: fixInstanceID, ExpiresAt: time.Now().Add(time.Hour)},
+		{ID: "binding-2", InstanceID: fixInstanceID, ExpiresAt: time.Now().Add(time.Hour)},
+		{ID: "binding-3", InstanceID: "other-instance", ExpiresAt: time.Now().Add(time.Hour)},
+	} {
+		err = memoryStorage.Bindings().Insert(binding)
+		require.NoError(t, err)
+	}
+
+	step := NewRemoveBindingsStep(memoryStorage.Operations(), memoryStorage.Bindings())
+
+	// when
+	_, repeat, err := step.Run(operation, logrus.New())
+
+	// then
+	require.NoError(t, err)
+	assert.Zero(t, repeat)
+	bindings, err := memoryStorage.Bindings().ListByInstanceID(fixInstanceID)
+	require.NoError(t, err)
+	assert.Empty(t, bindings)
+	bindings, err = memoryStorage.Bindings().ListByInstanceID("other-instance")
+	require.NoError(t, err)
+	assert.Len(t, bindings, 1)
+}
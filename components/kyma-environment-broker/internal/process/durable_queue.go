@@ -0,0 +1,221 @@
+package process
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+type DurableQueueConfig struct {
+	Enabled       bool          `envconfig:"default=false"`
+	PollInterval  time.Duration `envconfig:"default=1s"`
+	LeaseDuration time.Duration `envconfig:"default=5m"`
+}
+
+// NewDurableQueue creates a Queue which keeps its items in the storage. Items survive restarts of the application
+// and every item is processed by only one replica at the same time, the one which holds the lease of the item.
+func NewDurableQueue(name string, executor Executor, items storage.QueueItems, cfg DurableQueueConfig, log logrus.FieldLogger) *Queue {
+	return &Queue{
+		queue:     newDurableQueue(name, items, cfg, log),
+		executor:  executor,
+		waitGroup: sync.WaitGroup{},
+		log:       log,
+
+		speedFactor: 1,
+	}
+}
+
+type leasedItem struct {
+	item        internal.QueueItem
+	rescheduled bool
+	dueAt       time.Time
+	stopRenewal chan struct{}
+}
+
+// durableQueue implements the queueInterface on top of the QueueItems storage. A single poller leases as many items
+// as there are idle workers and hands them over to the workers, so the storage is polled once per queue.
+type durableQueue struct {
+	name  string
+	owner string
+	items storage.QueueItems
+	cfg   DurableQueueConfig
+	log   logrus.FieldLogger
+
+	mu       sync.Mutex
+	inFlight map[string]*leasedItem
+
+	// idle is the number of workers waiting in Get, which did not get an item yet
+	idle        int32
+	leased      chan string
+	wake        chan struct{}
+	pollerStart sync.Once
+
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
+}
+
+func newDurableQueue(name string, items storage.QueueItems, cfg DurableQueueConfig, log logrus.FieldLogger) *durableQueue {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "keb"
+	}
+
+	return &durableQueue{
+		name:     name,
+		owner:    fmt.Sprintf("%s-%s", hostname, uuid.New().String()),
+		items:    items,
+		cfg:      cfg,
+		log:      log.WithField("queue", name),
+		inFlight: make(map[string]*leasedItem),
+		leased:   make(chan string),
+		wake:     make(chan struct{}, 1),
+		shutdown: make(chan struct{}),
+	}
+}
+
+func (q *durableQueue) Add(item interface{}) {
+	q.AddAfter(item, 0)
+}
+
+func (q *durableQueue) AddAfter(item interface{}, duration time.Duration) {
+	id := item.(string)
+	dueAt := time.Now().Add(duration)
+
+	q.mu.Lock()
+	leased, processing := q.inFlight[id]
+	if processing {
+		// the item keeps its lease until the processing is done, the new due time is applied in Done
+		if !leased.rescheduled || dueAt.Before(leased.dueAt) {
+			leased.dueAt = dueAt
+		}
+		leased.rescheduled = true
+		q.mu.Unlock()
+		return
+	}
+	q.mu.Unlock()
+
+	if err := q.items.Enqueue(q.name, id, dueAt); err != nil {
+		q.log.Errorf("unable to add item %s: %s", id, err)
+	}
+}
+
+func (q *durableQueue) addIfAbsent(id string) {
+	if err := q.items.EnqueueIfAbsent(q.name, id, time.Now()); err != nil {
+		q.log.Errorf("unable to add item %s: %s", id, err)
+	}
+}
+
+// Get blocks until the poller hands a leased item over or the queue is shut down
+func (q *durableQueue) Get() (interface{}, bool) {
+	q.pollerStart.Do(func() {
+		go q.poll()
+	})
+	atomic.AddInt32(&q.idle, 1)
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+
+	select {
+	case <-q.shutdown:
+		return nil, true
+	case id := <-q.leased:
+		return id, false
+	}
+}
+
+// poll leases due items for idle workers. It polls the storage every PollInterval, and at once when a worker
+// becomes idle or all requested items were leased, because more items can be due.
+func (q *durableQueue) poll() {
+	for {
+		if idle := atomic.LoadInt32(&q.idle); idle > 0 {
+			leased, err := q.items.Lease(q.name, q.owner, time.Now(), q.cfg.LeaseDuration, int(idle))
+			if err != nil {
+				q.log.Errorf("unable to lease items: %s", err)
+			}
+			for _, item := range leased {
+				stop := make(chan struct{})
+				q.mu.Lock()
+				q.inFlight[item.ItemID] = &leasedItem{item: item, stopRenewal: stop}
+				q.mu.Unlock()
+				go q.renewLease(item, stop)
+
+				// every leased item has a waiting worker, the worker is counted as idle until it gets the item
+				select {
+				case <-q.shutdown:
+					return
+				case q.leased <- item.ItemID:
+					atomic.AddInt32(&q.idle, -1)
+				}
+			}
+			if len(leased) == int(idle) {
+				continue
+			}
+		}
+
+		select {
+		case <-q.shutdown:
+			return
+		case <-q.wake:
+		case <-time.After(q.cfg.PollInterval):
+		}
+	}
+}
+
+func (q *durableQueue) Done(item interface{}) {
+	id := item.(string)
+
+	q.mu.Lock()
+	leased, processing := q.inFlight[id]
+	delete(q.inFlight, id)
+	q.mu.Unlock()
+	if !processing {
+		return
+	}
+
+	close(leased.stopRenewal)
+	if leased.rescheduled {
+		if err := q.items.Reschedule(leased.item, leased.dueAt); err != nil {
+			q.log.Errorf("unable to reschedule item %s: %s", id, err)
+		}
+		return
+	}
+	if err := q.items.Complete(leased.item); err != nil {
+		q.log.Errorf("unable to complete item %s: %s", id, err)
+	}
+}
+
+// Forget does nothing, retries are driven by the due time of the item
+func (q *durableQueue) Forget(interface{}) {}
+
+func (q *durableQueue) ShutDown() {
+	q.shutdownOnce.Do(func() {
+		close(q.shutdown)
+	})
+}
+
+// renewLease extends the lease of the item until the processing is done
+func (q *durableQueue) renewLease(item internal.QueueItem, stop <-chan struct{}) {
+	ticker := time.NewTicker(q.cfg.LeaseDuration / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-q.shutdown:
+			return
+		case <-ticker.C:
+			if err := q.items.RenewLease(item, time.Now().Add(q.cfg.LeaseDuration)); err != nil {
+				q.log.Errorf("unable to renew lease of item %s: %s", item.ItemID, err)
+			}
+		}
+	}
+}
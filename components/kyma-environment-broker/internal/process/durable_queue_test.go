@@ -0,0 +1,206 @@
+package process
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type countingExecutor struct {
+	mu         sync.Mutex
+	executions map[string]int
+	retries    int
+}
+
+func (e *countingExecutor) Execute(operationID string) (time.Duration, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.executions[operationID]++
+	if e.executions[operationID] <= e.retries {
+		return time.Millisecond, nil
+	}
+	return 0, nil
+}
+
+func (e *countingExecutor) count(operationID string) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.executions[operationID]
+}
+
+// leaseRecorder records the limits of leases
+type leaseRecorder struct {
+	storage.QueueItems
+
+	mu     sync.Mutex
+	leased []int
+}
+
+func (r *leaseRecorder) Lease(queue, owner string, now time.Time, leaseDuration time.Duration, limit int) ([]internal.QueueItem, error) {
+	items, err := r.QueueItems.Lease(queue, owner, now, leaseDuration, limit)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.leased = append(r.leased, len(items))
+	return items, err
+}
+
+func TestDurableQueue(t *testing.T) {
+	cfg := DurableQueueConfig{Enabled: true, PollInterval: 10 * time.Millisecond, LeaseDuration: time.Minute}
+
+	t.Run("should process items and remove them from the storage", func(t *testing.T) {
+		// given
+		items := storage.NewMemoryStorage().QueueItems()
+		executor := &countingExecutor{executions: map[string]int{}, retries: 2}
+		queue := NewDurableQueue("provisioning", executor, items, cfg, logrus.New())
+		stop := make(chan struct{})
+		defer close(stop)
+
+		// when
+		queue.Add("op-1")
+		queue.Run(stop, 2)
+
+		// then
+		err := wait.PollImmediate(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+			return executor.count("op-1") == 3, nil
+		})
+		require.NoError(t, err)
+		queue.ShutDown()
+
+		leased, err := items.Lease("provisioning", "other", time.Now().Add(time.Hour), time.Minute, 10)
+		require.NoError(t, err)
+		assert.Empty(t, leased)
+	})
+
+	t.Run("should take over item not completed by the previous owner", func(t *testing.T) {
+		// given
+		items := storage.NewMemoryStorage().QueueItems()
+		require.NoError(t, items.Enqueue("deprovisioning", "op-1", time.Now()))
+		leased, err := items.Lease("deprovisioning", "crashed-replica", time.Now(), time.Millisecond, 1)
+		require.NoError(t, err)
+		require.Len(t, leased, 1)
+
+		executor := &countingExecutor{executions: map[string]int{}}
+		queue := NewDurableQueue("deprovisioning", executor, items, cfg, logrus.New())
+		stop := make(chan struct{})
+		defer close(stop)
+
+		// when
+		queue.Resume("op-1")
+		queue.Run(stop, 1)
+
+		// then
+		err = wait.PollImmediate(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+			return executor.count("op-1") == 1, nil
+		})
+		require.NoError(t, err)
+		queue.ShutDown()
+	})
+
+	t.Run("should lease items for all idle workers at once", func(t *testing.T) {
+		// given
+		items := &leaseRecorder{QueueItems: storage.NewMemoryStorage().QueueItems()}
+		queue := newDurableQueue("provisioning", items, cfg, logrus.New())
+		defer queue.ShutDown()
+		dueAt := time.Now().Add(200 * time.Millisecond)
+		for _, id := range []string{"op-1", "op-2", "op-3"} {
+			require.NoError(t, items.Enqueue("provisioning", id, dueAt))
+		}
+		got := make(chan interface{}, 3)
+
+		// when
+		for i := 0; i < 3; i++ {
+			go func() {
+				item, _ := queue.Get()
+				got <- item
+			}()
+		}
+		err := wait.PollImmediate(time.Millisecond, 2*time.Second, func() (bool, error) {
+			return atomic.LoadInt32(&queue.idle) == 3, nil
+		})
+		require.NoError(t, err)
+
+		// then
+		var ids []interface{}
+		for i := 0; i < 3; i++ {
+			select {
+			case item := <-got:
+				ids = append(ids, item)
+			case <-time.After(2 * time.Second):
+				t.Fatal("the item was not handed over to the worker")
+			}
+		}
+		assert.ElementsMatch(t, []interface{}{"op-1", "op-2", "op-3"}, ids)
+		items.mu.Lock()
+		defer items.mu.Unlock()
+		assert.Contains(t, items.leased, 3)
+	})
+
+	t.Run("should not lease item leased by other owner", func(t *testing.T) {
+		// given
+		items := storage.NewMemoryStorage().QueueItems()
+		require.NoError(t, items.Enqueue("update", "op-1", time.Now()))
+
+		// when
+		first, err := items.Lease("update", "replica-1", time.Now(), time.Minute, 1)
+		require.NoError(t, err)
+		second, err := items.Lease("update", "replica-2", time.Now(), time.Minute, 1)
+		require.NoError(t, err)
+
+		// then
+		assert.Len(t, first, 1)
+		assert.Empty(t, second)
+	})
+
+	t.Run("should keep the lease of item added again during processing until it is done", func(t *testing.T) {
+		// given
+		items := storage.NewMemoryStorage().QueueItems()
+		queue := newDurableQueue("update", items, cfg, logrus.New())
+		queue.Add("op-1")
+		item, shutdown := queue.Get()
+		require.False(t, shutdown)
+
+		// when
+		queue.Add("op-1")
+
+		// then
+		leased, err := items.Lease("update", "replica-2", time.Now(), time.Minute, 1)
+		require.NoError(t, err)
+		assert.Empty(t, leased)
+
+		// when
+		queue.Done(item)
+
+		// then
+		leased, err = items.Lease("update", "replica-2", time.Now(), time.Minute, 1)
+		require.NoError(t, err)
+		assert.Len(t, leased, 1)
+	})
+
+	t.Run("should keep item added again during processing", func(t *testing.T) {
+		// given
+		items := storage.NewMemoryStorage().QueueItems()
+		require.NoError(t, items.Enqueue("update", "op-1", time.Now()))
+		leased, err := items.Lease("update", "replica-1", time.Now(), time.Minute, 1)
+		require.NoError(t, err)
+		require.Len(t, leased, 1)
+
+		// when
+		require.NoError(t, items.Enqueue("update", "op-1", time.Now()))
+		require.NoError(t, items.Complete(leased[0]))
+
+		// then
+		leased, err = items.Lease("update", "replica-1", time.Now(), time.Minute, 1)
+		require.NoError(t, err)
+		assert.Len(t, leased, 1)
+	})
+}
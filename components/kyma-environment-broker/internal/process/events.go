@@ -4,6 +4,10 @@ import (
 	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+
+	"github.com/pivotal-cf/brokerapi/v8/domain"
 )
 
 type StepProcessed struct {
@@ -42,6 +46,125 @@ type UpgradeClusterStepProcessed struct {
 	Operation    internal.UpgradeClusterOperation
 }
 
+type RunTaskStepProcessed struct {
+	StepProcessed
+	OldOperation internal.RunTaskOperation
+	Operation    internal.RunTaskOperation
+}
+
+type HibernationStepProcessed struct {
+	StepProcessed
+	OldOperation internal.HibernationOperation
+	Operation    internal.HibernationOperation
+}
+
 type ProvisioningSucceeded struct {
 	Operation internal.ProvisioningOperation
 }
+
+// OperationChanged is the event of the saved operation. It is appended to the outbox in the same transaction
+// as the operation, so it describes the saved state of the operation and has no details of the step which saved it.
+type OperationChanged struct {
+	Operation internal.Operation
+}
+
+// OperationEvent is the form of events of operations kept in the outbox and delivered to durable subscribers
+type OperationEvent struct {
+	OperationID     string                 `json:"operationID"`
+	OperationType   internal.OperationType `json:"operationType"`
+	InstanceID      string                 `json:"instanceID"`
+	RuntimeID       string                 `json:"runtimeID,omitempty"`
+	GlobalAccountID string                 `json:"globalAccountID,omitempty"`
+	SubAccountID    string                 `json:"subAccountID,omitempty"`
+	PlanID          string                 `json:"planID,omitempty"`
+	OrchestrationID string                 `json:"orchestrationID,omitempty"`
+	State           string                 `json:"state"`
+	Description     string                 `json:"description,omitempty"`
+
+	StepName       string        `json:"stepName,omitempty"`
+	Duration       time.Duration `json:"duration,omitempty"`
+	When           time.Duration `json:"when,omitempty"`
+	ErrorReason    string        `json:"errorReason,omitempty"`
+	ErrorComponent string        `json:"errorComponent,omitempty"`
+	ErrorMessage   string        `json:"errorMessage,omitempty"`
+}
+
+func newOperationEvent(op internal.Operation, step *StepProcessed) OperationEvent {
+	ev := OperationEvent{
+		OperationID:     op.ID,
+		OperationType:   op.Type,
+		InstanceID:      op.InstanceID,
+		RuntimeID:       op.RuntimeID,
+		GlobalAccountID: op.ProvisioningParameters.ErsContext.GlobalAccountID,
+		SubAccountID:    op.ProvisioningParameters.ErsContext.SubAccountID,
+		PlanID:          op.ProvisioningParameters.PlanID,
+		OrchestrationID: op.OrchestrationID,
+		State:           string(op.State),
+		Description:     op.Description,
+	}
+	lastErr := op.LastError
+	if step != nil {
+		ev.StepName = step.StepName
+		ev.Duration = step.Duration
+		ev.When = step.When
+		if step.Error != nil {
+			lastErr = kebError.ReasonForError(step.Error)
+		}
+	}
+	if lastErr.Error() != "" || lastErr.Reason() != "" {
+		ev.ErrorReason = string(lastErr.Reason())
+		ev.ErrorComponent = string(lastErr.Component())
+		ev.ErrorMessage = lastErr.Error()
+	}
+	return ev
+}
+
+// OperationEvents returns events of the saved operation, which are appended to the outbox in the same transaction
+// as the operation. Events of steps are saved in the outbox when they are published, because the storage does not know the step.
+func OperationEvents(op internal.Operation) []event.DurableEvent {
+	events := []event.DurableEvent{OperationChanged{Operation: op}}
+	if op.Type == internal.OperationTypeProvision && op.State == domain.Succeeded {
+		events = append(events, ProvisioningSucceeded{Operation: internal.ProvisioningOperation{Operation: op}})
+	}
+	return events
+}
+
+func (e ProvisioningStepProcessed) OutboxEvent() interface{} {
+	return newOperationEvent(e.Operation.Operation, &e.StepProcessed)
+}
+
+func (e UpdatingStepProcessed) OutboxEvent() interface{} {
+	return newOperationEvent(e.Operation.Operation, &e.StepProcessed)
+}
+
+func (e DeprovisioningStepProcessed) OutboxEvent() interface{} {
+	return newOperationEvent(e.Operation.Operation, &e.StepProcessed)
+}
+
+func (e UpgradeKymaStepProcessed) OutboxEvent() interface{} {
+	return newOperationEvent(e.Operation.Operation, &e.StepProcessed)
+}
+
+func (e UpgradeClusterStepProcessed) OutboxEvent() interface{} {
+	return newOperationEvent(e.Operation.Operation, &e.StepProcessed)
+}
+
+func (e RunTaskStepProcessed) OutboxEvent() interface{} {
+	return newOperationEvent(e.Operation.Operation, &e.StepProcessed)
+}
+
+func (e HibernationStepProcessed) OutboxEvent() interface{} {
+	return newOperationEvent(e.Operation.Operation, &e.StepProcessed)
+}
+
+func (e OperationChanged) OutboxEvent() interface{} {
+	return newOperationEvent(e.Operation, nil)
+}
+
+func (e OperationChanged) SavedWithOperation() {}
+
+func (e ProvisioningSucceeded) SavedWithOperation() {}
+
+func (e ProvisioningSucceeded) OutboxEvent() interface{} {
+	return newOperationEvent(e.Operation.Operation, nil)
+}
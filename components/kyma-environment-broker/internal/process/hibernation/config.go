@@ -0,0 +1,22 @@
+package hibernation
+
+import "time"
+
+type TimeSchedule struct {
+	Retry       time.Duration
+	StatusCheck time.Duration
+	// Timeout is the time of waiting for the provisioner to hibernate or wake up the cluster
+	Timeout time.Duration
+}
+
+// timeScheduleOrDefault returns the given time schedule or the default one if it is not set
+func timeScheduleOrDefault(timeSchedule *TimeSchedule) TimeSchedule {
+	if timeSchedule == nil {
+		return TimeSchedule{
+			Retry:       5 * time.Second,
+			StatusCheck: time.Minute,
+			Timeout:     time.Hour,
+		}
+	}
+	return *timeSchedule
+}
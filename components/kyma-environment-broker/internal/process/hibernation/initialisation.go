@@ -0,0 +1,69 @@
+package hibernation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+)
+
+type InitialisationStep struct {
+	operationManager *process.HibernationOperationManager
+	operationStorage storage.Operations
+	instanceStorage  storage.Instances
+	timeSchedule     TimeSchedule
+}
+
+func NewInitialisationStep(os storage.Operations, is storage.Instances, timeSchedule *TimeSchedule) *InitialisationStep {
+	return &InitialisationStep{
+		operationManager: process.NewHibernationOperationManager(os),
+		operationStorage: os,
+		instanceStorage:  is,
+		timeSchedule:     timeScheduleOrDefault(timeSchedule),
+	}
+}
+
+func (s *InitialisationStep) Name() string {
+	return "Hibernation_Initialisation"
+}
+
+func (s *InitialisationStep) Run(operation internal.HibernationOperation, log logrus.FieldLogger) (internal.HibernationOperation, time.Duration, error) {
+	if operation.State != orchestration.Pending {
+		return operation, 0, nil
+	}
+
+	_, err := s.instanceStorage.GetByID(operation.InstanceID)
+	switch {
+	case dberr.IsNotFound(err):
+		return s.operationManager.OperationFailed(operation, "the instance was deprovisioned", nil, log)
+	case err != nil:
+		return operation, s.timeSchedule.Retry, nil
+	}
+	if operation.RuntimeID == "" {
+		return s.operationManager.OperationFailed(operation, "the instance has no runtime", nil, log)
+	}
+
+	// the cluster cannot be woken up while it is being hibernated and vice versa,
+	// wait for the previous operations of the instance to finish
+	ops, err := s.operationStorage.ListHibernationOperationsByInstanceID(operation.InstanceID)
+	if err != nil {
+		return operation, s.timeSchedule.Retry, nil
+	}
+	for _, op := range ops {
+		if op.CreatedAt.Before(operation.CreatedAt) && !op.IsFinished() {
+			log.Infof("waiting for the %s operation %s to finish", op.Type, op.Operation.ID)
+			return operation, s.timeSchedule.StatusCheck, nil
+		}
+	}
+
+	return s.operationManager.UpdateOperation(operation, func(op *internal.HibernationOperation) {
+		op.State = domain.InProgress
+		op.Description = fmt.Sprintf("%s operation in progress", op.Type)
+	}, log)
+}
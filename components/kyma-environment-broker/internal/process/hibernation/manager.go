@@ -0,0 +1,91 @@
+package hibernation
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+type Step interface {
+	Name() string
+	Run(operation internal.HibernationOperation, logger logrus.FieldLogger) (internal.HibernationOperation, time.Duration, error)
+}
+
+// Manager processes the hibernate and wake up operations of suspended instances
+type Manager struct {
+	log              logrus.FieldLogger
+	engine           *process.StagedEngine[internal.HibernationOperation]
+	operationStorage storage.Operations
+}
+
+func NewManager(storage storage.Operations, pub event.Publisher, logger logrus.FieldLogger) *Manager {
+	return &Manager{
+		log: logger,
+		engine: process.NewStagedEngine(process.StagedEngineConfig[internal.HibernationOperation]{
+			Operation: func(op *internal.HibernationOperation) *internal.Operation {
+				return &op.Operation
+			},
+			Update: storage.UpdateHibernationOperation,
+			Steps:  storage,
+			StepProcessedEvent: func(old, processed internal.HibernationOperation, step process.StepProcessed) interface{} {
+				return process.HibernationStepProcessed{
+					OldOperation:  old,
+					Operation:     processed,
+					StepProcessed: step,
+				}
+			},
+		}, pub),
+		operationStorage: storage,
+	}
+}
+
+func (m *Manager) InitStep(step Step) {
+	m.AddStep(0, step, "")
+}
+
+// AddStep adds the step run only for operations of the given type, the step is run for all operations if the type is empty
+func (m *Manager) AddStep(weight int, step Step, opType internal.OperationType) {
+	if weight <= 0 {
+		weight = 1
+	}
+	var cnd process.StepCondition[internal.HibernationOperation]
+	if opType != "" {
+		cnd = func(operation internal.HibernationOperation) bool {
+			return operation.Type == opType
+		}
+	}
+	m.engine.AddWeightedStep(weight, step, cnd)
+}
+
+func (m *Manager) Execute(operationID string) (time.Duration, error) {
+	op, err := m.operationStorage.GetHibernationOperationByID(operationID)
+	if err != nil {
+		m.log.Errorf("Cannot fetch operation from storage: %s", err)
+		return 3 * time.Second, nil
+	}
+	operation := *op
+	if operation.IsFinished() {
+		return 0, nil
+	}
+
+	logOperation := m.log.WithFields(logrus.Fields{"operation": operationID, "instanceID": operation.InstanceID, "type": operation.Type})
+
+	logOperation.Info("Start process operation steps")
+	operation, when, err := m.engine.Run(operation, logOperation)
+	if err != nil {
+		return 0, err
+	}
+	if operation.IsFinished() {
+		return 0, nil
+	}
+	if when > 0 {
+		return when, nil
+	}
+
+	logOperation.Infof("Operation %q got status %s. All steps finished.", operation.Operation.ID, operation.State)
+	return 0, nil
+}
@@ -0,0 +1,156 @@
+package hibernation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	instanceID         = "inst-id"
+	hibernateOpID      = "hibernate-op-id"
+	wakeUpOpID         = "wake-up-op-id"
+	provisionerTimeout = time.Hour
+)
+
+func TestManager_Execute(t *testing.T) {
+	for name, tc := range map[string]struct {
+		operationID      string
+		opType           internal.OperationType
+		provisionerState gqlschema.OperationState
+		expectedState    string
+		expectedError    bool
+	}{
+		"hibernation succeeded": {
+			operationID:      hibernateOpID,
+			opType:           internal.OperationTypeHibernate,
+			provisionerState: gqlschema.OperationStateSucceeded,
+			expectedState:    orchestration.Succeeded,
+		},
+		"wake up succeeded": {
+			operationID:      wakeUpOpID,
+			opType:           internal.OperationTypeWakeUp,
+			provisionerState: gqlschema.OperationStateSucceeded,
+			expectedState:    orchestration.Succeeded,
+		},
+		"hibernation failed in the provisioner": {
+			operationID:      hibernateOpID,
+			opType:           internal.OperationTypeHibernate,
+			provisionerState: gqlschema.OperationStateFailed,
+			expectedState:    orchestration.Failed,
+			expectedError:    true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			memoryStorage := storage.NewMemoryStorage()
+			provisionerClient := provisioner.NewFakeClient()
+			manager := fixManager(memoryStorage, provisionerClient)
+
+			instance := fixture.FixInstance(instanceID)
+			require.NoError(t, memoryStorage.Instances().Insert(instance))
+			operation := fixHibernationOperation(tc.operationID, tc.opType, &instance)
+			require.NoError(t, memoryStorage.Operations().InsertHibernationOperation(operation))
+
+			// when
+			repeat, err := manager.Execute(tc.operationID)
+
+			// then
+			require.NoError(t, err)
+			assert.NotZero(t, repeat)
+			op, err := memoryStorage.Operations().GetHibernationOperationByID(tc.operationID)
+			require.NoError(t, err)
+			assert.Equal(t, orchestration.InProgress, string(op.State))
+			require.NotEmpty(t, op.ProvisionerOperationID)
+
+			// when
+			provisionerClient.FinishProvisionerOperation(op.ProvisionerOperationID, tc.provisionerState)
+			repeat, err = manager.Execute(tc.operationID)
+
+			// then
+			assert.Equal(t, tc.expectedError, err != nil)
+			assert.Zero(t, repeat)
+			op, err = memoryStorage.Operations().GetHibernationOperationByID(tc.operationID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedState, string(op.State))
+		})
+	}
+}
+
+func TestManager_Execute_WaitsForPreviousOperation(t *testing.T) {
+	// given
+	memoryStorage := storage.NewMemoryStorage()
+	provisionerClient := provisioner.NewFakeClient()
+	manager := fixManager(memoryStorage, provisionerClient)
+
+	instance := fixture.FixInstance(instanceID)
+	require.NoError(t, memoryStorage.Instances().Insert(instance))
+	hibernation := fixHibernationOperation(hibernateOpID, internal.OperationTypeHibernate, &instance)
+	hibernation.CreatedAt = time.Now().Add(-time.Minute)
+	require.NoError(t, memoryStorage.Operations().InsertHibernationOperation(hibernation))
+	wakeUp := fixHibernationOperation(wakeUpOpID, internal.OperationTypeWakeUp, &instance)
+	require.NoError(t, memoryStorage.Operations().InsertHibernationOperation(wakeUp))
+
+	// when
+	_, err := manager.Execute(hibernateOpID)
+	require.NoError(t, err)
+	repeat, err := manager.Execute(wakeUpOpID)
+
+	// then
+	require.NoError(t, err)
+	assert.NotZero(t, repeat)
+	op, err := memoryStorage.Operations().GetHibernationOperationByID(wakeUpOpID)
+	require.NoError(t, err)
+	assert.Equal(t, orchestration.Pending, string(op.State))
+	assert.Empty(t, op.ProvisionerOperationID)
+}
+
+func TestManager_Execute_InstanceDeprovisioned(t *testing.T) {
+	// given
+	memoryStorage := storage.NewMemoryStorage()
+	manager := fixManager(memoryStorage, provisioner.NewFakeClient())
+
+	instance := fixture.FixInstance(instanceID)
+	operation := fixHibernationOperation(wakeUpOpID, internal.OperationTypeWakeUp, &instance)
+	require.NoError(t, memoryStorage.Operations().InsertHibernationOperation(operation))
+
+	// when
+	_, err := manager.Execute(wakeUpOpID)
+
+	// then
+	assert.Error(t, err)
+	op, err := memoryStorage.Operations().GetHibernationOperationByID(wakeUpOpID)
+	require.NoError(t, err)
+	assert.Equal(t, orchestration.Failed, string(op.State))
+}
+
+func fixManager(db storage.BrokerStorage, cli provisioner.Client) *Manager {
+	ts := &TimeSchedule{
+		Retry:       time.Millisecond,
+		StatusCheck: time.Millisecond,
+		Timeout:     provisionerTimeout,
+	}
+	manager := NewManager(db.Operations(), event.NewPubSub(logrus.New()), logrus.New())
+	manager.InitStep(NewInitialisationStep(db.Operations(), db.Instances(), ts))
+	manager.AddStep(2, NewHibernateRuntimeStep(db.Operations(), cli, ts), internal.OperationTypeHibernate)
+	manager.AddStep(2, NewWakeUpRuntimeStep(db.Operations(), cli, ts), internal.OperationTypeWakeUp)
+	manager.AddStep(3, NewCheckRuntimeOperationStep(db.Operations(), cli, ts), "")
+	return manager
+}
+
+func fixHibernationOperation(id string, opType internal.OperationType, instance *internal.Instance) internal.HibernationOperation {
+	if opType == internal.OperationTypeWakeUp {
+		return internal.NewWakeUpOperationWithID(id, instance)
+	}
+	return internal.NewHibernationOperationWithID(id, instance)
+}
@@ -0,0 +1,119 @@
+package hibernation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/sirupsen/logrus"
+)
+
+// ProvisionerCallStep triggers the provisioner operation which hibernates or wakes up the cluster
+type ProvisionerCallStep struct {
+	name             string
+	description      string
+	call             func(accountID, runtimeID string) (gqlschema.OperationStatus, error)
+	operationManager *process.HibernationOperationManager
+	timeSchedule     TimeSchedule
+}
+
+func NewHibernateRuntimeStep(os storage.Operations, cli provisioner.Client, timeSchedule *TimeSchedule) *ProvisionerCallStep {
+	return &ProvisionerCallStep{
+		name:             "Hibernate_Runtime",
+		description:      "cluster hibernation in progress",
+		call:             cli.HibernateRuntime,
+		operationManager: process.NewHibernationOperationManager(os),
+		timeSchedule:     timeScheduleOrDefault(timeSchedule),
+	}
+}
+
+func NewWakeUpRuntimeStep(os storage.Operations, cli provisioner.Client, timeSchedule *TimeSchedule) *ProvisionerCallStep {
+	return &ProvisionerCallStep{
+		name:             "Wake_Up_Runtime",
+		description:      "cluster wake up in progress",
+		call:             cli.WakeUpRuntime,
+		operationManager: process.NewHibernationOperationManager(os),
+		timeSchedule:     timeScheduleOrDefault(timeSchedule),
+	}
+}
+
+func (s *ProvisionerCallStep) Name() string {
+	return s.name
+}
+
+func (s *ProvisionerCallStep) Run(operation internal.HibernationOperation, log logrus.FieldLogger) (internal.HibernationOperation, time.Duration, error) {
+	if operation.ProvisionerOperationID != "" {
+		return operation, 0, nil
+	}
+
+	status, err := s.call(operation.ProvisioningParameters.ErsContext.GlobalAccountID, operation.RuntimeID)
+	switch {
+	case kebError.IsTemporaryError(err):
+		return s.operationManager.RetryOperation(operation, fmt.Sprintf("call to provisioner failed: %s", err), err, s.timeSchedule.Retry, s.timeSchedule.Timeout, log)
+	case err != nil:
+		return s.operationManager.OperationFailed(operation, "call to provisioner failed", err, log)
+	}
+	if status.ID == nil {
+		return s.operationManager.OperationFailed(operation, "provisioner returned no operation ID", nil, log)
+	}
+	log.Infof("call to provisioner succeeded, got operation ID %q", *status.ID)
+
+	return s.operationManager.UpdateOperation(operation, func(op *internal.HibernationOperation) {
+		op.ProvisionerOperationID = *status.ID
+		op.Description = s.description
+	}, log)
+}
+
+// CheckRuntimeOperationStep waits for the provisioner operation to finish
+type CheckRuntimeOperationStep struct {
+	provisionerClient provisioner.Client
+	operationManager  *process.HibernationOperationManager
+	timeSchedule      TimeSchedule
+}
+
+func NewCheckRuntimeOperationStep(os storage.Operations, cli provisioner.Client, timeSchedule *TimeSchedule) *CheckRuntimeOperationStep {
+	return &CheckRuntimeOperationStep{
+		provisionerClient: cli,
+		operationManager:  process.NewHibernationOperationManager(os),
+		timeSchedule:      timeScheduleOrDefault(timeSchedule),
+	}
+}
+
+func (s *CheckRuntimeOperationStep) Name() string {
+	return "Check_Runtime_Operation"
+}
+
+func (s *CheckRuntimeOperationStep) Run(operation internal.HibernationOperation, log logrus.FieldLogger) (internal.HibernationOperation, time.Duration, error) {
+	if time.Since(operation.UpdatedAt) > s.timeSchedule.Timeout {
+		log.Infof("operation has reached the time limit: updated operation time: %s", operation.UpdatedAt)
+		return s.operationManager.OperationFailed(operation, fmt.Sprintf("operation has reached the time limit: %s", s.timeSchedule.Timeout), nil, log)
+	}
+	if operation.ProvisionerOperationID == "" {
+		return s.operationManager.OperationFailed(operation, "Operation does not contain Provisioner Operation ID", nil, log)
+	}
+
+	status, err := s.provisionerClient.RuntimeOperationStatus(operation.ProvisioningParameters.ErsContext.GlobalAccountID, operation.ProvisionerOperationID)
+	if err != nil {
+		log.Errorf("call to provisioner RuntimeOperationStatus failed: %s", err.Error())
+		return operation, s.timeSchedule.StatusCheck, nil
+	}
+	log.Infof("call to provisioner returned %s status", status.State.String())
+
+	switch status.State {
+	case gqlschema.OperationStateSucceeded:
+		return s.operationManager.OperationSucceeded(operation, fmt.Sprintf("%s operation succeeded", operation.Type), log)
+	case gqlschema.OperationStateInProgress, gqlschema.OperationStatePending:
+		return operation, s.timeSchedule.StatusCheck, nil
+	case gqlschema.OperationStateFailed:
+		lastErr := provisioner.OperationStatusLastError(status.LastError)
+		return s.operationManager.OperationFailed(operation, "provisioner client returns failed status", lastErr, log)
+	}
+
+	lastErr := provisioner.OperationStatusLastError(status.LastError)
+	return s.operationManager.OperationFailed(operation, fmt.Sprintf("unsupported provisioner client status: %s", status.State.String()), lastErr, log)
+}
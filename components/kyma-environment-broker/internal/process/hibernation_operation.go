@@ -0,0 +1,98 @@
+package process
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type HibernationOperationManager struct {
+	storage storage.Hibernation
+}
+
+func NewHibernationOperationManager(storage storage.Operations) *HibernationOperationManager {
+	return &HibernationOperationManager{storage: storage}
+}
+
+// OperationSucceeded marks the operation as succeeded and only repeats it if there is a storage error
+func (om *HibernationOperationManager) OperationSucceeded(operation internal.HibernationOperation, description string, log logrus.FieldLogger) (internal.HibernationOperation, time.Duration, error) {
+	updatedOperation, repeat, _ := om.update(operation, orchestration.Succeeded, description, log)
+	// repeat in case of storage error
+	if repeat != 0 {
+		return updatedOperation, repeat, nil
+	}
+
+	return updatedOperation, 0, nil
+}
+
+// OperationFailed marks the operation as failed and only repeats it if there is a storage error
+func (om *HibernationOperationManager) OperationFailed(operation internal.HibernationOperation, description string, err error, log logrus.FieldLogger) (internal.HibernationOperation, time.Duration, error) {
+	updatedOperation, repeat, _ := om.update(operation, orchestration.Failed, description, log)
+	// repeat in case of storage error
+	if repeat != 0 {
+		return updatedOperation, repeat, nil
+	}
+
+	var retErr error
+	if err == nil {
+		// no exact err passed in
+		retErr = errors.New(description)
+	} else {
+		// keep the original err object for error categorizer
+		retErr = errors.Wrap(err, description)
+	}
+
+	return updatedOperation, 0, retErr
+}
+
+// RetryOperation retries an operation for at maxTime in retryInterval steps and fails the operation if retrying failed
+func (om *HibernationOperationManager) RetryOperation(operation internal.HibernationOperation, errorMessage string, err error, retryInterval time.Duration, maxTime time.Duration, log logrus.FieldLogger) (internal.HibernationOperation, time.Duration, error) {
+	since := time.Since(operation.UpdatedAt)
+
+	log.Infof("Retry Operation was triggered with message: %s", errorMessage)
+	log.Infof("Retrying for %s in %s steps", maxTime.String(), retryInterval.String())
+	if since < maxTime {
+		return operation, retryInterval, nil
+	}
+	log.Errorf("Aborting after %s of failing retries", maxTime.String())
+	return om.OperationFailed(operation, errorMessage, err, log)
+}
+
+// UpdateOperation updates a given operation
+func (om *HibernationOperationManager) UpdateOperation(operation internal.HibernationOperation, update func(operation *internal.HibernationOperation), log logrus.FieldLogger) (internal.HibernationOperation, time.Duration, error) {
+	update(&operation)
+	updatedOperation, err := om.storage.UpdateHibernationOperation(operation)
+	switch {
+	case dberr.IsConflict(err):
+		{
+			op, err := om.storage.GetHibernationOperationByID(operation.Operation.ID)
+			if err != nil {
+				log.Errorf("while getting operation: %v", err)
+				return operation, 1 * time.Minute, err
+			}
+			update(op)
+			updatedOperation, err = om.storage.UpdateHibernationOperation(*op)
+			if err != nil {
+				log.Errorf("while updating operation after conflict: %v", err)
+				return operation, 1 * time.Minute, err
+			}
+		}
+	case err != nil:
+		log.Errorf("while updating operation: %v", err)
+		return operation, 1 * time.Minute, err
+	}
+	return *updatedOperation, 0, nil
+}
+
+func (om *HibernationOperationManager) update(operation internal.HibernationOperation, state domain.LastOperationState, description string, log logrus.FieldLogger) (internal.HibernationOperation, time.Duration, error) {
+	return om.UpdateOperation(operation, func(operation *internal.HibernationOperation) {
+		operation.State = state
+		operation.Description = description
+	}, log)
+}
@@ -239,6 +239,14 @@ func (r *RuntimeInput) CreateProvisionRuntimeInput() (gqlschema.ProvisionRuntime
 			name:    "configure DNS",
 			execute: r.configureDNS,
 		},
+		{
+			name:    "configure hibernation schedules",
+			execute: r.configureHibernationSchedules,
+		},
+		{
+			name:    "configure worker pools",
+			execute: r.configureWorkerPools,
+		},
 	} {
 		if err := step.execute(); err != nil {
 			return gqlschema.ProvisionRuntimeInput{}, errors.Wrapf(err, "while %s", step.name)
@@ -635,6 +643,76 @@ func (r *RuntimeInput) configureDNS() error {
 	return nil
 }
 
+func (r *RuntimeInput) configureHibernationSchedules() error {
+	if r.provisionRuntimeInput.ClusterConfig != nil {
+		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.HibernationSchedules = HibernationSchedulesInput(r.provisioningParameters.Parameters.HibernationSchedules)
+	}
+	return nil
+}
+
+// HibernationSchedulesInput converts hibernation schedules given in the parameters to the provisioner input,
+// nil is returned if the schedules are not provided
+func HibernationSchedulesInput(schedules []internal.HibernationScheduleDTO) []*gqlschema.HibernationScheduleInput {
+	if schedules == nil {
+		return nil
+	}
+	result := make([]*gqlschema.HibernationScheduleInput, 0, len(schedules))
+	for _, schedule := range schedules {
+		result = append(result, &gqlschema.HibernationScheduleInput{
+			Start:    nilIfEmpty(schedule.Start),
+			End:      nilIfEmpty(schedule.End),
+			Location: nilIfEmpty(schedule.Location),
+		})
+	}
+	return result
+}
+
+func (r *RuntimeInput) configureWorkerPools() error {
+	if r.provisionRuntimeInput.ClusterConfig != nil {
+		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.WorkerPools = WorkerPoolsInput(r.provisioningParameters.Parameters.WorkerPools)
+	}
+	return nil
+}
+
+// WorkerPoolsInput converts worker pools given in the parameters to the provisioner input,
+// nil is returned if the worker pools are not provided
+func WorkerPoolsInput(pools []internal.WorkerPoolDTO) []*gqlschema.WorkerPoolInput {
+	if pools == nil {
+		return nil
+	}
+	result := make([]*gqlschema.WorkerPoolInput, 0, len(pools))
+	for _, pool := range pools {
+		poolInput := &gqlschema.WorkerPoolInput{
+			Name:          pool.Name,
+			MachineType:   pool.MachineType,
+			AutoScalerMin: pool.AutoScalerMin,
+			AutoScalerMax: pool.AutoScalerMax,
+		}
+		if len(pool.Labels) > 0 {
+			poolInput.Labels = gqlschema.Labels{}
+			for key, value := range pool.Labels {
+				poolInput.Labels[key] = value
+			}
+		}
+		for _, taint := range pool.Taints {
+			poolInput.Taints = append(poolInput.Taints, &gqlschema.TaintInput{
+				Key:    taint.Key,
+				Value:  nilIfEmpty(taint.Value),
+				Effect: taint.Effect,
+			})
+		}
+		result = append(result, poolInput)
+	}
+	return result
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
 func (r *RuntimeInput) configureOIDC() error {
 	// set default or provided params to provisioning/update input (if exists)
 	// This method could be used for:
@@ -1036,6 +1036,82 @@ func TestCreateProvisionRuntimeInput_ConfigureAdmins(t *testing.T) {
 	})
 }
 
+func TestCreateProvisionRuntimeInput_ConfigureHibernationSchedules(t *testing.T) {
+	// given
+	id := uuid.New().String()
+
+	optComponentsSvc := dummyOptionalComponentServiceMock(fixKymaComponentList())
+	componentsProvider := &automock.ComponentListProvider{}
+	componentsProvider.On("AllComponents", mock.AnythingOfType("internal.RuntimeVersionData"), mock.AnythingOfType("string")).Return(fixKymaComponentList(), nil)
+
+	inputBuilder, err := NewInputBuilderFactory(optComponentsSvc, runtime.NewDisabledComponentsProvider(), componentsProvider,
+		Config{}, "1.24.0", fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO())
+	assert.NoError(t, err)
+
+	provisioningParams := fixture.FixProvisioningParameters(id)
+	provisioningParams.Parameters.HibernationSchedules = []internal.HibernationScheduleDTO{
+		{Start: "00 20 * * 1,2,3,4,5", Location: "Europe/Berlin"},
+	}
+
+	creator, err := inputBuilder.CreateProvisionInput(provisioningParams, internal.RuntimeVersionData{Version: "", Origin: internal.Defaults})
+	require.NoError(t, err)
+	setRuntimeProperties(creator)
+
+	// when
+	input, err := creator.CreateProvisionRuntimeInput()
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, []*gqlschema.HibernationScheduleInput{
+		{Start: ptr.String("00 20 * * 1,2,3,4,5"), Location: ptr.String("Europe/Berlin")},
+	}, input.ClusterConfig.GardenerConfig.HibernationSchedules)
+}
+
+func TestCreateProvisionRuntimeInput_ConfigureWorkerPools(t *testing.T) {
+	// given
+	id := uuid.New().String()
+
+	optComponentsSvc := dummyOptionalComponentServiceMock(fixKymaComponentList())
+	componentsProvider := &automock.ComponentListProvider{}
+	componentsProvider.On("AllComponents", mock.AnythingOfType("internal.RuntimeVersionData"), mock.AnythingOfType("string")).Return(fixKymaComponentList(), nil)
+
+	inputBuilder, err := NewInputBuilderFactory(optComponentsSvc, runtime.NewDisabledComponentsProvider(), componentsProvider,
+		Config{}, "1.24.0", fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO())
+	assert.NoError(t, err)
+
+	provisioningParams := fixture.FixProvisioningParameters(id)
+	provisioningParams.Parameters.WorkerPools = []internal.WorkerPoolDTO{
+		{
+			Name:          "gpu",
+			MachineType:   "n1-highmem-8",
+			AutoScalerMin: 0,
+			AutoScalerMax: 2,
+			Labels:        map[string]string{"accelerator": "gpu"},
+			Taints:        []internal.TaintDTO{{Key: "nvidia.com/gpu", Value: "present", Effect: "NoSchedule"}},
+		},
+	}
+
+	creator, err := inputBuilder.CreateProvisionInput(provisioningParams, internal.RuntimeVersionData{Version: "", Origin: internal.Defaults})
+	require.NoError(t, err)
+	setRuntimeProperties(creator)
+
+	// when
+	input, err := creator.CreateProvisionRuntimeInput()
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, []*gqlschema.WorkerPoolInput{
+		{
+			Name:          "gpu",
+			MachineType:   "n1-highmem-8",
+			AutoScalerMin: 0,
+			AutoScalerMax: 2,
+			Labels:        gqlschema.Labels{"accelerator": "gpu"},
+			Taints:        []*gqlschema.TaintInput{{Key: "nvidia.com/gpu", Value: ptr.String("present"), Effect: "NoSchedule"}},
+		},
+	}, input.ClusterConfig.GardenerConfig.WorkerPools)
+}
+
 func assertAllConfigsContainsGlobals(t *testing.T, components []reconcilerApi.Component, domainName string) {
 	for _, cmp := range components {
 		found := false
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/avs"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/sirupsen/logrus"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
@@ -12,14 +14,20 @@ import (
 
 type ExternalEvalStep struct {
 	externalEvalCreator *ExternalEvalCreator
+	timeLimit           time.Duration
+	maxRetries          int
 }
 
-// ensure the interface is implemented
+// ensure the interfaces are implemented
 var _ Step = (*ExternalEvalStep)(nil)
+var _ process.StepWithTimeLimit = (*ExternalEvalStep)(nil)
+var _ process.StepWithRetryLimit = (*ExternalEvalStep)(nil)
 
-func NewExternalEvalStep(externalEvalCreator *ExternalEvalCreator) *ExternalEvalStep {
+func NewExternalEvalStep(externalEvalCreator *ExternalEvalCreator, cfg avs.Config) *ExternalEvalStep {
 	return &ExternalEvalStep{
 		externalEvalCreator: externalEvalCreator,
+		timeLimit:           cfg.StepTimeLimit,
+		maxRetries:          cfg.StepMaxRetries,
 	}
 }
 
@@ -27,6 +35,14 @@ func (e ExternalEvalStep) Name() string {
 	return "AVS_Create_External_Eval_Step"
 }
 
+func (s *ExternalEvalStep) TimeLimit() time.Duration {
+	return s.timeLimit
+}
+
+func (s *ExternalEvalStep) MaxRetries() int {
+	return s.maxRetries
+}
+
 func (s *ExternalEvalStep) Run(operation internal.ProvisioningOperation, log logrus.FieldLogger) (internal.ProvisioningOperation, time.Duration, error) {
 	if broker.IsTrialPlan(operation.ProvisioningParameters.PlanID) || broker.IsFreemiumPlan(operation.ProvisioningParameters.PlanID) {
 		log.Debug("skipping AVS external evaluation creation for trial/freemium plan")
@@ -6,18 +6,27 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/avs"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/sirupsen/logrus"
 )
 
 type InternalEvaluationStep struct {
-	delegator *avs.Delegator
-	iec       *avs.InternalEvalAssistant
+	delegator  *avs.Delegator
+	iec        *avs.InternalEvalAssistant
+	timeLimit  time.Duration
+	maxRetries int
 }
 
-func NewInternalEvaluationStep(delegator *avs.Delegator, assistant *avs.InternalEvalAssistant) *InternalEvaluationStep {
+// ensure the interfaces are implemented
+var _ process.StepWithTimeLimit = (*InternalEvaluationStep)(nil)
+var _ process.StepWithRetryLimit = (*InternalEvaluationStep)(nil)
+
+func NewInternalEvaluationStep(delegator *avs.Delegator, assistant *avs.InternalEvalAssistant, cfg avs.Config) *InternalEvaluationStep {
 	return &InternalEvaluationStep{
-		delegator: delegator,
-		iec:       assistant,
+		delegator:  delegator,
+		iec:        assistant,
+		timeLimit:  cfg.StepTimeLimit,
+		maxRetries: cfg.StepMaxRetries,
 	}
 }
 
@@ -25,6 +34,14 @@ func (ies *InternalEvaluationStep) Name() string {
 	return "AVS_Create_Internal_Eval_Step"
 }
 
+func (ies *InternalEvaluationStep) TimeLimit() time.Duration {
+	return ies.timeLimit
+}
+
+func (ies *InternalEvaluationStep) MaxRetries() int {
+	return ies.maxRetries
+}
+
 func (ies *InternalEvaluationStep) Run(operation internal.ProvisioningOperation, logger logrus.FieldLogger) (internal.ProvisioningOperation, time.Duration, error) {
 	return ies.delegator.CreateEvaluation(logger, operation, ies.iec, "")
 }
@@ -53,7 +53,7 @@ func TestInternalEvaluationStep_Run(t *testing.T) {
 	assert.NoError(t, err)
 	avsDel := avs.NewDelegator(avsClient, avsConfig, memoryStorage.Operations())
 	internalEvalAssistant := avs.NewInternalEvalAssistant(avsConfig)
-	ies := NewInternalEvaluationStep(avsDel, internalEvalAssistant)
+	ies := NewInternalEvaluationStep(avsDel, internalEvalAssistant, avsConfig)
 
 	// when
 	logger := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -92,7 +92,7 @@ func TestInternalEvaluationStep_WhenOperationIsRepeatedWithIdPresent(t *testing.
 	assert.NoError(t, err)
 	avsDel := avs.NewDelegator(avsClient, avsConfig, memoryStorage.Operations())
 	internalEvalAssistant := avs.NewInternalEvalAssistant(avsConfig)
-	ies := NewInternalEvaluationStep(avsDel, internalEvalAssistant)
+	ies := NewInternalEvaluationStep(avsDel, internalEvalAssistant, avsConfig)
 
 	// when
 	logger := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -249,3 +249,23 @@ func generateId() (int64, int64) {
 	id := time.Now().Unix()
 	return timeUnixEpoch, id
 }
+
+func TestAvsSteps_LimitsFromConfig(t *testing.T) {
+	// given
+	cfg := avs.Config{StepTimeLimit: 15 * time.Minute, StepMaxRetries: 30}
+
+	for name, step := range map[string]interface {
+		TimeLimit() time.Duration
+		MaxRetries() int
+	}{
+		"internal evaluation": NewInternalEvaluationStep(nil, nil, cfg),
+		"external evaluation": NewExternalEvalStep(nil, cfg),
+		"runtime tags":        NewRuntimeTagsStep(NewInternalEvalUpdater(nil, nil, cfg), nil),
+	} {
+		t.Run(name, func(t *testing.T) {
+			// then
+			assert.Equal(t, 15*time.Minute, step.TimeLimit())
+			assert.Equal(t, 30, step.MaxRetries())
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/avs"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 )
@@ -16,8 +17,10 @@ type RuntimeTagsStep struct {
 	provisionerClient   provisioner.Client
 }
 
-// ensure the interface is implemented
+// ensure the interfaces are implemented
 var _ Step = (*RuntimeTagsStep)(nil)
+var _ process.StepWithTimeLimit = (*RuntimeTagsStep)(nil)
+var _ process.StepWithRetryLimit = (*RuntimeTagsStep)(nil)
 
 func NewRuntimeTagsStep(internalEvalUpdater *InternalEvalUpdater, provisionerClient provisioner.Client) *RuntimeTagsStep {
 	return &RuntimeTagsStep{
@@ -29,6 +32,14 @@ func (e *RuntimeTagsStep) Name() string {
 	return "AVS_Tags"
 }
 
+func (s *RuntimeTagsStep) TimeLimit() time.Duration {
+	return s.internalEvalUpdater.avsConfig.StepTimeLimit
+}
+
+func (s *RuntimeTagsStep) MaxRetries() int {
+	return s.internalEvalUpdater.avsConfig.StepMaxRetries
+}
+
 func (s *RuntimeTagsStep) Run(operation internal.ProvisioningOperation, log logrus.FieldLogger) (internal.ProvisioningOperation, time.Duration, error) {
 	status, err := s.provisionerClient.RuntimeStatus(operation.ProvisioningParameters.ErsContext.GlobalAccountID, operation.RuntimeID)
 	if err != nil {
@@ -3,7 +3,6 @@ package provisioning
 import (
 	"context"
 	"fmt"
-	"sync"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
@@ -21,13 +20,9 @@ type StagedManager struct {
 	log              logrus.FieldLogger
 	operationStorage storage.Operations
 	publisher        event.Publisher
+	engine           *process.StagedEngine[internal.ProvisioningOperation]
 
-	stages           []*stage
 	operationTimeout time.Duration
-
-	mu sync.RWMutex
-
-	speedFactor int64
 }
 
 type Step interface {
@@ -35,24 +30,13 @@ type Step interface {
 	Run(operation internal.ProvisioningOperation, logger logrus.FieldLogger) (internal.ProvisioningOperation, time.Duration, error)
 }
 
-type StepCondition func(operation internal.ProvisioningOperation) bool
-
-type StepWithCondition struct {
-	Step
-	condition StepCondition
-}
+// StepWithTimeLimit is implemented by steps which must finish within the time limit, counted from the first run of the step
+type StepWithTimeLimit = process.StepWithTimeLimit
 
-type stage struct {
-	name  string
-	steps []StepWithCondition
-}
+// StepWithRetryLimit is implemented by steps which can be retried only the given number of times
+type StepWithRetryLimit = process.StepWithRetryLimit
 
-func (s *stage) AddStep(step Step, cnd StepCondition) {
-	s.steps = append(s.steps, StepWithCondition{
-		Step:      step,
-		condition: cnd,
-	})
-}
+type StepCondition func(operation internal.ProvisioningOperation) bool
 
 func NewStagedManager(storage storage.Operations, pub event.Publisher, operationTimeout time.Duration, logger logrus.FieldLogger) *StagedManager {
 	return &StagedManager{
@@ -60,39 +44,48 @@ func NewStagedManager(storage storage.Operations, pub event.Publisher, operation
 		operationStorage: storage,
 		publisher:        pub,
 		operationTimeout: operationTimeout,
-		speedFactor:      1,
+		engine: process.NewStagedEngine(process.StagedEngineConfig[internal.ProvisioningOperation]{
+			Operation: func(op *internal.ProvisioningOperation) *internal.Operation {
+				return &op.Operation
+			},
+			Update: storage.UpdateProvisioningOperation,
+			Steps:  storage,
+			StepProcessedEvent: func(_, processed internal.ProvisioningOperation, step process.StepProcessed) interface{} {
+				return process.ProvisioningStepProcessed{
+					Operation:     processed,
+					StepProcessed: step,
+				}
+			},
+			RetryInPlace:       10 * time.Minute,
+			SaveFinishedStages: true,
+			SaveLastError:      true,
+			IsFinished: func(op internal.ProvisioningOperation) bool {
+				return op.State == domain.Failed || op.State == domain.Succeeded
+			},
+		}, pub),
 	}
 }
 
 // SpeedUp changes speedFactor parameter to reduce the sleep time if a step needs a retry.
 // This method should only be used for testing purposes
 func (m *StagedManager) SpeedUp(speedFactor int64) {
-	m.speedFactor = speedFactor
+	m.engine.SpeedUp(speedFactor)
 }
 
 func (m *StagedManager) DefineStages(names []string) {
-	m.stages = make([]*stage, len(names))
-	for i, n := range names {
-		m.stages[i] = &stage{name: n, steps: []StepWithCondition{}}
-	}
+	m.engine.DefineStages(names)
 }
 
 func (m *StagedManager) AddStep(stageName string, step Step, cnd StepCondition) error {
-	for _, s := range m.stages {
-		if s.name == stageName {
-			s.AddStep(step, cnd)
-			return nil
-		}
-	}
-	return fmt.Errorf("Stage %s not defined", stageName)
+	return m.engine.AddStep(stageName, step, process.StepCondition[internal.ProvisioningOperation](cnd))
 }
 
 func (m *StagedManager) GetAllStages() []string {
-	var all []string
-	for _, s := range m.stages {
-		all = append(all, s.name)
-	}
-	return all
+	return m.engine.GetAllStages()
+}
+
+func (m *StagedManager) GetAllSteps() []string {
+	return m.engine.GetAllSteps()
 }
 
 func (m *StagedManager) Execute(operationID string) (time.Duration, error) {
@@ -104,12 +97,12 @@ func (m *StagedManager) Execute(operationID string) (time.Duration, error) {
 
 	logOperation := m.log.WithFields(logrus.Fields{"operation": operationID, "instanceID": operation.InstanceID, "planID": operation.ProvisioningParameters.PlanID})
 	logOperation.Infof("Start process operation steps for GlobalAcocunt=%s, ", operation.ProvisioningParameters.ErsContext.GlobalAccountID)
-	if time.Since(operation.CreatedAt) > m.operationTimeout {
+	if time.Since(operation.TimeoutBase()) > m.operationTimeout {
 		timeoutErr := kebError.TimeoutError("operation has reached the time limit")
 		operation.LastError = timeoutErr
 		defer m.callPubSubOutsideSteps(operation, timeoutErr)
 
-		logOperation.Infof("operation has reached the time limit: operation was created at: %s", operation.CreatedAt)
+		logOperation.Infof("operation has reached the time limit: operation was created or resumed at: %s", operation.TimeoutBase())
 		operation.State = domain.Failed
 		_, err = m.operationStorage.UpdateProvisioningOperation(*operation)
 		if err != nil {
@@ -122,110 +115,34 @@ func (m *StagedManager) Execute(operationID string) (time.Duration, error) {
 		return 0, timeoutErr
 	}
 
-	var when time.Duration
-	processedOperation := *operation
-
-	for _, stage := range m.stages {
-		if processedOperation.IsStageFinished(stage.name) {
-			continue
-		}
-
-		for _, step := range stage.steps {
-			logStep := logOperation.WithField("step", step.Name()).
-				WithField("stage", stage.name)
-			if step.condition != nil && !step.condition(processedOperation) {
-				logStep.Debugf("Skipping")
-				continue
-			}
-
-			processedOperation, when, err = m.runStep(step, processedOperation, logStep)
-			if err != nil {
-				logStep.Errorf("Process operation failed: %s", err)
-				return 0, err
-			}
-			if processedOperation.State == domain.Failed || processedOperation.State == domain.Succeeded {
-				logStep.Infof("Operation %q got status %s. Process finished.", operation.ID, processedOperation.State)
-				return 0, nil
-			}
-
-			// the step needs a retry
-			if when > 0 {
-				return when, nil
-			}
-		}
-
-		processedOperation, err = m.saveFinishedStage(processedOperation, stage, logOperation)
-		if err != nil {
-			return time.Second, nil
-		}
+	processedOperation, when, err := m.engine.Run(*operation, logOperation)
+	if err != nil {
+		return 0, err
+	}
+	if processedOperation.State == domain.Failed || processedOperation.State == domain.Succeeded {
+		return 0, nil
+	}
+	// the step needs a retry
+	if when > 0 {
+		return when, nil
 	}
 
 	logOperation.Infof("Operation succeeded")
 
 	processedOperation.State = domain.Succeeded
-	m.publisher.Publish(context.TODO(), process.ProvisioningSucceeded{
-		Operation: processedOperation,
-	})
-
 	_, err = m.operationStorage.UpdateProvisioningOperation(processedOperation)
 	if err != nil {
 		logOperation.Infof("Unable to save operation with finished the provisioning process")
 		return time.Second, err
 	}
+	// the event is published only once the state is saved, the failed update is retried and would publish it again
+	m.publisher.Publish(context.TODO(), process.ProvisioningSucceeded{
+		Operation: processedOperation,
+	})
 
 	return 0, nil
 }
 
-func (m *StagedManager) saveFinishedStage(operation internal.ProvisioningOperation, s *stage, log logrus.FieldLogger) (internal.ProvisioningOperation, error) {
-	operation.FinishStage(s.name)
-	op, err := m.operationStorage.UpdateProvisioningOperation(operation)
-	if err != nil {
-		log.Infof("Unable to save operation with finished stage %s: %s", s.name, err.Error())
-		return operation, err
-	}
-	log.Infof("Finished stage %s", s.name)
-	return *op, nil
-}
-
-func (m *StagedManager) runStep(step Step, operation internal.ProvisioningOperation, logger logrus.FieldLogger) (internal.ProvisioningOperation, time.Duration, error) {
-	begin := time.Now()
-	for {
-		start := time.Now()
-		logger.Infof("Start step")
-		processedOperation, when, err := step.Run(operation, logger)
-
-		if err != nil {
-			processedOperation.LastError = kebError.ReasonForError(err)
-			logOperation := m.log.WithFields(logrus.Fields{"operation": processedOperation.Operation.ID, "error_component": processedOperation.LastError.Component(), "error_reason": processedOperation.LastError.Reason()})
-			logOperation.Errorf("Last error from step %s: %s", step.Name(), processedOperation.LastError.Error())
-			// only save to storage, skip for alerting if error
-			_, err = m.operationStorage.UpdateProvisioningOperation(processedOperation)
-			if err != nil {
-				logOperation.Errorf("Unable to save operation with resolved last error from step: %s", step.Name())
-			}
-		}
-
-		m.publisher.Publish(context.TODO(), process.ProvisioningStepProcessed{
-			Operation: processedOperation,
-			StepProcessed: process.StepProcessed{
-				StepName: step.Name(),
-				Duration: time.Since(start),
-				When:     when,
-				Error:    err,
-			},
-		})
-
-		// break the loop if:
-		// - the step does not need a retry
-		// - step returns an error
-		// - the loop takes too much time (to not block the worker too long)
-		if when == 0 || err != nil || time.Since(begin) > 10*time.Minute {
-			return processedOperation, when, err
-		}
-		time.Sleep(when / time.Duration(m.speedFactor))
-	}
-}
-
 func (m *StagedManager) callPubSubOutsideSteps(operation *internal.ProvisioningOperation, err error) {
 	logOperation := m.log.WithFields(logrus.Fields{"operation": operation.Operation.ID, "error_component": operation.LastError.Component(), "error_reason": operation.LastError.Reason()})
 	logOperation.Errorf("Last error: %s", operation.LastError.Error())
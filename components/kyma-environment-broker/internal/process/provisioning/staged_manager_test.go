@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
@@ -87,6 +88,65 @@ func TestWithRetry(t *testing.T) {
 	assert.True(t, op.IsStageFinished("stage-2"))
 }
 
+func TestStepWithRetryLimit(t *testing.T) {
+	// given
+	operation := FixProvisionOperation("op-0001234")
+	mgr, operationStorage, eventCollector := SetupStagedManager(operation)
+	mgr.AddStep("stage-1", &limitedStep{name: "limited", maxRetries: 3, eventPublisher: eventCollector}, nil)
+	mgr.AddStep("stage-1", &testingStep{name: "second", eventPublisher: eventCollector}, nil)
+
+	// when
+	retry, _ := mgr.Execute(operation.ID)
+
+	// then
+	assert.Zero(t, retry)
+	eventCollector.AssertProcessedSteps(t, []string{"limited", "limited", "limited", "limited"})
+	op, _ := operationStorage.GetProvisioningOperationByID(operation.ID)
+	assert.Equal(t, domain.Failed, op.State)
+	assert.Equal(t, kebError.ErrKEBStepRetriesExceeded, op.LastError.Reason())
+	assert.Equal(t, 4, op.StepAttempts["limited"].Count)
+}
+
+func TestStepWithTimeLimit(t *testing.T) {
+	// given
+	operation := FixProvisionOperation("op-0001234")
+	operation.StepAttempts = map[string]internal.StepAttempts{
+		"limited": {Count: 10, StartedAt: time.Now().Add(-time.Hour)},
+	}
+	mgr, operationStorage, eventCollector := SetupStagedManager(operation)
+	mgr.AddStep("stage-1", &limitedStep{name: "limited", timeLimit: time.Minute, eventPublisher: eventCollector}, nil)
+
+	// when
+	retry, _ := mgr.Execute(operation.ID)
+
+	// then
+	assert.Zero(t, retry)
+	eventCollector.AssertProcessedSteps(t, []string{"limited"})
+	op, _ := operationStorage.GetProvisioningOperationByID(operation.ID)
+	assert.Equal(t, domain.Failed, op.State)
+	assert.Equal(t, kebError.ErrKEBStepTimeOut, op.LastError.Reason())
+}
+
+func TestStepWithError(t *testing.T) {
+	// given
+	operation := FixProvisionOperation("op-0001234")
+	mgr, operationStorage, eventCollector := SetupStagedManager(operation)
+	mgr.AddStep("stage-1", &failingStep{name: "failing", eventPublisher: eventCollector}, nil)
+	mgr.AddStep("stage-1", &testingStep{name: "second", eventPublisher: eventCollector}, nil)
+
+	// when
+	retry, err := mgr.Execute(operation.ID)
+
+	// then
+	assert.Error(t, err)
+	assert.Zero(t, retry)
+	eventCollector.AssertProcessedSteps(t, []string{"failing"})
+	op, _ := operationStorage.GetProvisioningOperationByID(operation.ID)
+	assert.Equal(t, domain.Failed, op.State)
+	assert.Equal(t, "step failing failed: unexpected error", op.Description)
+	assert.False(t, op.IsStageFinished("stage-1"))
+}
+
 func TestSkipFinishedStage(t *testing.T) {
 	// given
 	operation := FixProvisionOperation("op-0001234")
@@ -137,6 +197,40 @@ func (s *testingStep) Run(operation internal.ProvisioningOperation, logger logru
 	return operation, 0, nil
 }
 
+type limitedStep struct {
+	name           string
+	maxRetries     int
+	timeLimit      time.Duration
+	eventPublisher event.Publisher
+}
+
+func (s *limitedStep) Name() string {
+	return s.name
+}
+func (s *limitedStep) MaxRetries() int {
+	return s.maxRetries
+}
+func (s *limitedStep) TimeLimit() time.Duration {
+	return s.timeLimit
+}
+func (s *limitedStep) Run(operation internal.ProvisioningOperation, logger logrus.FieldLogger) (internal.ProvisioningOperation, time.Duration, error) {
+	s.eventPublisher.Publish(context.Background(), s.name)
+	return operation, time.Second, nil
+}
+
+type failingStep struct {
+	name           string
+	eventPublisher event.Publisher
+}
+
+func (s *failingStep) Name() string {
+	return s.name
+}
+func (s *failingStep) Run(operation internal.ProvisioningOperation, logger logrus.FieldLogger) (internal.ProvisioningOperation, time.Duration, error) {
+	s.eventPublisher.Publish(context.Background(), s.name)
+	return operation, 0, fmt.Errorf("unexpected error")
+}
+
 type onceRetryingStep struct {
 	name           string
 	processed      bool
@@ -14,8 +14,18 @@ type Executor interface {
 	Execute(operationID string) (time.Duration, error)
 }
 
+// queueInterface is the subset of workqueue.RateLimitingInterface used by the Queue
+type queueInterface interface {
+	Add(item interface{})
+	AddAfter(item interface{}, duration time.Duration)
+	Get() (item interface{}, shutdown bool)
+	Done(item interface{})
+	Forget(item interface{})
+	ShutDown()
+}
+
 type Queue struct {
-	queue     workqueue.RateLimitingInterface
+	queue     queueInterface
 	executor  Executor
 	waitGroup sync.WaitGroup
 	log       logrus.FieldLogger
@@ -38,6 +48,16 @@ func (q *Queue) Add(processId string) {
 	q.queue.Add(processId)
 }
 
+// Resume adds the process to the queue unless it is already there. It is used to take up processes,
+// which were in progress before the application restarted.
+func (q *Queue) Resume(processId string) {
+	if durable, ok := q.queue.(*durableQueue); ok {
+		durable.addIfAbsent(processId)
+		return
+	}
+	q.queue.Add(processId)
+}
+
 func (q *Queue) AddAfter(processId string, duration time.Duration) {
 	q.queue.AddAfter(processId, duration)
 }
@@ -53,20 +73,36 @@ func (q *Queue) Run(stop <-chan struct{}, workersAmount int) {
 	}
 }
 
+// RunAfter starts the workers once the ready channel is closed, e.g. when the replica is elected as the leader.
+// A nil channel starts the workers immediately.
+func (q *Queue) RunAfter(ready <-chan struct{}, stop <-chan struct{}, workersAmount int) {
+	if ready == nil {
+		q.Run(stop, workersAmount)
+		return
+	}
+	go func() {
+		select {
+		case <-ready:
+			q.Run(stop, workersAmount)
+		case <-stop:
+		}
+	}()
+}
+
 // SpeedUp changes speedFactor parameter to reduce time between processing operations.
 //This method should only be used for testing purposes
 func (q *Queue) SpeedUp(speedFactor int64) {
 	q.speedFactor = speedFactor
 }
 
-func (q *Queue) createWorker(queue workqueue.RateLimitingInterface, process func(id string) (time.Duration, error), stopCh <-chan struct{}, waitGroup *sync.WaitGroup, log logrus.FieldLogger) {
+func (q *Queue) createWorker(queue queueInterface, process func(id string) (time.Duration, error), stopCh <-chan struct{}, waitGroup *sync.WaitGroup, log logrus.FieldLogger) {
 	go func() {
 		wait.Until(q.worker(queue, process, log), time.Second, stopCh)
 		waitGroup.Done()
 	}()
 }
 
-func (q *Queue) worker(queue workqueue.RateLimitingInterface, process func(key string) (time.Duration, error), log logrus.FieldLogger) func() {
+func (q *Queue) worker(queue queueInterface, process func(key string) (time.Duration, error), log logrus.FieldLogger) func() {
 	return func() {
 		exit := false
 		for !exit {
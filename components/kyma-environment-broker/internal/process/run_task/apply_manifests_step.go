@@ -0,0 +1,76 @@
+package run_task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const applyManifestsTimeout = 10 * time.Minute
+
+type ApplyManifestsStep struct {
+	operationManager *process.RunTaskOperationManager
+	timeSchedule     TimeSchedule
+}
+
+func NewApplyManifestsStep(os storage.Operations, timeSchedule *TimeSchedule) *ApplyManifestsStep {
+	return &ApplyManifestsStep{
+		operationManager: process.NewRunTaskOperationManager(os),
+		timeSchedule:     timeScheduleOrDefault(timeSchedule),
+	}
+}
+
+var _ Step = (*ApplyManifestsStep)(nil)
+
+func (s *ApplyManifestsStep) Name() string {
+	return "Apply_Task_Manifests"
+}
+
+func (s *ApplyManifestsStep) Run(operation internal.RunTaskOperation, log logrus.FieldLogger) (internal.RunTaskOperation, time.Duration, error) {
+	if operation.ManifestsApplied {
+		return operation, 0, nil
+	}
+
+	task, err := ParseTask(operation.Task, s.timeSchedule.TaskTimeout)
+	if err != nil {
+		return s.operationManager.OperationFailed(operation, "invalid task", err, log)
+	}
+
+	for _, obj := range task.Manifests {
+		err := applyObject(operation.K8sClient, obj.DeepCopy())
+		if err != nil {
+			msg := fmt.Sprintf("while applying %s %s", obj.GetKind(), client.ObjectKeyFromObject(obj))
+			log.Errorf("%s: %s", msg, err)
+			return s.operationManager.RetryOperation(operation, msg, err, s.timeSchedule.Retry, applyManifestsTimeout, log)
+		}
+		log.Infof("Applied %s %s", obj.GetKind(), client.ObjectKeyFromObject(obj))
+	}
+
+	return s.operationManager.UpdateOperation(operation, func(op *internal.RunTaskOperation) {
+		op.ManifestsApplied = true
+	}, log)
+}
+
+// applyObject creates the object or updates it if it already exists
+func applyObject(cli client.Client, obj *unstructured.Unstructured) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+	err := cli.Get(context.Background(), client.ObjectKeyFromObject(obj), existing)
+	switch {
+	case apiErrors.IsNotFound(err):
+		return cli.Create(context.Background(), obj)
+	case err != nil:
+		return err
+	}
+
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	return cli.Update(context.Background(), obj)
+}
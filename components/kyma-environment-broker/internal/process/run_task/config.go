@@ -0,0 +1,22 @@
+package run_task
+
+import "time"
+
+type TimeSchedule struct {
+	Retry       time.Duration
+	StatusCheck time.Duration
+	// TaskTimeout is the time of waiting for the Job if the task does not set its timeout
+	TaskTimeout time.Duration
+}
+
+// timeScheduleOrDefault returns the given time schedule or the default one if it is not set
+func timeScheduleOrDefault(timeSchedule *TimeSchedule) TimeSchedule {
+	if timeSchedule == nil {
+		return TimeSchedule{
+			Retry:       5 * time.Second,
+			StatusCheck: time.Minute,
+			TaskTimeout: time.Hour,
+		}
+	}
+	return *timeSchedule
+}
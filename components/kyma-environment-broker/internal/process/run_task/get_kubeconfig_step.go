@@ -0,0 +1,59 @@
+package run_task
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type GetKubeconfigStep struct {
+	provisionerClient provisioner.Client
+	operationManager  *process.RunTaskOperationManager
+	k8sClientProvider func(kcfg string) (client.Client, error)
+}
+
+func NewGetKubeconfigStep(os storage.Operations, provisionerClient provisioner.Client, k8sClientProvider func(kcfg string) (client.Client, error)) *GetKubeconfigStep {
+	return &GetKubeconfigStep{
+		provisionerClient: provisionerClient,
+		operationManager:  process.NewRunTaskOperationManager(os),
+		k8sClientProvider: k8sClientProvider,
+	}
+}
+
+var _ Step = (*GetKubeconfigStep)(nil)
+
+func (s *GetKubeconfigStep) Name() string {
+	return "Get_Kubeconfig"
+}
+
+func (s *GetKubeconfigStep) Run(operation internal.RunTaskOperation, log logrus.FieldLogger) (internal.RunTaskOperation, time.Duration, error) {
+	if operation.RuntimeOperation.RuntimeID == "" {
+		log.Errorf("Runtime ID is empty")
+		return s.operationManager.OperationFailed(operation, "Runtime ID is empty", nil, log)
+	}
+
+	status, err := s.provisionerClient.RuntimeStatus(operation.RuntimeOperation.GlobalAccountID, operation.RuntimeOperation.RuntimeID)
+	if err != nil {
+		log.Errorf("call to provisioner RuntimeStatus failed: %s", err.Error())
+		return s.operationManager.RetryOperation(operation, "call to provisioner RuntimeStatus failed", err, 1*time.Minute, 10*time.Minute, log)
+	}
+
+	if status.RuntimeConfiguration == nil || status.RuntimeConfiguration.Kubeconfig == nil || len(*status.RuntimeConfiguration.Kubeconfig) < 10 {
+		log.Errorf("kubeconfig is not provided")
+		return s.operationManager.RetryOperation(operation, "kubeconfig is not provided", nil, 1*time.Minute, 10*time.Minute, log)
+	}
+	cli, err := s.k8sClientProvider(*status.RuntimeConfiguration.Kubeconfig)
+	if err != nil {
+		log.Errorf("Unable to create k8s client from the kubeconfig")
+		return s.operationManager.OperationFailed(operation, "could not create a k8s client", err, log)
+	}
+	operation.Kubeconfig = *status.RuntimeConfiguration.Kubeconfig
+	operation.K8sClient = cli
+
+	return operation, 0, nil
+}
@@ -0,0 +1,67 @@
+package run_task
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	provisionerAutomock "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner/automock"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const fixRuntimeID = "runtime-id"
+
+func TestGetKubeconfigStep_Run(t *testing.T) {
+	for tn, tc := range map[string]struct {
+		status gqlschema.RuntimeStatus
+		err    error
+	}{
+		"provisioner call fails": {
+			err: errors.New("provisioner is unavailable"),
+		},
+		"kubeconfig is not provided": {
+			status: gqlschema.RuntimeStatus{RuntimeConfiguration: &gqlschema.RuntimeConfig{}},
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			log := logrus.New()
+			memoryStorage := storage.NewMemoryStorage()
+			operation := fixRunTaskOperation(nil)
+			operation.RuntimeOperation.Runtime.RuntimeID = fixRuntimeID
+			err := memoryStorage.Operations().InsertRunTaskOperation(operation)
+			require.NoError(t, err)
+
+			provisionerClient := &provisionerAutomock.Client{}
+			provisionerClient.On("RuntimeStatus", operation.RuntimeOperation.GlobalAccountID, fixRuntimeID).Return(tc.status, tc.err)
+			step := NewGetKubeconfigStep(memoryStorage.Operations(), provisionerClient, func(kcfg string) (client.Client, error) {
+				return fake.NewClientBuilder().Build(), nil
+			})
+
+			// when
+			operation, repeat, err := step.Run(operation, log)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, time.Minute, repeat)
+
+			// given
+			operation.UpdatedAt = time.Now().Add(-11 * time.Minute)
+
+			// when
+			operation, repeat, err = step.Run(operation, log)
+
+			// then
+			assert.Error(t, err)
+			assert.Zero(t, repeat)
+			assert.Equal(t, orchestration.Failed, string(operation.State))
+		})
+	}
+}
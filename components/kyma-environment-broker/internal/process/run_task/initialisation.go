@@ -0,0 +1,84 @@
+package run_task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+)
+
+type InitialisationStep struct {
+	operationManager     *process.RunTaskOperationManager
+	operationStorage     storage.Operations
+	orchestrationStorage storage.Orchestrations
+	timeSchedule         TimeSchedule
+}
+
+func NewInitialisationStep(os storage.Operations, ors storage.Orchestrations, timeSchedule *TimeSchedule) *InitialisationStep {
+	return &InitialisationStep{
+		operationManager:     process.NewRunTaskOperationManager(os),
+		operationStorage:     os,
+		orchestrationStorage: ors,
+		timeSchedule:         timeScheduleOrDefault(timeSchedule),
+	}
+}
+
+func (s *InitialisationStep) Name() string {
+	return "Run_Task_Initialisation"
+}
+
+func (s *InitialisationStep) Run(operation internal.RunTaskOperation, log logrus.FieldLogger) (internal.RunTaskOperation, time.Duration, error) {
+	// Check concurrent deprovisioning (or suspension) operation (launched after target resolution)
+	lastOp, err := s.operationStorage.GetLastOperation(operation.InstanceID)
+	if err != nil {
+		return operation, s.timeSchedule.Retry, nil
+	}
+	if lastOp.Type == internal.OperationTypeDeprovision {
+		return s.operationManager.OperationCanceled(operation, fmt.Sprintf("operation preempted by deprovisioning %s", lastOp.ID), log)
+	}
+
+	if operation.State != orchestration.Pending {
+		return operation, 0, nil
+	}
+
+	// Check if the orchestration got cancelled, don't start new pending operation
+	o, err := s.orchestrationStorage.GetByID(operation.OrchestrationID)
+	if err != nil {
+		return operation, s.timeSchedule.Retry, nil
+	}
+	if o.IsCanceled() {
+		log.Infof("Skipping processing because orchestration %s was canceled", operation.OrchestrationID)
+		return s.operationManager.OperationCanceled(operation, fmt.Sprintf("orchestration %s was canceled", operation.OrchestrationID), log)
+	}
+	if o.IsPaused() {
+		log.Infof("Postponing processing because orchestration %s is paused", operation.OrchestrationID)
+		return operation, s.timeSchedule.StatusCheck, nil
+	}
+
+	// Wait for the operations which change the runtime to finish before the task is run
+	switch lastOp.Type {
+	case internal.OperationTypeProvision, internal.OperationTypeUpdate, internal.OperationTypeUpgradeKyma, internal.OperationTypeUpgradeCluster:
+		if !lastOp.IsFinished() {
+			return operation, s.timeSchedule.StatusCheck, nil
+		}
+	}
+
+	op, delay, _ := s.operationManager.UpdateOperation(operation, func(op *internal.RunTaskOperation) {
+		op.ProvisioningParameters.ErsContext = internal.UpdateERSContext(op.ProvisioningParameters.ErsContext, lastOp.ProvisioningParameters.ErsContext)
+		op.State = domain.InProgress
+	}, log)
+	if delay != 0 {
+		return operation, delay, nil
+	}
+
+	if op.DryRun {
+		return s.operationManager.OperationSucceeded(op, "dry run: the task was not run in the runtime", log)
+	}
+
+	return op, 0, nil
+}
@@ -0,0 +1,110 @@
+package run_task
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+type Step interface {
+	Name() string
+	Run(operation internal.RunTaskOperation, logger logrus.FieldLogger) (internal.RunTaskOperation, time.Duration, error)
+}
+
+type Manager struct {
+	log              logrus.FieldLogger
+	engine           *process.StagedEngine[internal.RunTaskOperation]
+	operationStorage storage.Operations
+
+	publisher event.Publisher
+}
+
+func NewManager(storage storage.Operations, pub event.Publisher, logger logrus.FieldLogger) *Manager {
+	return &Manager{
+		log: logger,
+		engine: process.NewStagedEngine(process.StagedEngineConfig[internal.RunTaskOperation]{
+			Operation: func(op *internal.RunTaskOperation) *internal.Operation {
+				return &op.Operation
+			},
+			Update: storage.UpdateRunTaskOperation,
+			Steps:  storage,
+			StepProcessedEvent: func(old, processed internal.RunTaskOperation, step process.StepProcessed) interface{} {
+				return process.RunTaskStepProcessed{
+					OldOperation:  old,
+					Operation:     processed,
+					StepProcessed: step,
+				}
+			},
+		}, pub),
+		operationStorage: storage,
+		publisher:        pub,
+	}
+}
+
+func (m *Manager) InitStep(step Step) {
+	m.AddStep(0, step)
+}
+
+func (m *Manager) AddStep(weight int, step Step) {
+	if weight <= 0 {
+		weight = 1
+	}
+	m.engine.AddWeightedStep(weight, step, nil)
+}
+
+func (m *Manager) GetAllStages() []string {
+	return m.engine.GetAllStages()
+}
+
+func (m *Manager) GetAllSteps() []string {
+	return m.engine.GetAllSteps()
+}
+
+func (m *Manager) Execute(operationID string) (time.Duration, error) {
+	op, err := m.operationStorage.GetRunTaskOperationByID(operationID)
+	if err != nil {
+		m.log.Errorf("Cannot fetch operation from storage: %s", err)
+		return 3 * time.Second, nil
+	}
+	operation := *op
+	if operation.IsFinished() {
+		return 0, nil
+	}
+
+	logOperation := m.log.WithFields(logrus.Fields{"operation": operationID, "instanceID": operation.InstanceID})
+
+	logOperation.Info("Start process operation steps")
+	operation, when, err := m.engine.Run(operation, logOperation)
+	if err != nil {
+		return 0, err
+	}
+	if operation.IsFinished() {
+		return 0, nil
+	}
+	if when > 0 {
+		return when, nil
+	}
+
+	logOperation.Infof("Operation %q got status %s. All steps finished.", operation.Operation.ID, operation.State)
+	return 0, nil
+}
+
+func (m Manager) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
+	op, err := m.operationStorage.GetRunTaskOperationByID(operationID)
+	if err != nil {
+		m.log.Errorf("Cannot fetch operation %s from storage: %s", operationID, err)
+		return err
+	}
+	op.MaintenanceWindowBegin = maintenanceWindowBegin
+	op.MaintenanceWindowEnd = maintenanceWindowEnd
+	op, err = m.operationStorage.UpdateRunTaskOperation(*op)
+	if err != nil {
+		m.log.Errorf("Cannot update (reschedule) operation %s in storage: %s", operationID, err)
+	}
+
+	return err
+}
@@ -0,0 +1,126 @@
+package run_task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	coreV1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const createJobTimeout = 10 * time.Minute
+
+type RunJobStep struct {
+	operationManager *process.RunTaskOperationManager
+	timeSchedule     TimeSchedule
+}
+
+func NewRunJobStep(os storage.Operations, timeSchedule *TimeSchedule) *RunJobStep {
+	return &RunJobStep{
+		operationManager: process.NewRunTaskOperationManager(os),
+		timeSchedule:     timeScheduleOrDefault(timeSchedule),
+	}
+}
+
+var _ Step = (*RunJobStep)(nil)
+
+func (s *RunJobStep) Name() string {
+	return "Run_Task_Job"
+}
+
+func (s *RunJobStep) Run(operation internal.RunTaskOperation, log logrus.FieldLogger) (internal.RunTaskOperation, time.Duration, error) {
+	task, err := ParseTask(operation.Task, s.timeSchedule.TaskTimeout)
+	if err != nil {
+		return s.operationManager.OperationFailed(operation, "invalid task", err, log)
+	}
+	if task.Job == nil {
+		return s.operationManager.OperationSucceeded(operation, fmt.Sprintf("%d objects applied", len(task.Manifests)), log)
+	}
+
+	if operation.JobName == "" {
+		return s.createJob(operation, task.Job, log)
+	}
+
+	key := client.ObjectKey{Namespace: operation.JobNamespace, Name: operation.JobName}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(batchv1.SchemeGroupVersion.WithKind("Job"))
+	err = operation.K8sClient.Get(context.Background(), key, obj)
+	if apiErrors.IsNotFound(err) {
+		return s.operationManager.OperationFailed(operation, fmt.Sprintf("Job %s was removed before it finished", key), err, log)
+	}
+	if err != nil {
+		log.Errorf("while getting Job %s: %s", key, err)
+		return operation, s.timeSchedule.StatusCheck, nil
+	}
+	job := batchv1.Job{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &job)
+	if err != nil {
+		return s.operationManager.OperationFailed(operation, fmt.Sprintf("while converting Job %s", key), err, log)
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != coreV1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return s.operationManager.OperationSucceeded(operation, fmt.Sprintf("Job %s succeeded", key), log)
+		case batchv1.JobFailed:
+			msg := fmt.Sprintf("Job %s failed", key)
+			if condition.Reason != "" {
+				msg = fmt.Sprintf("%s: %s %s", msg, condition.Reason, condition.Message)
+			}
+			return s.operationManager.OperationFailed(operation, msg, nil, log)
+		}
+	}
+
+	if !job.CreationTimestamp.IsZero() && time.Since(job.CreationTimestamp.Time) > task.Timeout {
+		return s.operationManager.OperationFailed(operation, fmt.Sprintf("Job %s did not finish within %s", key, task.Timeout), nil, log)
+	}
+	log.Infof("Job %s is running, active pods: %d", key, job.Status.Active)
+
+	return operation, s.timeSchedule.StatusCheck, nil
+}
+
+func (s *RunJobStep) createJob(operation internal.RunTaskOperation, template *unstructured.Unstructured, log logrus.FieldLogger) (internal.RunTaskOperation, time.Duration, error) {
+	job := template.DeepCopy()
+	job.SetName(JobName(template, operation.Operation.ID))
+	job.SetGenerateName("")
+	labels := job.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[OrchestrationIDLabel] = operation.OrchestrationID
+	labels[OperationIDLabel] = operation.Operation.ID
+	job.SetLabels(labels)
+
+	err := operation.K8sClient.Create(context.Background(), job)
+	if err != nil && !apiErrors.IsAlreadyExists(err) {
+		msg := fmt.Sprintf("while creating Job %s", client.ObjectKeyFromObject(job))
+		log.Errorf("%s: %s", msg, err)
+		return s.operationManager.RetryOperation(operation, msg, err, s.timeSchedule.Retry, createJobTimeout, log)
+	}
+	log.Infof("Created Job %s", client.ObjectKeyFromObject(job))
+
+	// the Job name is the same in every run of the operation, so the Job is not created twice if the operation is not saved
+	updatedOperation, delay, err := s.operationManager.UpdateOperation(operation, func(op *internal.RunTaskOperation) {
+		op.JobName = job.GetName()
+		op.JobNamespace = job.GetNamespace()
+		op.Description = fmt.Sprintf("Job %s created", client.ObjectKeyFromObject(job))
+	}, log)
+	if delay != 0 {
+		log.Errorf("unable to save Job %s in the operation: %s", client.ObjectKeyFromObject(job), err)
+		return operation, s.timeSchedule.Retry, nil
+	}
+
+	return updatedOperation, s.timeSchedule.StatusCheck, nil
+}
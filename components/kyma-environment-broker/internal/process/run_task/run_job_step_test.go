@@ -0,0 +1,98 @@
+package run_task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	coreV1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRunJobStep_Run(t *testing.T) {
+	for tn, tc := range map[string]struct {
+		condition     batchv1.JobConditionType
+		expectedState domain.LastOperationState
+		expectedErr   bool
+	}{
+		"job completed": {
+			condition:     batchv1.JobComplete,
+			expectedState: domain.Succeeded,
+		},
+		"job failed": {
+			condition:     batchv1.JobFailed,
+			expectedState: domain.Failed,
+			expectedErr:   true,
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			log := logrus.New()
+			memoryStorage := storage.NewMemoryStorage()
+			cli := fake.NewClientBuilder().Build()
+			operation := fixRunTaskOperation(cli)
+			err := memoryStorage.Operations().InsertRunTaskOperation(operation)
+			require.NoError(t, err)
+
+			step := NewRunJobStep(memoryStorage.Operations(), nil)
+
+			// when
+			operation, repeat, err := step.Run(operation, log)
+
+			// then
+			require.NoError(t, err)
+			assert.NotZero(t, repeat)
+			assert.Equal(t, "cleanup-"+operation.Operation.ID[:8], operation.JobName)
+			assert.Equal(t, "kyma-system", operation.JobNamespace)
+
+			job := batchv1.Job{}
+			err = cli.Get(context.Background(), client.ObjectKey{Namespace: operation.JobNamespace, Name: operation.JobName}, &job)
+			require.NoError(t, err)
+			assert.Equal(t, operation.OrchestrationID, job.Labels[OrchestrationIDLabel])
+			assert.Equal(t, operation.Operation.ID, job.Labels[OperationIDLabel])
+
+			// when
+			operation, repeat, err = step.Run(operation, log)
+
+			// then
+			require.NoError(t, err)
+			assert.NotZero(t, repeat)
+			assert.Equal(t, orchestration.InProgress, string(operation.State))
+
+			// given
+			job.Status.Conditions = []batchv1.JobCondition{{Type: tc.condition, Status: coreV1.ConditionTrue}}
+			err = cli.Status().Update(context.Background(), &job)
+			require.NoError(t, err)
+
+			// when
+			operation, repeat, err = step.Run(operation, log)
+
+			// then
+			assert.Equal(t, tc.expectedErr, err != nil)
+			assert.Zero(t, repeat)
+			assert.Equal(t, tc.expectedState, operation.State)
+		})
+	}
+}
+
+func fixRunTaskOperation(cli client.Client) internal.RunTaskOperation {
+	op := fixture.FixOperation("6a1b2c3d-4e5f-4a1b-8c9d-0e1f2a3b4c5d", "instance-id", internal.OperationTypeRunTask)
+	op.State = domain.InProgress
+	op.OrchestrationID = "orchestration-id"
+	op.UpdatedAt = time.Now()
+	return internal.RunTaskOperation{
+		Operation: op,
+		Task:      orchestration.TaskParameters{Job: fixJob},
+		K8sClient: cli,
+	}
+}
@@ -0,0 +1,127 @@
+package run_task
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	// defaultJobName is the base of the name of the Job which does not set its name
+	defaultJobName = "task"
+
+	OrchestrationIDLabel = "kyma-project.io/keb-orchestration-id"
+	OperationIDLabel     = "kyma-project.io/keb-operation-id"
+)
+
+// Task is the decoded form of the task parameters of run task orchestrations
+type Task struct {
+	Manifests []*unstructured.Unstructured
+	Job       *unstructured.Unstructured
+	Timeout   time.Duration
+}
+
+// ParseTask decodes the manifests and the Job of the task. It is also used to validate the task before the orchestration is created.
+func ParseTask(params orchestration.TaskParameters, defaultTimeout time.Duration) (Task, error) {
+	task := Task{Timeout: defaultTimeout}
+	if len(params.Manifests) == 0 && params.Job == "" {
+		return task, errors.New("task must define manifests or a job")
+	}
+
+	for i, manifest := range params.Manifests {
+		objects, err := decodeObjects(manifest)
+		if err != nil {
+			return task, errors.Wrapf(err, "while decoding manifest %d", i)
+		}
+		for _, obj := range objects {
+			if obj.GetName() == "" {
+				return task, fmt.Errorf("%s object in manifest %d must define metadata.name", obj.GetKind(), i)
+			}
+		}
+		task.Manifests = append(task.Manifests, objects...)
+	}
+
+	if params.Job != "" {
+		objects, err := decodeObjects(params.Job)
+		if err != nil {
+			return task, errors.Wrap(err, "while decoding job")
+		}
+		if len(objects) != 1 {
+			return task, fmt.Errorf("job must contain exactly one object, found %d", len(objects))
+		}
+		job := objects[0]
+		if job.GetAPIVersion() != "batch/v1" || job.GetKind() != "Job" {
+			return task, fmt.Errorf("job must be a batch/v1 Job, found %s %s", job.GetAPIVersion(), job.GetKind())
+		}
+		if job.GetNamespace() == "" {
+			return task, errors.New("job must define metadata.namespace")
+		}
+		task.Job = job
+	}
+
+	if params.Timeout != "" {
+		timeout, err := time.ParseDuration(params.Timeout)
+		if err != nil || timeout <= 0 {
+			return task, fmt.Errorf("invalid task timeout %s", params.Timeout)
+		}
+		task.Timeout = timeout
+	}
+
+	return task, nil
+}
+
+// JobName returns the name of the Job created in the runtime by the given operation, which is unique for every operation
+func JobName(job *unstructured.Unstructured, operationID string) string {
+	base := job.GetName()
+	if base == "" {
+		base = strings.TrimSuffix(job.GetGenerateName(), "-")
+	}
+	if base == "" {
+		base = defaultJobName
+	}
+	suffix := operationID
+	if len(suffix) > 8 {
+		suffix = suffix[:8]
+	}
+	if max := validation.DNS1123LabelMaxLength - len(suffix) - 1; len(base) > max {
+		base = strings.TrimSuffix(base[:max], "-")
+	}
+	return fmt.Sprintf("%s-%s", base, suffix)
+}
+
+// decodeObjects decodes all objects of the manifest, which can contain multiple YAML documents
+func decodeObjects(manifest string) ([]*unstructured.Unstructured, error) {
+	objects := make([]*unstructured.Unstructured, 0)
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+	for {
+		content := map[string]interface{}{}
+		err := decoder.Decode(&content)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(content) == 0 {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{Object: content}
+		if obj.GetAPIVersion() == "" || obj.GetKind() == "" {
+			return nil, errors.New("object must define apiVersion and kind")
+		}
+		objects = append(objects, obj)
+	}
+	if len(objects) == 0 {
+		return nil, errors.New("manifest does not contain any object")
+	}
+
+	return objects, nil
+}
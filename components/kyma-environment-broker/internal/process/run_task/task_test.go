@@ -0,0 +1,114 @@
+package run_task
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	fixJob = `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: cleanup
+  namespace: kyma-system
+spec:
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: cleanup
+        image: busybox
+`
+	fixManifest = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: tasks
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  namespace: tasks
+data:
+  key: value
+`
+)
+
+func TestParseTask(t *testing.T) {
+	for tn, tc := range map[string]struct {
+		params            orchestration.TaskParameters
+		expectedErr       bool
+		expectedManifests int
+		expectedJob       bool
+		expectedTimeout   time.Duration
+	}{
+		"job and manifests": {
+			params:            orchestration.TaskParameters{Manifests: []string{fixManifest}, Job: fixJob, Timeout: "10m"},
+			expectedManifests: 2,
+			expectedJob:       true,
+			expectedTimeout:   10 * time.Minute,
+		},
+		"manifests only": {
+			params:            orchestration.TaskParameters{Manifests: []string{fixManifest}},
+			expectedManifests: 2,
+			expectedTimeout:   time.Hour,
+		},
+		"empty task": {
+			expectedErr: true,
+		},
+		"job which is not a Job": {
+			params:      orchestration.TaskParameters{Job: fixManifest},
+			expectedErr: true,
+		},
+		"manifest object without name": {
+			params:      orchestration.TaskParameters{Manifests: []string{"apiVersion: v1\nkind: ConfigMap\n"}},
+			expectedErr: true,
+		},
+		"manifest object without kind": {
+			params:      orchestration.TaskParameters{Manifests: []string{"metadata:\n  name: settings\n"}},
+			expectedErr: true,
+		},
+		"job without namespace": {
+			params:      orchestration.TaskParameters{Job: "apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: cleanup\n"},
+			expectedErr: true,
+		},
+		"invalid timeout": {
+			params:      orchestration.TaskParameters{Job: fixJob, Timeout: "-1m"},
+			expectedErr: true,
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			// when
+			task, err := ParseTask(tc.params, time.Hour)
+
+			// then
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, task.Manifests, tc.expectedManifests)
+			assert.Equal(t, tc.expectedJob, task.Job != nil)
+			assert.Equal(t, tc.expectedTimeout, task.Timeout)
+		})
+	}
+}
+
+func TestJobName(t *testing.T) {
+	job := &unstructured.Unstructured{}
+	assert.Equal(t, "task-6a1b2c3d", JobName(job, "6a1b2c3d-0000-0000-0000-000000000000"))
+
+	job.SetGenerateName("cleanup-")
+	assert.Equal(t, "cleanup-6a1b2c3d", JobName(job, "6a1b2c3d-0000-0000-0000-000000000000"))
+
+	job.SetName(strings.Repeat("a", 70))
+	assert.Len(t, JobName(job, "6a1b2c3d-0000-0000-0000-000000000000"), 63)
+}
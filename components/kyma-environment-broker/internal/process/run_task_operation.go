@@ -0,0 +1,108 @@
+package process
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type RunTaskOperationManager struct {
+	storage storage.RunTask
+}
+
+func NewRunTaskOperationManager(storage storage.Operations) *RunTaskOperationManager {
+	return &RunTaskOperationManager{storage: storage}
+}
+
+// OperationSucceeded marks the operation as succeeded and only repeats it if there is a storage error
+func (om *RunTaskOperationManager) OperationSucceeded(operation internal.RunTaskOperation, description string, log logrus.FieldLogger) (internal.RunTaskOperation, time.Duration, error) {
+	updatedOperation, repeat, _ := om.update(operation, orchestration.Succeeded, description, log)
+	// repeat in case of storage error
+	if repeat != 0 {
+		return updatedOperation, repeat, nil
+	}
+
+	return updatedOperation, 0, nil
+}
+
+// OperationFailed marks the operation as failed and only repeats it if there is a storage error
+func (om *RunTaskOperationManager) OperationFailed(operation internal.RunTaskOperation, description string, err error, log logrus.FieldLogger) (internal.RunTaskOperation, time.Duration, error) {
+	updatedOperation, repeat, _ := om.update(operation, orchestration.Failed, description, log)
+	// repeat in case of storage error
+	if repeat != 0 {
+		return updatedOperation, repeat, nil
+	}
+
+	var retErr error
+	if err == nil {
+		// no exact err passed in
+		retErr = errors.New(description)
+	} else {
+		// keep the original err object for error categorizer
+		retErr = errors.Wrap(err, description)
+	}
+
+	return updatedOperation, 0, retErr
+}
+
+// OperationCanceled marks the operation as canceled and only repeats it if there is a storage error
+func (om *RunTaskOperationManager) OperationCanceled(operation internal.RunTaskOperation, description string, log logrus.FieldLogger) (internal.RunTaskOperation, time.Duration, error) {
+	updatedOperation, repeat, _ := om.update(operation, orchestration.Canceled, description, log)
+	if repeat != 0 {
+		return updatedOperation, repeat, nil
+	}
+
+	return updatedOperation, 0, nil
+}
+
+// RetryOperation retries an operation for at maxTime in retryInterval steps and fails the operation if retrying failed
+func (om *RunTaskOperationManager) RetryOperation(operation internal.RunTaskOperation, errorMessage string, err error, retryInterval time.Duration, maxTime time.Duration, log logrus.FieldLogger) (internal.RunTaskOperation, time.Duration, error) {
+	since := time.Since(operation.UpdatedAt)
+
+	log.Infof("Retry Operation was triggered with message: %s", errorMessage)
+	log.Infof("Retrying for %s in %s steps", maxTime.String(), retryInterval.String())
+	if since < maxTime {
+		return operation, retryInterval, nil
+	}
+	log.Errorf("Aborting after %s of failing retries", maxTime.String())
+	return om.OperationFailed(operation, errorMessage, err, log)
+}
+
+// UpdateOperation updates a given operation
+func (om *RunTaskOperationManager) UpdateOperation(operation internal.RunTaskOperation, update func(operation *internal.RunTaskOperation), log logrus.FieldLogger) (internal.RunTaskOperation, time.Duration, error) {
+	update(&operation)
+	updatedOperation, err := om.storage.UpdateRunTaskOperation(operation)
+	switch {
+	case dberr.IsConflict(err):
+		{
+			op, err := om.storage.GetRunTaskOperationByID(operation.Operation.ID)
+			if err != nil {
+				log.Errorf("while getting operation: %v", err)
+				return operation, 1 * time.Minute, err
+			}
+			update(op)
+			updatedOperation, err = om.storage.UpdateRunTaskOperation(*op)
+			if err != nil {
+				log.Errorf("while updating operation after conflict: %v", err)
+				return operation, 1 * time.Minute, err
+			}
+		}
+	case err != nil:
+		log.Errorf("while updating operation: %v", err)
+		return operation, 1 * time.Minute, err
+	}
+	return *updatedOperation, 0, nil
+}
+
+func (om *RunTaskOperationManager) update(operation internal.RunTaskOperation, state domain.LastOperationState, description string, log logrus.FieldLogger) (internal.RunTaskOperation, time.Duration, error) {
+	return om.UpdateOperation(operation, func(operation *internal.RunTaskOperation) {
+		operation.State = state
+		operation.Description = description
+	}, log)
+}
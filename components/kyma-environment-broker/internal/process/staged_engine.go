@@ -0,0 +1,372 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+)
+
+// Step is a single step of the processing of an operation of the type T
+type Step[T any] interface {
+	Name() string
+	Run(operation T, logger logrus.FieldLogger) (T, time.Duration, error)
+}
+
+type StepCondition[T any] func(operation T) bool
+
+// StepWithTimeLimit is implemented by steps which must finish within the time limit, counted from the first run of the step
+type StepWithTimeLimit interface {
+	TimeLimit() time.Duration
+}
+
+// StepWithRetryLimit is implemented by steps which can be retried only the given number of times
+type StepWithRetryLimit interface {
+	MaxRetries() int
+}
+
+// StagedEngineConfig adapts the StagedEngine to the operation type T
+type StagedEngineConfig[T any] struct {
+	// Operation returns the part of the operation common for all operation types
+	Operation func(operation *T) *internal.Operation
+	// Update saves the operation in the storage
+	Update func(operation T) (*T, error)
+	// StepProcessedEvent creates the event published after every run of a step
+	StepProcessedEvent func(old, processed T, step StepProcessed) interface{}
+
+	// RetryInPlace is the time a step is retried by the engine before the operation is returned to the queue.
+	// Zero returns the operation to the queue after the first retry request.
+	RetryInPlace time.Duration
+	// SaveFinishedStages marks finished stages in the operation, so they are not run again
+	SaveFinishedStages bool
+	// SaveLastError saves the operation with the last error returned by a step
+	SaveLastError bool
+	// IsFinished overrides the check if the operation is finished, Operation.IsFinished is used by default
+	IsFinished func(operation T) bool
+	// Steps saves the journal of runs of steps, the journal is not saved if it is not set
+	Steps storage.OperationSteps
+}
+
+type stagedStep[T any] struct {
+	step      Step[T]
+	condition StepCondition[T]
+}
+
+type stage[T any] struct {
+	name   string
+	weight int
+	steps  []stagedStep[T]
+}
+
+// StagedEngine runs steps of operations of the type T. Steps are grouped in stages, which are run in the defined order.
+// A step which needs a retry stops the processing, which continues from the first not finished stage.
+type StagedEngine[T any] struct {
+	cfg       StagedEngineConfig[T]
+	publisher event.Publisher
+	stages    []*stage[T]
+
+	speedFactor int64
+}
+
+func NewStagedEngine[T any](cfg StagedEngineConfig[T], pub event.Publisher) *StagedEngine[T] {
+	return &StagedEngine[T]{
+		cfg:         cfg,
+		publisher:   pub,
+		speedFactor: 1,
+	}
+}
+
+// SpeedUp changes speedFactor parameter to reduce the sleep time if a step needs a retry.
+// This method should only be used for testing purposes
+func (e *StagedEngine[T]) SpeedUp(speedFactor int64) {
+	e.speedFactor = speedFactor
+}
+
+func (e *StagedEngine[T]) DefineStages(names []string) {
+	e.stages = make([]*stage[T], len(names))
+	for i, n := range names {
+		e.stages[i] = &stage[T]{name: n}
+	}
+}
+
+func (e *StagedEngine[T]) AddStep(stageName string, step Step[T], cnd StepCondition[T]) error {
+	for _, s := range e.stages {
+		if s.name == stageName {
+			s.steps = append(s.steps, stagedStep[T]{step: step, condition: cnd})
+			return nil
+		}
+	}
+	return fmt.Errorf("Stage %s not defined", stageName)
+}
+
+// AddWeightedStep adds the step to the stage of the given weight. Stages of weighted steps are run in the ascending order of weights.
+func (e *StagedEngine[T]) AddWeightedStep(weight int, step Step[T], cnd StepCondition[T]) {
+	position := len(e.stages)
+	for i, s := range e.stages {
+		if s.weight == weight {
+			s.steps = append(s.steps, stagedStep[T]{step: step, condition: cnd})
+			return
+		}
+		if s.weight > weight {
+			position = i
+			break
+		}
+	}
+
+	s := &stage[T]{name: fmt.Sprintf("%d", weight), weight: weight, steps: []stagedStep[T]{{step: step, condition: cnd}}}
+	e.stages = append(e.stages[:position], append([]*stage[T]{s}, e.stages[position:]...)...)
+}
+
+func (e *StagedEngine[T]) GetAllStages() []string {
+	var all []string
+	for _, s := range e.stages {
+		all = append(all, s.name)
+	}
+	return all
+}
+
+// GetAllSteps returns the names of steps of all stages
+func (e *StagedEngine[T]) GetAllSteps() []string {
+	var all []string
+	for _, s := range e.stages {
+		for _, step := range s.steps {
+			all = append(all, step.step.Name())
+		}
+	}
+	return all
+}
+
+// Run processes all not finished stages of the operation. It returns the time after which the processing must be repeated
+// if a step needs a retry. The processing stops when a step returns an error or finishes the operation. A step error
+// fails the operation, unless the step already finished it.
+func (e *StagedEngine[T]) Run(operation T, logger logrus.FieldLogger) (T, time.Duration, error) {
+	var when time.Duration
+	var err error
+	processedOperation := operation
+
+	for _, stage := range e.stages {
+		// stages can be also finished manually when the operation is resumed
+		if e.cfg.Operation(&processedOperation).IsStageFinished(stage.name) {
+			continue
+		}
+
+		for _, step := range stage.steps {
+			logStep := logger.WithField("step", step.step.Name()).
+				WithField("stage", stage.name)
+			if step.condition != nil && !step.condition(processedOperation) {
+				logStep.Debugf("Skipping")
+				continue
+			}
+			if e.cfg.Operation(&processedOperation).IsStepSkipped(step.step.Name()) {
+				logStep.Infof("Skipping, the step was skipped manually")
+				continue
+			}
+
+			processedOperation, when, err = e.runStep(step.step, stage.name, processedOperation, logStep)
+			if err != nil {
+				logStep.Errorf("Process operation failed: %s", err)
+				return e.failOperation(processedOperation, step.step.Name(), err, logStep)
+			}
+			if e.isFinished(processedOperation) {
+				op := e.cfg.Operation(&processedOperation)
+				logStep.Infof("Operation %q got status %s. Process finished.", op.ID, op.State)
+				return processedOperation, when, nil
+			}
+
+			// the step needs a retry
+			if when > 0 {
+				logStep.Infof("Process operation will be repeated in %s ...", when)
+				return processedOperation, when, nil
+			}
+		}
+
+		if e.cfg.SaveFinishedStages {
+			processedOperation, err = e.saveFinishedStage(processedOperation, stage, logger)
+			if err != nil {
+				return processedOperation, time.Second, nil
+			}
+		}
+	}
+
+	return processedOperation, 0, nil
+}
+
+// failOperation saves the operation, which is not finished by the failed step, as failed. The processing is repeated
+// after a second if the operation cannot be saved, so the operation is not left in progress without a worker.
+func (e *StagedEngine[T]) failOperation(operation T, stepName string, stepErr error, logger logrus.FieldLogger) (T, time.Duration, error) {
+	if e.isFinished(operation) {
+		return operation, 0, stepErr
+	}
+
+	failed := operation
+	op := e.cfg.Operation(&failed)
+	op.State = domain.Failed
+	op.Description = fmt.Sprintf("step %s failed: %s", stepName, stepErr)
+	updatedOperation, err := e.cfg.Update(failed)
+	if err != nil {
+		logger.Errorf("Unable to save failed operation: %s", err)
+		return operation, time.Second, nil
+	}
+	return *updatedOperation, 0, stepErr
+}
+
+func (e *StagedEngine[T]) isFinished(operation T) bool {
+	if e.cfg.IsFinished != nil {
+		return e.cfg.IsFinished(operation)
+	}
+	return e.cfg.Operation(&operation).IsFinished()
+}
+
+func (e *StagedEngine[T]) saveFinishedStage(operation T, s *stage[T], log logrus.FieldLogger) (T, error) {
+	e.cfg.Operation(&operation).FinishStage(s.name)
+	op, err := e.cfg.Update(operation)
+	if err != nil {
+		log.Infof("Unable to save operation with finished stage %s: %s", s.name, err.Error())
+		return operation, err
+	}
+	log.Infof("Finished stage %s", s.name)
+	return *op, nil
+}
+
+func (e *StagedEngine[T]) runStep(step Step[T], stageName string, operation T, logger logrus.FieldLogger) (T, time.Duration, error) {
+	begin := time.Now()
+	for {
+		start := time.Now()
+		logger.Infof("Start step")
+		processedOperation, when, err := step.Run(operation, logger)
+		if err == nil && when != 0 && hasStepLimits(step) {
+			processedOperation, when, err = e.checkStepLimits(step, processedOperation, when, logger)
+		}
+
+		if err != nil {
+			op := e.cfg.Operation(&processedOperation)
+			op.LastError = kebError.ReasonForError(err)
+			logError := logger.WithFields(logrus.Fields{"error_component": op.LastError.Component(), "error_reason": op.LastError.Reason()})
+			logError.Errorf("Last error from step %s: %s", step.Name(), op.LastError.Error())
+			if e.cfg.SaveLastError {
+				// only save to storage, skip for alerting if error
+				if updatedOperation, saveErr := e.cfg.Update(processedOperation); saveErr != nil {
+					logError.Errorf("Unable to save operation with resolved last error from step: %s", step.Name())
+				} else {
+					processedOperation = *updatedOperation
+				}
+			}
+		}
+
+		e.saveStepJournal(step.Name(), stageName, start, processedOperation, when, err, logger)
+		e.publisher.Publish(context.TODO(), e.cfg.StepProcessedEvent(operation, processedOperation, StepProcessed{
+			StepName: step.Name(),
+			Duration: time.Since(start),
+			When:     when,
+			Error:    err,
+		}))
+
+		// break the loop if:
+		// - the step does not need a retry
+		// - step returns an error
+		// - the loop takes too much time (to not block the worker too long)
+		if when == 0 || err != nil || time.Since(begin) >= e.cfg.RetryInPlace {
+			return processedOperation, when, err
+		}
+		if hasStepLimits(step) {
+			// the next run must start from the operation with the saved attempts
+			operation = processedOperation
+		}
+		time.Sleep(when / time.Duration(e.speedFactor))
+	}
+}
+
+// saveStepJournal saves the result of the run of the step in the operation step journal.
+// The processing is not stopped if the journal cannot be saved.
+func (e *StagedEngine[T]) saveStepJournal(stepName, stageName string, start time.Time, operation T, when time.Duration, err error, logger logrus.FieldLogger) {
+	if e.cfg.Steps == nil {
+		return
+	}
+
+	op := e.cfg.Operation(&operation)
+	entry := internal.OperationStep{
+		ID:          uuid.New().String(),
+		OperationID: op.ID,
+		StepName:    stepName,
+		Stage:       stageName,
+		StartedAt:   start,
+		FinishedAt:  time.Now(),
+		Result:      internal.OperationStepSucceeded,
+		RetryDelay:  when,
+	}
+	switch {
+	case err != nil:
+		entry.Result = internal.OperationStepFailed
+		entry.ErrorReason = string(kebError.ReasonForError(err).Reason())
+		entry.ErrorMessage = err.Error()
+	case op.State == domain.Failed:
+		entry.Result = internal.OperationStepFailed
+		entry.ErrorMessage = op.Description
+	case when > 0:
+		entry.Result = internal.OperationStepRetry
+	}
+
+	if saveErr := e.cfg.Steps.InsertOperationStep(entry); saveErr != nil {
+		logger.Warnf("Unable to save the operation step journal: %s", saveErr)
+	}
+}
+
+// checkStepLimits counts the attempts of the step, which needs a retry, and fails the operation if the step
+// has reached its time limit or retry limit
+func (e *StagedEngine[T]) checkStepLimits(step Step[T], operation T, when time.Duration, logger logrus.FieldLogger) (T, time.Duration, error) {
+	op := e.cfg.Operation(&operation)
+	attempts := op.StepAttempts[step.Name()]
+	if attempts.StartedAt.IsZero() {
+		attempts.StartedAt = time.Now()
+	}
+	attempts.Count++
+
+	var stepErr error
+	if s, ok := step.(StepWithTimeLimit); ok && s.TimeLimit() > 0 && time.Since(attempts.StartedAt) > s.TimeLimit() {
+		stepErr = kebError.NewStepTimeoutError(step.Name(), s.TimeLimit())
+	}
+	if s, ok := step.(StepWithRetryLimit); ok && s.MaxRetries() > 0 && attempts.Count > s.MaxRetries() {
+		stepErr = kebError.NewStepRetriesExceededError(step.Name(), s.MaxRetries())
+	}
+
+	stepAttempts := make(map[string]internal.StepAttempts, len(op.StepAttempts)+1)
+	for name, a := range op.StepAttempts {
+		stepAttempts[name] = a
+	}
+	stepAttempts[step.Name()] = attempts
+	op.StepAttempts = stepAttempts
+
+	if stepErr != nil {
+		logger.Errorf("Step limit exceeded: %s", stepErr)
+		op.State = domain.Failed
+		op.Description = stepErr.Error()
+		op.LastError = kebError.ReasonForError(stepErr)
+	}
+
+	updatedOperation, err := e.cfg.Update(operation)
+	if stepErr != nil {
+		if err != nil {
+			logger.Errorf("Unable to save failed operation: %s", err)
+			return operation, 0, stepErr
+		}
+		return *updatedOperation, 0, stepErr
+	}
+	if err != nil {
+		logger.Errorf("Unable to save attempts of the step: %s", err)
+		return operation, when, nil
+	}
+	return *updatedOperation, when, nil
+}
+
+func hasStepLimits(step interface{}) bool {
+	_, withTimeLimit := step.(StepWithTimeLimit)
+	_, withRetryLimit := step.(StepWithRetryLimit)
+	return withTimeLimit || withRetryLimit
+}
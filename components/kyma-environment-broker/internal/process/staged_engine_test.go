@@ -0,0 +1,242 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingStep struct {
+	name    string
+	when    time.Duration
+	state   domain.LastOperationState
+	err     error
+	history *[]string
+}
+
+func (s *recordingStep) Name() string {
+	return s.name
+}
+
+func (s *recordingStep) Run(operation internal.UpdatingOperation, _ logrus.FieldLogger) (internal.UpdatingOperation, time.Duration, error) {
+	*s.history = append(*s.history, s.name)
+	if s.state != "" {
+		operation.State = s.state
+	}
+	return operation, s.when, s.err
+}
+
+type limitedStep struct {
+	recordingStep
+	maxRetries int
+}
+
+func (s *limitedStep) MaxRetries() int {
+	return s.maxRetries
+}
+
+type recordingPublisher struct {
+	events []interface{}
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, ev interface{}) {
+	p.events = append(p.events, ev)
+}
+
+func TestStagedEngine(t *testing.T) {
+	newEngine := func(operations storage.Operations, saveStages bool) *StagedEngine[internal.UpdatingOperation] {
+		return NewStagedEngine(StagedEngineConfig[internal.UpdatingOperation]{
+			Operation: func(op *internal.UpdatingOperation) *internal.Operation {
+				return &op.Operation
+			},
+			Update: operations.UpdateUpdatingOperation,
+			Steps:  operations,
+			StepProcessedEvent: func(old, processed internal.UpdatingOperation, step StepProcessed) interface{} {
+				return UpdatingStepProcessed{OldOperation: old, Operation: processed, StepProcessed: step}
+			},
+			SaveFinishedStages: saveStages,
+		}, event.NewPubSub(logrus.New()))
+	}
+	fixOperation := func(operations storage.Operations) internal.UpdatingOperation {
+		op := internal.UpdatingOperation{Operation: internal.Operation{ID: "op-1", State: domain.InProgress, Type: internal.OperationTypeUpdate, FinishedStages: map[string]struct{}{}}}
+		require.NoError(t, operations.InsertUpdatingOperation(op))
+		stored, err := operations.GetUpdatingOperationByID("op-1")
+		require.NoError(t, err)
+		return *stored
+	}
+
+	t.Run("should run weighted steps in the order of weights", func(t *testing.T) {
+		// given
+		operations := storage.NewMemoryStorage().Operations()
+		engine := newEngine(operations, false)
+		var history []string
+		engine.AddWeightedStep(10, &recordingStep{name: "third", history: &history}, nil)
+		engine.AddWeightedStep(1, &recordingStep{name: "first", history: &history}, nil)
+		engine.AddWeightedStep(5, &recordingStep{name: "second", history: &history}, nil)
+		engine.AddWeightedStep(5, &recordingStep{name: "skipped", history: &history}, func(internal.UpdatingOperation) bool {
+			return false
+		})
+
+		// when
+		_, when, err := engine.Run(fixOperation(operations), logrus.New())
+
+		// then
+		require.NoError(t, err)
+		assert.Zero(t, when)
+		assert.Equal(t, []string{"first", "second", "third"}, history)
+		assert.Equal(t, []string{"1", "5", "10"}, engine.GetAllStages())
+	})
+
+	t.Run("should stop on retry and skip finished stages", func(t *testing.T) {
+		// given
+		operations := storage.NewMemoryStorage().Operations()
+		engine := newEngine(operations, true)
+		engine.DefineStages([]string{"stage-1", "stage-2"})
+		var history []string
+		retrying := &recordingStep{name: "retrying", when: time.Minute, history: &history}
+		require.NoError(t, engine.AddStep("stage-1", &recordingStep{name: "first", history: &history}, nil))
+		require.NoError(t, engine.AddStep("stage-2", retrying, nil))
+		require.NoError(t, engine.AddStep("stage-2", &recordingStep{name: "last", history: &history}, nil))
+		operation := fixOperation(operations)
+
+		// when
+		operation, when, err := engine.Run(operation, logrus.New())
+		require.NoError(t, err)
+		assert.Equal(t, time.Minute, when)
+
+		retrying.when = 0
+		operation, when, err = engine.Run(operation, logrus.New())
+
+		// then
+		require.NoError(t, err)
+		assert.Zero(t, when)
+		assert.Equal(t, []string{"first", "retrying", "retrying", "last"}, history)
+		assert.True(t, operation.IsStageFinished("stage-1"))
+		assert.True(t, operation.IsStageFinished("stage-2"))
+	})
+
+	t.Run("should stop when a step finishes the operation", func(t *testing.T) {
+		// given
+		operations := storage.NewMemoryStorage().Operations()
+		engine := newEngine(operations, false)
+		var history []string
+		engine.AddWeightedStep(1, &recordingStep{name: "failing", state: domain.Failed, history: &history}, nil)
+		engine.AddWeightedStep(2, &recordingStep{name: "never", history: &history}, nil)
+
+		// when
+		operation, _, err := engine.Run(fixOperation(operations), logrus.New())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, domain.Failed, operation.State)
+		assert.Equal(t, []string{"failing"}, history)
+	})
+
+	t.Run("should fail the operation when a step returns an error", func(t *testing.T) {
+		// given
+		operations := storage.NewMemoryStorage().Operations()
+		engine := newEngine(operations, false)
+		var history []string
+		engine.AddWeightedStep(1, &recordingStep{name: "broken", err: errors.New("unexpected error"), history: &history}, nil)
+		engine.AddWeightedStep(2, &recordingStep{name: "never", history: &history}, nil)
+
+		// when
+		operation, when, err := engine.Run(fixOperation(operations), logrus.New())
+
+		// then
+		require.Error(t, err)
+		assert.Zero(t, when)
+		assert.Equal(t, domain.Failed, operation.State)
+		assert.Equal(t, []string{"broken"}, history)
+		stored, err := operations.GetUpdatingOperationByID("op-1")
+		require.NoError(t, err)
+		assert.Equal(t, domain.Failed, stored.State)
+		assert.Equal(t, "step broken failed: unexpected error", stored.Description)
+	})
+
+	t.Run("should save the journal of steps", func(t *testing.T) {
+		// given
+		operations := storage.NewMemoryStorage().Operations()
+		engine := newEngine(operations, false)
+		var history []string
+		engine.AddWeightedStep(1, &recordingStep{name: "first", history: &history}, nil)
+		engine.AddWeightedStep(2, &recordingStep{name: "retrying", when: time.Minute, history: &history}, nil)
+
+		// when
+		_, _, err := engine.Run(fixOperation(operations), logrus.New())
+		require.NoError(t, err)
+
+		// then
+		steps, err := operations.ListOperationSteps("op-1")
+		require.NoError(t, err)
+		require.Len(t, steps, 2)
+		assert.Equal(t, "first", steps[0].StepName)
+		assert.Equal(t, "1", steps[0].Stage)
+		assert.Equal(t, internal.OperationStepSucceeded, steps[0].Result)
+		assert.Equal(t, "retrying", steps[1].StepName)
+		assert.Equal(t, internal.OperationStepRetry, steps[1].Result)
+		assert.Equal(t, time.Minute, steps[1].RetryDelay)
+	})
+
+	t.Run("should not run steps and stages skipped manually", func(t *testing.T) {
+		// given
+		operations := storage.NewMemoryStorage().Operations()
+		engine := newEngine(operations, false)
+		var history []string
+		engine.AddWeightedStep(1, &recordingStep{name: "finished", history: &history}, nil)
+		engine.AddWeightedStep(2, &recordingStep{name: "skipped", history: &history}, nil)
+		engine.AddWeightedStep(2, &recordingStep{name: "last", history: &history}, nil)
+		operation := fixOperation(operations)
+		operation.FinishStage("1")
+		operation.SkipStep("skipped")
+
+		// when
+		_, _, err := engine.Run(operation, logrus.New())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"last"}, history)
+	})
+
+	t.Run("should publish the operation before and after every run of a step with limits", func(t *testing.T) {
+		// given
+		operations := storage.NewMemoryStorage().Operations()
+		publisher := &recordingPublisher{}
+		engine := NewStagedEngine(StagedEngineConfig[internal.UpdatingOperation]{
+			Operation: func(op *internal.UpdatingOperation) *internal.Operation {
+				return &op.Operation
+			},
+			Update: operations.UpdateUpdatingOperation,
+			StepProcessedEvent: func(old, processed internal.UpdatingOperation, step StepProcessed) interface{} {
+				return UpdatingStepProcessed{OldOperation: old, Operation: processed, StepProcessed: step}
+			},
+			RetryInPlace: time.Second,
+		}, publisher)
+		var history []string
+		engine.AddWeightedStep(1, &limitedStep{recordingStep: recordingStep{name: "limited", when: time.Millisecond, history: &history}, maxRetries: 2}, nil)
+
+		// when
+		operation, _, err := engine.Run(fixOperation(operations), logrus.New())
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, domain.Failed, operation.State)
+		require.Len(t, publisher.events, 3)
+		for i, ev := range publisher.events {
+			processed := ev.(UpdatingStepProcessed)
+			assert.Equal(t, i, processed.OldOperation.StepAttempts["limited"].Count)
+			assert.Equal(t, i+1, processed.Operation.StepAttempts["limited"].Count)
+		}
+		assert.Equal(t, domain.InProgress, publisher.events[2].(UpdatingStepProcessed).OldOperation.State)
+		assert.Equal(t, domain.Failed, publisher.events[2].(UpdatingStepProcessed).Operation.State)
+	})
+}
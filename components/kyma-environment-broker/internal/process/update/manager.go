@@ -1,10 +1,8 @@
 package update
 
 import (
-	"context"
 	"errors"
 	"fmt"
-	"sync"
 	"time"
 
 	reconcilerApi "github.com/kyma-incubator/reconciler/pkg/keb"
@@ -22,13 +20,9 @@ type Manager struct {
 	log              logrus.FieldLogger
 	operationStorage storage.Operations
 	publisher        event.Publisher
+	engine           *process.StagedEngine[internal.UpdatingOperation]
 
-	stages           []*stage
 	operationTimeout time.Duration
-
-	mu sync.RWMutex
-
-	speedFactor int64
 }
 
 type Step interface {
@@ -38,62 +32,54 @@ type Step interface {
 
 type StepCondition func(operation internal.UpdatingOperation) bool
 
-type StepWithCondition struct {
-	Step
-	condition StepCondition
-}
-
-type stage struct {
-	name  string
-	steps []StepWithCondition
-}
-
-func (s *stage) AddStep(step Step, cnd StepCondition) {
-	s.steps = append(s.steps, StepWithCondition{
-		Step:      step,
-		condition: cnd,
-	})
-}
-
 func NewManager(storage storage.Operations, pub event.Publisher, operationTimeout time.Duration, logger logrus.FieldLogger) *Manager {
 	return &Manager{
 		log:              logger,
 		operationStorage: storage,
 		publisher:        pub,
 		operationTimeout: operationTimeout,
-		speedFactor:      1,
+		engine: process.NewStagedEngine(process.StagedEngineConfig[internal.UpdatingOperation]{
+			Operation: func(op *internal.UpdatingOperation) *internal.Operation {
+				return &op.Operation
+			},
+			Update: storage.UpdateUpdatingOperation,
+			Steps:  storage,
+			StepProcessedEvent: func(old, processed internal.UpdatingOperation, step process.StepProcessed) interface{} {
+				return process.UpdatingStepProcessed{
+					OldOperation:  old,
+					Operation:     processed,
+					StepProcessed: step,
+				}
+			},
+			RetryInPlace:       time.Minute,
+			SaveFinishedStages: true,
+			IsFinished: func(op internal.UpdatingOperation) bool {
+				return op.State == domain.Failed || op.State == domain.Succeeded
+			},
+		}, pub),
 	}
 }
 
 // SpeedUp changes speedFactor parameter to reduce the sleep time if a step needs a retry.
 // This method should only be used for testing purposes
 func (m *Manager) SpeedUp(speedFactor int64) {
-	m.speedFactor = speedFactor
+	m.engine.SpeedUp(speedFactor)
 }
 
 func (m *Manager) DefineStages(names []string) {
-	m.stages = make([]*stage, len(names))
-	for i, n := range names {
-		m.stages[i] = &stage{name: n, steps: []StepWithCondition{}}
-	}
+	m.engine.DefineStages(names)
 }
 
 func (m *Manager) AddStep(stageName string, step Step, cnd StepCondition) error {
-	for _, s := range m.stages {
-		if s.name == stageName {
-			s.AddStep(step, cnd)
-			return nil
-		}
-	}
-	return fmt.Errorf("Stage %s not defined", stageName)
+	return m.engine.AddStep(stageName, step, process.StepCondition[internal.UpdatingOperation](cnd))
 }
 
 func (m *Manager) GetAllStages() []string {
-	var all []string
-	for _, s := range m.stages {
-		all = append(all, s.name)
-	}
-	return all
+	return m.engine.GetAllStages()
+}
+
+func (m *Manager) GetAllSteps() []string {
+	return m.engine.GetAllSteps()
 }
 
 func (m *Manager) Execute(operationID string) (time.Duration, error) {
@@ -105,8 +91,8 @@ func (m *Manager) Execute(operationID string) (time.Duration, error) {
 
 	logOperation := m.log.WithFields(logrus.Fields{"operation": operationID, "instanceID": operation.InstanceID, "planID": operation.ProvisioningParameters.PlanID})
 	logOperation.Infof("Start process operation steps for GlobalAcocunt=%s, ", operation.ProvisioningParameters.ErsContext.GlobalAccountID)
-	if time.Since(operation.CreatedAt) > m.operationTimeout {
-		logOperation.Infof("operation has reached the time limit: operation was created at: %s", operation.CreatedAt)
+	if time.Since(operation.TimeoutBase()) > m.operationTimeout {
+		logOperation.Infof("operation has reached the time limit: operation was created or resumed at: %s", operation.TimeoutBase())
 		operation.State = domain.Failed
 		_, err = m.operationStorage.UpdateUpdatingOperation(*operation)
 		if err != nil {
@@ -116,44 +102,16 @@ func (m *Manager) Execute(operationID string) (time.Duration, error) {
 		return 0, errors.New("operation has reached the time limit")
 	}
 
-	var when time.Duration
-	processedOperation := *operation
-
-	for _, stage := range m.stages {
-		if processedOperation.IsStageFinished(stage.name) {
-			continue
-		}
-
-		for _, step := range stage.steps {
-
-			logStep := logOperation.WithField("step", step.Name()).
-				WithField("stage", stage.name)
-			if step.condition != nil && !step.condition(processedOperation) {
-				logStep.Debugf("Skipping")
-				continue
-			}
-			logStep.Infof("Start step")
-
-			processedOperation, when, err = m.runStep(step, processedOperation, logStep)
-			if err != nil {
-				logStep.Errorf("Process operation failed: %s", err)
-				return 0, err
-			}
-			if processedOperation.State == domain.Failed || processedOperation.State == domain.Succeeded {
-				logStep.Infof("Operation %q got status %s. Process finished.", operation.ID, processedOperation.State)
-				return 0, nil
-			}
-
-			// the step needs a retry
-			if when > 0 {
-				return when, nil
-			}
-		}
-
-		processedOperation, err = m.saveFinishedStage(processedOperation, stage, logOperation)
-		if err != nil {
-			return time.Second, nil
-		}
+	processedOperation, when, err := m.engine.Run(*operation, logOperation)
+	if err != nil {
+		return 0, err
+	}
+	if processedOperation.State == domain.Failed || processedOperation.State == domain.Succeeded {
+		return 0, nil
+	}
+	// the step needs a retry
+	if when > 0 {
+		return when, nil
 	}
 
 	processedOperation.State = domain.Succeeded
@@ -167,44 +125,6 @@ func (m *Manager) Execute(operationID string) (time.Duration, error) {
 	return 0, nil
 }
 
-func (m *Manager) saveFinishedStage(operation internal.UpdatingOperation, s *stage, log logrus.FieldLogger) (internal.UpdatingOperation, error) {
-	operation.FinishStage(s.name)
-	op, err := m.operationStorage.UpdateUpdatingOperation(operation)
-	if err != nil {
-		log.Infof("Unable to save operation with finished stage %s: %s", s.name, err.Error())
-		return operation, err
-	}
-	log.Infof("Finished stage %s", s.name)
-	return *op, nil
-}
-
-func (m *Manager) runStep(step Step, operation internal.UpdatingOperation, logger logrus.FieldLogger) (internal.UpdatingOperation, time.Duration, error) {
-	begin := time.Now()
-	for {
-		start := time.Now()
-		processedOperation, when, err := step.Run(operation, logger)
-		m.publisher.Publish(context.TODO(), process.UpdatingStepProcessed{
-			OldOperation: operation,
-			Operation:    processedOperation,
-			StepProcessed: process.StepProcessed{
-				StepName: step.Name(),
-				Duration: time.Since(start),
-				When:     when,
-				Error:    err,
-			},
-		})
-
-		// break the loop if:
-		// - the step does not need a retry
-		// - step returns an error
-		// - the loop takes too much time (to not block the worker too long)
-		if when == 0 || err != nil || time.Since(begin) > time.Minute {
-			return processedOperation, when, err
-		}
-		time.Sleep(when / time.Duration(m.speedFactor))
-	}
-}
-
 func getComponent(componentProvider input.ComponentListProvider, component string,
 	kymaVersion internal.RuntimeVersionData, planName string) (*runtime.KymaComponent, error) {
 	allComponents, err := componentProvider.AllComponents(kymaVersion, planName)
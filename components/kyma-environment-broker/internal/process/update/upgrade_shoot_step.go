@@ -5,6 +5,7 @@ import (
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/input"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
@@ -104,11 +105,13 @@ func (s *UpgradeShootStep) createUpgradeShootInput(operation internal.UpdatingOp
 	// modify configuration
 	result := gqlschema.UpgradeShootInput{
 		GardenerConfig: &gqlschema.GardenerUpgradeInput{
-			OidcConfig:     fullInput.GardenerConfig.OidcConfig,
-			AutoScalerMax:  operation.UpdatingParameters.AutoScalerMax,
-			AutoScalerMin:  operation.UpdatingParameters.AutoScalerMin,
-			MaxSurge:       operation.UpdatingParameters.MaxSurge,
-			MaxUnavailable: operation.UpdatingParameters.MaxUnavailable,
+			OidcConfig:           fullInput.GardenerConfig.OidcConfig,
+			AutoScalerMax:        operation.UpdatingParameters.AutoScalerMax,
+			AutoScalerMin:        operation.UpdatingParameters.AutoScalerMin,
+			MaxSurge:             operation.UpdatingParameters.MaxSurge,
+			MaxUnavailable:       operation.UpdatingParameters.MaxUnavailable,
+			HibernationSchedules: input.HibernationSchedulesInput(operation.UpdatingParameters.HibernationSchedules),
+			WorkerPools:          input.WorkerPoolsInput(operation.UpdatingParameters.WorkerPools),
 		},
 		Administrators: fullInput.Administrators,
 	}
@@ -119,12 +122,14 @@ func (s *UpgradeShootStep) createUpgradeShootInput(operation internal.UpdatingOp
 
 func gardenerUpgradeInputToConfigInput(input gqlschema.UpgradeShootInput) *gqlschema.GardenerConfigInput {
 	result := &gqlschema.GardenerConfigInput{
-		MachineImage:        input.GardenerConfig.MachineImage,
-		MachineImageVersion: input.GardenerConfig.MachineImageVersion,
-		DiskType:            input.GardenerConfig.DiskType,
-		VolumeSizeGb:        input.GardenerConfig.VolumeSizeGb,
-		Purpose:             input.GardenerConfig.Purpose,
-		OidcConfig:          input.GardenerConfig.OidcConfig,
+		MachineImage:         input.GardenerConfig.MachineImage,
+		MachineImageVersion:  input.GardenerConfig.MachineImageVersion,
+		DiskType:             input.GardenerConfig.DiskType,
+		VolumeSizeGb:         input.GardenerConfig.VolumeSizeGb,
+		Purpose:              input.GardenerConfig.Purpose,
+		OidcConfig:           input.GardenerConfig.OidcConfig,
+		HibernationSchedules: input.GardenerConfig.HibernationSchedules,
+		WorkerPools:          input.GardenerConfig.WorkerPools,
 	}
 	if input.GardenerConfig.KubernetesVersion != nil {
 		result.KubernetesVersion = *input.GardenerConfig.KubernetesVersion
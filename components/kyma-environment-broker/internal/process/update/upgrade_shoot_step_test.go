@@ -71,6 +71,75 @@ func TestUpgradeShootStep_Run(t *testing.T) {
 	assert.NotEmpty(t, newOperation.ProvisionerOperationID)
 }
 
+func TestUpgradeShootStep_RunWithHibernationSchedules(t *testing.T) {
+	// given
+	memoryStorage := storage.NewMemoryStorage()
+	os := memoryStorage.Operations()
+	rs := memoryStorage.RuntimeStates()
+	cli := provisioner.NewFakeClient()
+	step := NewUpgradeShootStep(os, rs, cli)
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id")
+	operation.RuntimeID = "runtime-id"
+	operation.ProvisionerOperationID = ""
+	operation.UpdatingParameters.HibernationSchedules = []internal.HibernationScheduleDTO{
+		{Start: "00 20 * * 1,2,3,4,5", End: "00 08 * * 1,2,3,4,5"},
+	}
+	operation.InputCreator = fixInputCreator(t)
+	os.InsertUpdatingOperation(operation)
+	runtimeState := fixture.FixRuntimeState("runtime-id", "runtime-id", "provisioning-op-1")
+	runtimeState.ClusterConfig.OidcConfig = &gqlschema.OIDCConfigInput{
+		ClientID:  "clientID",
+		IssuerURL: "https://issuer.url",
+	}
+	rs.Insert(runtimeState)
+
+	// when
+	_, d, err := step.Run(operation, logrus.New())
+
+	// then
+	require.NoError(t, err)
+	assert.Zero(t, d)
+	req, _ := cli.LastShootUpgrade("runtime-id")
+	start, end := "00 20 * * 1,2,3,4,5", "00 08 * * 1,2,3,4,5"
+	assert.Equal(t, []*gqlschema.HibernationScheduleInput{
+		{Start: &start, End: &end},
+	}, req.GardenerConfig.HibernationSchedules)
+}
+
+func TestUpgradeShootStep_RunWithWorkerPools(t *testing.T) {
+	// given
+	memoryStorage := storage.NewMemoryStorage()
+	os := memoryStorage.Operations()
+	rs := memoryStorage.RuntimeStates()
+	cli := provisioner.NewFakeClient()
+	step := NewUpgradeShootStep(os, rs, cli)
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id")
+	operation.RuntimeID = "runtime-id"
+	operation.ProvisionerOperationID = ""
+	operation.UpdatingParameters.WorkerPools = []internal.WorkerPoolDTO{
+		{Name: "memory", MachineType: "m5.8xlarge", AutoScalerMin: 1, AutoScalerMax: 3},
+	}
+	operation.InputCreator = fixInputCreator(t)
+	os.InsertUpdatingOperation(operation)
+	runtimeState := fixture.FixRuntimeState("runtime-id", "runtime-id", "provisioning-op-1")
+	runtimeState.ClusterConfig.OidcConfig = &gqlschema.OIDCConfigInput{
+		ClientID:  "clientID",
+		IssuerURL: "https://issuer.url",
+	}
+	rs.Insert(runtimeState)
+
+	// when
+	_, d, err := step.Run(operation, logrus.New())
+
+	// then
+	require.NoError(t, err)
+	assert.Zero(t, d)
+	req, _ := cli.LastShootUpgrade("runtime-id")
+	assert.Equal(t, []*gqlschema.WorkerPoolInput{
+		{Name: "memory", MachineType: "m5.8xlarge", AutoScalerMin: 1, AutoScalerMax: 3},
+	}, req.GardenerConfig.WorkerPools)
+}
+
 func fixInputCreator(t *testing.T) internal.ProvisionerInputCreator {
 	optComponentsSvc := &inputAutomock.OptionalComponentService{}
 
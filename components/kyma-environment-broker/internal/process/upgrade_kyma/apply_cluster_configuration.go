@@ -11,6 +11,8 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/reconciler"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
@@ -51,6 +53,18 @@ func (s *ApplyClusterConfigurationStep) Run(operation internal.UpgradeKymaOperat
 		return s.operationManager.OperationFailed(operation, "invalid operation data - cannot create cluster configuration", err, log)
 	}
 
+	if operation.Rollback != nil {
+		state, err := s.runtimeStateStorage.GetByID(operation.Rollback.RuntimeStateID)
+		switch {
+		case dberr.IsNotFound(errors.Cause(err)):
+			return s.operationManager.OperationFailed(operation, "cannot restore components of the rolled back runtime", err, log)
+		case err != nil:
+			log.Errorf("cannot get runtime state %s: %s", operation.Rollback.RuntimeStateID, err)
+			return operation, 10 * time.Second, nil
+		}
+		s.restoreComponents(&clusterConfiguration, state, log)
+	}
+
 	if err := internal.CheckBTPCredsValid(clusterConfiguration); err != nil {
 		log.Errorf("Sanity check for BTP operator configuration failed: %s", err.Error())
 		return s.operationManager.OperationFailed(operation, "invalid BTP Operator configuration", err, log)
@@ -97,6 +111,16 @@ func (s *ApplyClusterConfigurationStep) Run(operation internal.UpgradeKymaOperat
 
 }
 
+// restoreComponents replaces the components of the cluster configuration with the components of the runtime state restored by the rollback
+func (s *ApplyClusterConfigurationStep) restoreComponents(cluster *reconcilerApi.Cluster, state internal.RuntimeState, log logrus.FieldLogger) {
+	if state.ClusterSetup == nil {
+		log.Infof("runtime state %s has no cluster configuration, only Kyma version %s is restored", state.ID, cluster.KymaConfig.Version)
+		return
+	}
+	log.Infof("restoring components of runtime state %s", state.ID)
+	cluster.KymaConfig.Components = state.ClusterSetup.KymaConfig.Components
+}
+
 func (s *ApplyClusterConfigurationStep) componentList(cluster reconcilerApi.Cluster) string {
 	vals := []string{}
 	for _, c := range cluster.KymaConfig.Components {
@@ -97,6 +97,10 @@ func (s *InitialisationStep) Run(operation internal.UpgradeKymaOperation, log lo
 			log.Infof("Skipping processing because orchestration %s was canceled", operation.OrchestrationID)
 			return s.operationManager.OperationCanceled(operation, fmt.Sprintf("orchestration %s was canceled", operation.OrchestrationID), log)
 		}
+		if orchestration.IsPaused() {
+			log.Infof("Postponing processing because orchestration %s is paused", operation.OrchestrationID)
+			return operation, s.timeSchedule.StatusCheck, nil
+		}
 
 		// Check concurrent operations and wait to finish before proceeding
 		// - unsuspension provisioning launched after suspension
@@ -1,8 +1,6 @@
 package upgrade_kyma
 
 import (
-	"context"
-	"sort"
 	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
@@ -19,14 +17,9 @@ type Step interface {
 
 type StepCondition func(operation internal.UpgradeKymaOperation) bool
 
-type StepWithCondition struct {
-	Step
-	condition StepCondition
-}
-
 type Manager struct {
 	log              logrus.FieldLogger
-	steps            map[int][]StepWithCondition
+	engine           *process.StagedEngine[internal.UpgradeKymaOperation]
 	operationStorage storage.Operations
 
 	publisher event.Publisher
@@ -34,8 +27,21 @@ type Manager struct {
 
 func NewManager(storage storage.Operations, pub event.Publisher, logger logrus.FieldLogger) *Manager {
 	return &Manager{
-		log:              logger,
-		steps:            make(map[int][]StepWithCondition, 0),
+		log: logger,
+		engine: process.NewStagedEngine(process.StagedEngineConfig[internal.UpgradeKymaOperation]{
+			Operation: func(op *internal.UpgradeKymaOperation) *internal.Operation {
+				return &op.Operation
+			},
+			Update: storage.UpdateUpgradeKymaOperation,
+			Steps:  storage,
+			StepProcessedEvent: func(old, processed internal.UpgradeKymaOperation, step process.StepProcessed) interface{} {
+				return process.UpgradeKymaStepProcessed{
+					OldOperation:  old,
+					Operation:     processed,
+					StepProcessed: step,
+				}
+			},
+		}, pub),
 		operationStorage: storage,
 		publisher:        pub,
 	}
@@ -49,26 +55,15 @@ func (m *Manager) AddStep(weight int, step Step, cnd StepCondition) {
 	if weight <= 0 {
 		weight = 1
 	}
-	m.steps[weight] = append(m.steps[weight], StepWithCondition{
-		Step:      step,
-		condition: cnd,
-	})
+	m.engine.AddWeightedStep(weight, step, process.StepCondition[internal.UpgradeKymaOperation](cnd))
+}
+
+func (m *Manager) GetAllStages() []string {
+	return m.engine.GetAllStages()
 }
 
-func (m *Manager) runStep(step Step, operation internal.UpgradeKymaOperation, logger logrus.FieldLogger) (internal.UpgradeKymaOperation, time.Duration, error) {
-	start := time.Now()
-	processedOperation, when, err := step.Run(operation, logger)
-	m.publisher.Publish(context.TODO(), process.UpgradeKymaStepProcessed{
-		OldOperation: operation,
-		Operation:    processedOperation,
-		StepProcessed: process.StepProcessed{
-			StepName: step.Name(),
-			Duration: time.Since(start),
-			When:     when,
-			Error:    err,
-		},
-	})
-	return processedOperation, when, err
+func (m *Manager) GetAllSteps() []string {
+	return m.engine.GetAllSteps()
 }
 
 func (m *Manager) Execute(operationID string) (time.Duration, error) {
@@ -82,36 +77,18 @@ func (m *Manager) Execute(operationID string) (time.Duration, error) {
 		return 0, nil
 	}
 
-	var when time.Duration
 	logOperation := m.log.WithFields(logrus.Fields{"operation": operationID, "instanceID": operation.InstanceID})
 
 	logOperation.Info("Start process operation steps")
-	for _, weightStep := range m.sortWeight() {
-		steps := m.steps[weightStep]
-		for _, step := range steps {
-			if step.condition != nil && !step.condition(operation) {
-				continue
-			}
-			logStep := logOperation.WithField("step", step.Name())
-			logStep.Infof("Start step")
-
-			operation, when, err = m.runStep(step, operation, logStep)
-			if err != nil {
-				logStep.Errorf("Process operation failed: %s", err)
-				return 0, err
-			}
-			if operation.IsFinished() {
-				logStep.Infof("Operation %q got status %s. Process finished.", operation.Operation.ID, operation.State)
-				return 0, nil
-			}
-			if when == 0 {
-				logStep.Info("Process operation successful")
-				continue
-			}
-
-			logStep.Infof("Process operation will be repeated in %s ...", when)
-			return when, nil
-		}
+	operation, when, err := m.engine.Run(operation, logOperation)
+	if err != nil {
+		return 0, err
+	}
+	if operation.IsFinished() {
+		return 0, nil
+	}
+	if when > 0 {
+		return when, nil
 	}
 
 	logOperation.Infof("Operation %q got status %s. All steps finished.", operation.Operation.ID, operation.State)
@@ -133,13 +110,3 @@ func (m Manager) Reschedule(operationID string, maintenanceWindowBegin, maintena
 
 	return err
 }
-
-func (m *Manager) sortWeight() []int {
-	var weight []int
-	for w := range m.steps {
-		weight = append(weight, w)
-	}
-	sort.Ints(weight)
-
-	return weight
-}
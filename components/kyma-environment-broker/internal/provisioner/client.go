@@ -26,6 +26,8 @@ type Client interface {
 	DeprovisionRuntime(accountID, runtimeID string) (string, error)
 	UpgradeRuntime(accountID, runtimeID string, config schema.UpgradeRuntimeInput) (schema.OperationStatus, error)
 	UpgradeShoot(accountID, runtimeID string, config schema.UpgradeShootInput) (schema.OperationStatus, error)
+	HibernateRuntime(accountID, runtimeID string) (schema.OperationStatus, error)
+	WakeUpRuntime(accountID, runtimeID string) (schema.OperationStatus, error)
 	ReconnectRuntimeAgent(accountID, runtimeID string) (string, error)
 	RuntimeOperationStatus(accountID, operationID string) (schema.OperationStatus, error)
 	RuntimeStatus(accountID, runtimeID string) (schema.RuntimeStatus, error)
@@ -121,6 +123,32 @@ func (c *client) UpgradeShoot(accountID, runtimeID string, config schema.Upgrade
 	return res, nil
 }
 
+func (c *client) HibernateRuntime(accountID, runtimeID string) (schema.OperationStatus, error) {
+	query := c.queryProvider.hibernateRuntime(runtimeID)
+	req := gcli.NewRequest(query)
+	req.Header.Add(accountIDKey, accountID)
+
+	var res schema.OperationStatus
+	err := c.executeRequest(req, &res)
+	if err != nil {
+		return schema.OperationStatus{}, errors.Wrap(err, "Failed to hibernate Runtime")
+	}
+	return res, nil
+}
+
+func (c *client) WakeUpRuntime(accountID, runtimeID string) (schema.OperationStatus, error) {
+	query := c.queryProvider.wakeUpRuntime(runtimeID)
+	req := gcli.NewRequest(query)
+	req.Header.Add(accountIDKey, accountID)
+
+	var res schema.OperationStatus
+	err := c.executeRequest(req, &res)
+	if err != nil {
+		return schema.OperationStatus{}, errors.Wrap(err, "Failed to wake up Runtime")
+	}
+	return res, nil
+}
+
 func (c *client) ReconnectRuntimeAgent(accountID, runtimeID string) (string, error) {
 	query := c.queryProvider.reconnectRuntimeAgent(runtimeID)
 	req := gcli.NewRequest(query)
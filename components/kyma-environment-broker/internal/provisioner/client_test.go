@@ -2,6 +2,7 @@ package provisioner
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -25,6 +26,7 @@ const (
 	provisionRuntimeOperationID   = "c89f7862-0ef9-4d4e-bc82-afbc5ac98b8d"
 	upgradeRuntimeOperationID     = "74f47e0a-9a76-4336-9974-70705500a981"
 	deprovisionRuntimeOperationID = "f9f7b734-7538-419c-8ac1-37060c60531a"
+	hibernateRuntimeOperationID   = "0a8e7b3c-4d6f-4c3e-9b1e-5d2f7a6c8e41"
 )
 
 var (
@@ -231,6 +233,92 @@ func TestClient_UpgradeShoot(t *testing.T) {
 	})
 }
 
+func TestClient_HibernateRuntime(t *testing.T) {
+	t.Run("should trigger hibernation", func(t *testing.T) {
+		// given
+		tr := &testResolver{t: t, runtime: &testRuntime{}}
+		testServer := fixHTTPServer(tr)
+		defer testServer.Close()
+
+		client := NewProvisionerClient(testServer.URL, false)
+
+		// when
+		status, err := client.HibernateRuntime(testAccountID, provisionRuntimeID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, ptr.String(hibernateRuntimeOperationID), status.ID)
+		assert.Equal(t, schema.OperationStateInProgress, status.State)
+		assert.Equal(t, schema.OperationTypeHibernate, status.Operation)
+		assert.Equal(t, ptr.String(provisionRuntimeID), status.RuntimeID)
+	})
+
+	t.Run("provisioner should return error", func(t *testing.T) {
+		// given
+		tr := &testResolver{t: t, runtime: &testRuntime{}}
+		testServer := fixHTTPServer(tr)
+		defer testServer.Close()
+
+		client := NewProvisionerClient(testServer.URL, false)
+		tr.failed = true
+
+		// when
+		status, err := client.HibernateRuntime(testAccountID, provisionRuntimeID)
+
+		// then
+		assert.Error(t, err)
+		assert.Empty(t, status)
+	})
+}
+
+func TestClient_WakeUpRuntime(t *testing.T) {
+	t.Run("should trigger wake up", func(t *testing.T) {
+		// given
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Query string `json:"query"`
+			}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			assert.NoError(t, err)
+			assert.Equal(t, testAccountID, r.Header.Get(accountIDKey))
+			assert.Contains(t, body.Query, fmt.Sprintf(`wakeUpRuntime(id: "%s")`, provisionRuntimeID))
+
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"data":{"result":{"id":"%s","operation":"WakeUp","state":"InProgress","runtimeID":"%s"}}}`, upgradeRuntimeOperationID, provisionRuntimeID)
+		}))
+		defer testServer.Close()
+
+		client := NewProvisionerClient(testServer.URL, false)
+
+		// when
+		status, err := client.WakeUpRuntime(testAccountID, provisionRuntimeID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, ptr.String(upgradeRuntimeOperationID), status.ID)
+		assert.Equal(t, schema.OperationStateInProgress, status.State)
+		assert.Equal(t, ptr.String(provisionRuntimeID), status.RuntimeID)
+	})
+
+	t.Run("provisioner should return error", func(t *testing.T) {
+		// given
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"errors":[{"message":"cannot wake up cluster: cluster is not hibernated","extensions":{"error_code":400}}]}`)
+		}))
+		defer testServer.Close()
+
+		client := NewProvisionerClient(testServer.URL, false)
+
+		// when
+		status, err := client.WakeUpRuntime(testAccountID, provisionRuntimeID)
+
+		// then
+		assert.Error(t, err)
+		assert.Empty(t, status)
+	})
+}
+
 func TestClient_ReconnectRuntimeAgent(t *testing.T) {
 	t.Run("should reconnect runtime agent", func(t *testing.T) {
 		// Given
@@ -572,7 +660,22 @@ func (tmr testMutationResolver) DeprovisionRuntime(_ context.Context, id string)
 	return tmr.runtime.deprovisionOperationID, nil
 }
 
-func (tmr testMutationResolver) HibernateRuntime(ctx context.Context, id string) (*schema.OperationStatus, error) {
+func (tmr testMutationResolver) HibernateRuntime(_ context.Context, id string) (*schema.OperationStatus, error) {
+	tmr.t.Log("HibernateRuntime testMutationResolver")
+
+	if tmr.failed {
+		return nil, fmt.Errorf("hibernation failed for %s", id)
+	}
+
+	return &schema.OperationStatus{
+		ID:        ptr.String(hibernateRuntimeOperationID),
+		State:     schema.OperationStateInProgress,
+		Operation: schema.OperationTypeHibernate,
+		RuntimeID: ptr.String(id),
+	}, nil
+}
+
+func (tmr testMutationResolver) WakeUpRuntime(_ context.Context, id string) (*schema.OperationStatus, error) {
 	return nil, errors.New("not implemented")
 }
 
@@ -643,6 +746,14 @@ func (tqr testQueryResolver) RuntimeOperationStatus(_ context.Context, id string
 	return nil, nil
 }
 
+func (tqr testQueryResolver) Runtimes(_ context.Context, filter *schema.RuntimesFilter, first *int, after *string) (*schema.RuntimeConnection, error) {
+	return nil, nil
+}
+
+func (tqr testQueryResolver) Operations(_ context.Context, runtimeID *string, operationType *schema.OperationType, state *schema.OperationState, since *string, first *int, after *string) (*schema.OperationConnection, error) {
+	return nil, nil
+}
+
 func fixProvisionRuntimeInput() schema.ProvisionRuntimeInput {
 	return schema.ProvisionRuntimeInput{
 		RuntimeInput: &schema.RuntimeInput{
@@ -156,6 +156,40 @@ func (c *FakeClient) DeprovisionRuntime(accountID, runtimeID string) (string, er
 	return opId, nil
 }
 
+func (c *FakeClient) HibernateRuntime(accountID, runtimeID string) (schema.OperationStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	opId := uuid.New().String()
+	c.operations[opId] = schema.OperationStatus{
+		ID:        &opId,
+		Operation: schema.OperationTypeHibernate,
+		RuntimeID: &runtimeID,
+		State:     schema.OperationStateInProgress,
+	}
+	return schema.OperationStatus{
+		RuntimeID: &runtimeID,
+		ID:        &opId,
+	}, nil
+}
+
+func (c *FakeClient) WakeUpRuntime(accountID, runtimeID string) (schema.OperationStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	opId := uuid.New().String()
+	c.operations[opId] = schema.OperationStatus{
+		ID:        &opId,
+		Operation: schema.OperationTypeWakeUp,
+		RuntimeID: &runtimeID,
+		State:     schema.OperationStateInProgress,
+	}
+	return schema.OperationStatus{
+		RuntimeID: &runtimeID,
+		ID:        &opId,
+	}, nil
+}
+
 func (c *FakeClient) ReconnectRuntimeAgent(accountID, runtimeID string) (string, error) {
 	return "", fmt.Errorf("not implemented")
 }
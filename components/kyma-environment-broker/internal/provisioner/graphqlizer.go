@@ -129,12 +129,67 @@ func (g *Graphqlizer) GardenerConfigInputToGraphQL(in gqlschema.GardenerConfigIn
 			usernamePrefix: "{{ .OidcConfig.UsernamePrefix }}",
 		}
 		{{- end }}
+		{{- with HibernationSchedulesInputToGraphQL .HibernationSchedules }}
+		hibernationSchedules: {{ . }},
+		{{- end }}
+		{{- with WorkerPoolsInputToGraphQL .WorkerPools }}
+		workerPools: {{ . }},
+		{{- end }}
 		{{- if .DNSConfig }}
 		dnsConfig: {{ DNSConfigInputToGraphQL .DNSConfig }}
 		{{- end }}
 	}`)
 }
 
+// HibernationSchedulesInputToGraphQL returns an empty string for nil schedules, so the field can be omitted,
+// an empty list is rendered as it removes the schedules on update
+func (g *Graphqlizer) HibernationSchedulesInputToGraphQL(in []*gqlschema.HibernationScheduleInput) (string, error) {
+	if in == nil {
+		return "", nil
+	}
+	return g.genericToGraphQL(in, `[
+			{{- range . }}
+			{
+				start: {{ .Start | marshal }},
+				end: {{ .End | marshal }},
+				location: {{ .Location | marshal }},
+			}
+			{{- end }}
+		]`)
+}
+
+// WorkerPoolsInputToGraphQL returns an empty string for nil worker pools, so the field can be omitted,
+// an empty list is rendered as it removes the worker pools on update
+func (g *Graphqlizer) WorkerPoolsInputToGraphQL(in []*gqlschema.WorkerPoolInput) (string, error) {
+	if in == nil {
+		return "", nil
+	}
+	return g.genericToGraphQL(in, `[
+			{{- range . }}
+			{
+				name: "{{ .Name }}",
+				machineType: "{{ .MachineType }}",
+				autoScalerMin: {{ .AutoScalerMin }},
+				autoScalerMax: {{ .AutoScalerMax }},
+				{{- if .Labels }}
+				labels: {{ LabelsToGQL .Labels }},
+				{{- end }}
+				{{- with .Taints }}
+				taints: [
+					{{- range . }}
+					{
+						key: "{{ .Key }}",
+						value: {{ .Value | marshal }},
+						effect: "{{ .Effect }}",
+					}
+					{{- end }}
+				],
+				{{- end }}
+			}
+			{{- end }}
+		]`)
+}
+
 func (g *Graphqlizer) DNSConfigInputToGraphQL(in gqlschema.DNSConfigInput) (string, error) {
 	return g.genericToGraphQL(in, `{
 			domain: "{{ .Domain }}",
@@ -289,6 +344,12 @@ func (g *Graphqlizer) GardenerUpgradeInputToGraphQL(in gqlschema.GardenerUpgrade
 			usernamePrefix: "{{ .OidcConfig.UsernamePrefix }}",
 		},
 		{{- end }}
+		{{- with HibernationSchedulesInputToGraphQL .HibernationSchedules }}
+		hibernationSchedules: {{ . }},
+		{{- end }}
+		{{- with WorkerPoolsInputToGraphQL .WorkerPools }}
+		workerPools: {{ . }},
+		{{- end }}
 	}`)
 }
 
@@ -352,6 +413,8 @@ func (g *Graphqlizer) genericToGraphQL(obj interface{}, tmpl string) (string, er
 	fm["AWSProviderConfigInputToGraphQL"] = g.AWSProviderConfigInputToGraphQL
 	fm["OpenStackProviderConfigInputToGraphQL"] = g.OpenStackProviderConfigInputToGraphQL
 	fm["DNSConfigInputToGraphQL"] = g.DNSConfigInputToGraphQL
+	fm["HibernationSchedulesInputToGraphQL"] = g.HibernationSchedulesInputToGraphQL
+	fm["WorkerPoolsInputToGraphQL"] = g.WorkerPoolsInputToGraphQL
 	fm["LabelsToGQL"] = g.LabelsToGQL
 	fm["strQuote"] = strconv.Quote
 
@@ -352,6 +352,140 @@ func Test_GardenerConfigInputToGraphQLWithMachineImage(t *testing.T) {
 	assert.Equal(t, exp, got)
 }
 
+func Test_GardenerConfigInputToGraphQLWithHibernationSchedules(t *testing.T) {
+	// given
+	sut := Graphqlizer{}
+	exp := `{
+		name: "c-90a3016",
+		kubernetesVersion: "1.18",
+		volumeSizeGB: 50,
+		machineType: "Standard_D4_v3",
+		region: "europe",
+		provider: "Azure",
+		diskType: "Standard_LRS",
+		targetSecret: "scr",
+		workerCidr: "10.250.0.0/19",
+		autoScalerMin: 0,
+		autoScalerMax: 0,
+		maxSurge: 0,
+		maxUnavailable: 0,
+		hibernationSchedules: [
+			{
+				start: "00 20 * * 1,2,3,4,5",
+				end: "00 08 * * 1,2,3,4,5",
+				location: "Europe/Berlin",
+			}
+			{
+				start: "00 18 * * 5",
+				end: null,
+				location: null,
+			}
+		],
+	}`
+
+	// when
+	got, err := sut.GardenerConfigInputToGraphQL(gqlschema.GardenerConfigInput{
+		Name:              "c-90a3016",
+		Region:            "europe",
+		VolumeSizeGb:      ptr.Integer(50),
+		WorkerCidr:        "10.250.0.0/19",
+		Provider:          "Azure",
+		DiskType:          ptr.String("Standard_LRS"),
+		TargetSecret:      "scr",
+		MachineType:       "Standard_D4_v3",
+		KubernetesVersion: "1.18",
+		HibernationSchedules: []*gqlschema.HibernationScheduleInput{
+			{
+				Start:    strPrt("00 20 * * 1,2,3,4,5"),
+				End:      strPrt("00 08 * * 1,2,3,4,5"),
+				Location: strPrt("Europe/Berlin"),
+			},
+			{
+				Start: strPrt("00 18 * * 5"),
+			},
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, exp, got)
+}
+
+func Test_GardenerConfigInputToGraphQLWithWorkerPools(t *testing.T) {
+	// given
+	sut := Graphqlizer{}
+	exp := `{
+		name: "c-90a3016",
+		kubernetesVersion: "1.18",
+		volumeSizeGB: 50,
+		machineType: "Standard_D4_v3",
+		region: "europe",
+		provider: "Azure",
+		diskType: "Standard_LRS",
+		targetSecret: "scr",
+		workerCidr: "10.250.0.0/19",
+		autoScalerMin: 0,
+		autoScalerMax: 0,
+		maxSurge: 0,
+		maxUnavailable: 0,
+		workerPools: [
+			{
+				name: "gpu",
+				machineType: "Standard_NC6s_v3",
+				autoScalerMin: 0,
+				autoScalerMax: 2,
+				labels: {accelerator:"gpu",},
+				taints: [
+					{
+						key: "nvidia.com/gpu",
+						value: "present",
+						effect: "NoSchedule",
+					}
+				],
+			}
+			{
+				name: "memory",
+				machineType: "Standard_E16_v3",
+				autoScalerMin: 1,
+				autoScalerMax: 3,
+			}
+		],
+	}`
+
+	// when
+	got, err := sut.GardenerConfigInputToGraphQL(gqlschema.GardenerConfigInput{
+		Name:              "c-90a3016",
+		Region:            "europe",
+		VolumeSizeGb:      ptr.Integer(50),
+		WorkerCidr:        "10.250.0.0/19",
+		Provider:          "Azure",
+		DiskType:          ptr.String("Standard_LRS"),
+		TargetSecret:      "scr",
+		MachineType:       "Standard_D4_v3",
+		KubernetesVersion: "1.18",
+		WorkerPools: []*gqlschema.WorkerPoolInput{
+			{
+				Name:          "gpu",
+				MachineType:   "Standard_NC6s_v3",
+				AutoScalerMin: 0,
+				AutoScalerMax: 2,
+				Labels:        gqlschema.Labels{"accelerator": "gpu"},
+				Taints:        []*gqlschema.TaintInput{{Key: "nvidia.com/gpu", Value: strPrt("present"), Effect: "NoSchedule"}},
+			},
+			{
+				Name:          "memory",
+				MachineType:   "Standard_E16_v3",
+				AutoScalerMin: 1,
+				AutoScalerMax: 3,
+			},
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, exp, got)
+}
+
 func Test_LabelsToGQL(t *testing.T) {
 
 	sut := Graphqlizer{}
@@ -573,6 +707,50 @@ func Test_UpgradeShootInputToGraphQL(t *testing.T) {
 	assert.Equal(t, exp, got)
 }
 
+func Test_UpgradeShootInputToGraphQLRemovingHibernationSchedules(t *testing.T) {
+	// given
+	sut := Graphqlizer{}
+	exp := `{
+	gardenerConfig: {
+		hibernationSchedules: [
+		],
+	},
+}`
+
+	// when
+	got, err := sut.UpgradeShootInputToGraphQL(gqlschema.UpgradeShootInput{
+		GardenerConfig: &gqlschema.GardenerUpgradeInput{
+			HibernationSchedules: []*gqlschema.HibernationScheduleInput{},
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, exp, got)
+}
+
+func Test_UpgradeShootInputToGraphQLRemovingWorkerPools(t *testing.T) {
+	// given
+	sut := Graphqlizer{}
+	exp := `{
+	gardenerConfig: {
+		workerPools: [
+		],
+	},
+}`
+
+	// when
+	got, err := sut.UpgradeShootInputToGraphQL(gqlschema.UpgradeShootInput{
+		GardenerConfig: &gqlschema.GardenerUpgradeInput{
+			WorkerPools: []*gqlschema.WorkerPoolInput{},
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, exp, got)
+}
+
 func TestOpenstack(t *testing.T) {
 	// given
 	input := gqlschema.ProviderSpecificInput{
@@ -34,6 +34,22 @@ func (qp queryProvider) deprovisionRuntime(runtimeID string) string {
 }`, runtimeID)
 }
 
+func (qp queryProvider) hibernateRuntime(runtimeID string) string {
+	return fmt.Sprintf(`mutation {
+	result: hibernateRuntime(id: "%s") {
+		%s
+}
+}`, runtimeID, operationStatusData())
+}
+
+func (qp queryProvider) wakeUpRuntime(runtimeID string) string {
+	return fmt.Sprintf(`mutation {
+	result: wakeUpRuntime(id: "%s") {
+		%s
+}
+}`, runtimeID, operationStatusData())
+}
+
 func (qp queryProvider) reconnectRuntimeAgent(runtimeID string) string {
 	return fmt.Sprintf(`mutation {
 	result: reconnectRuntimeAgent(id: "%s")
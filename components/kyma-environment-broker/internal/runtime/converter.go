@@ -1,6 +1,8 @@
 package runtime
 
 import (
+	"sort"
+
 	pkg "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
@@ -15,6 +17,7 @@ type Converter interface {
 	ApplyUpdateOperations(dto *pkg.RuntimeDTO, oprs []internal.UpdatingOperation, totalCount int)
 	ApplySuspensionOperations(dto *pkg.RuntimeDTO, oprs []internal.DeprovisioningOperation)
 	ApplyUnsuspensionOperations(dto *pkg.RuntimeDTO, oprs []internal.ProvisioningOperation)
+	ApplyHibernationOperations(dto *pkg.RuntimeDTO, oprs []internal.HibernationOperation)
 }
 
 type converter struct {
@@ -83,10 +86,21 @@ func (c *converter) NewDTO(instance internal.Instance) (pkg.RuntimeDTO, error) {
 	}
 
 	c.setRegionOrDefault(instance, &toReturn)
+	c.setHibernationSchedules(instance, &toReturn)
 
 	return toReturn, nil
 }
 
+func (c *converter) setHibernationSchedules(instance internal.Instance, dto *pkg.RuntimeDTO) {
+	for _, schedule := range instance.Parameters.Parameters.HibernationSchedules {
+		dto.HibernationSchedules = append(dto.HibernationSchedules, pkg.HibernationSchedule{
+			Start:    schedule.Start,
+			End:      schedule.End,
+			Location: schedule.Location,
+		})
+	}
+}
+
 func (c *converter) ApplyUpgradingKymaOperations(dto *pkg.RuntimeDTO, oprs []internal.UpgradeKymaOperation, totalCount int) {
 	if len(oprs) <= 0 {
 		return
@@ -160,6 +174,38 @@ func (c *converter) ApplyUnsuspensionOperations(dto *pkg.RuntimeDTO, oprs []inte
 	c.adjustRuntimeState(dto)
 }
 
+// ApplyHibernationOperations adds hibernate operations to the suspensions and wake up operations to the unsuspensions of the runtime
+func (c *converter) ApplyHibernationOperations(dto *pkg.RuntimeDTO, oprs []internal.HibernationOperation) {
+	if len(oprs) <= 0 {
+		return
+	}
+	for _, o := range oprs {
+		op := pkg.Operation{}
+		c.applyOperation(&o.Operation, &op)
+		switch o.Type {
+		case internal.OperationTypeHibernate:
+			dto.Status.Suspension = appendOperationData(dto.Status.Suspension, op)
+		case internal.OperationTypeWakeUp:
+			dto.Status.Unsuspension = appendOperationData(dto.Status.Unsuspension, op)
+		}
+	}
+	c.adjustRuntimeState(dto)
+}
+
+// appendOperationData adds the operation keeping the latest operation first
+func appendOperationData(data *pkg.OperationsData, op pkg.Operation) *pkg.OperationsData {
+	if data == nil {
+		data = &pkg.OperationsData{Data: make([]pkg.Operation, 0)}
+	}
+	data.Data = append(data.Data, op)
+	sort.SliceStable(data.Data, func(i, j int) bool {
+		return data.Data[i].CreatedAt.After(data.Data[j].CreatedAt)
+	})
+	data.Count = len(data.Data)
+	data.TotalCount++
+	return data
+}
+
 func (c *converter) ApplyUpdateOperations(dto *pkg.RuntimeDTO, oprs []internal.UpdatingOperation, totalCount int) {
 	if len(oprs) <= 0 {
 		return
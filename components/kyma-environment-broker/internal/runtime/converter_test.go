@@ -159,6 +159,23 @@ func TestConverting_SuspendedAndUpdateFAiled(t *testing.T) {
 	assert.Equal(t, runtime.StateSuspended, dto.Status.State)
 }
 
+func TestConverting_HibernationSchedules(t *testing.T) {
+	// given
+	instance := fixInstance()
+	instance.Parameters.Parameters.HibernationSchedules = []internal.HibernationScheduleDTO{
+		{Start: "00 20 * * 1,2,3,4,5", End: "00 08 * * 1,2,3,4,5", Location: "Europe/Berlin"},
+	}
+	svc := NewConverter("eu")
+
+	// when
+	dto, _ := svc.NewDTO(instance)
+
+	// then
+	assert.Equal(t, []runtime.HibernationSchedule{
+		{Start: "00 20 * * 1,2,3,4,5", End: "00 08 * * 1,2,3,4,5", Location: "Europe/Berlin"},
+	}, dto.HibernationSchedules)
+}
+
 func fixSuspensionOperation(state domain.LastOperationState, createdAt time.Time) []internal.DeprovisioningOperation {
 	return []internal.DeprovisioningOperation{{
 		Operation: internal.Operation{
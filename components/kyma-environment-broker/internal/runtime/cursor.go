@@ -0,0 +1,184 @@
+package runtime
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	pkg "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/pkg/errors"
+)
+
+// cursor is the position of the last runtime of a page, it is opaque to the clients
+type cursor struct {
+	Sort       pkg.SortField `json:"s"`
+	SortValue  string        `json:"v"`
+	InstanceID string        `json:"i"`
+}
+
+// alwaysLoadedColumns are needed for the cursor and to identify the runtime
+var alwaysLoadedColumns = []string{"instance_id", "created_at", "global_account_id"}
+
+// runtimeFieldColumns maps the fields of the runtime to the instance columns they are built from
+var runtimeFieldColumns = map[string][]string{
+	"instanceID":                  {},
+	"runtimeID":                   {"runtime_id"},
+	"globalAccountID":             {},
+	"subscriptionGlobalAccountID": {"subscription_global_account_id"},
+	"subAccountID":                {"sub_account_id"},
+	"region":                      {"provider_region"},
+	"subAccountRegion":            {"provisioning_parameters"},
+	"shootName":                   {},
+	"serviceClassID":              {"service_id"},
+	"serviceClassName":            {"service_name"},
+	"servicePlanID":               {"service_plan_id"},
+	"servicePlanName":             {"service_plan_name"},
+	"provider":                    {"provider"},
+	"status":                      {"updated_at"},
+	"userID":                      {"provisioning_parameters"},
+	"avsInternalEvaluationID":     {},
+	"kymaVersion":                 {},
+	"kymaConfig":                  {"runtime_id"},
+	"clusterConfig":               {"runtime_id"},
+	"hibernationSchedules":        {"provisioning_parameters"},
+}
+
+// fieldSet holds the requested fields of the runtimes, nil means all fields
+type fieldSet map[string]bool
+
+func (f fieldSet) has(fields ...string) bool {
+	if f == nil {
+		return true
+	}
+	for _, field := range fields {
+		if f[field] {
+			return true
+		}
+	}
+	return false
+}
+
+// columns returns the instance columns needed for the fields, nil means all columns
+func (f fieldSet) columns() []string {
+	if f == nil {
+		return nil
+	}
+	columns := append([]string{}, alwaysLoadedColumns...)
+	added := map[string]bool{}
+	for _, column := range columns {
+		added[column] = true
+	}
+	for field := range f {
+		for _, column := range runtimeFieldColumns[field] {
+			if !added[column] {
+				added[column] = true
+				columns = append(columns, column)
+			}
+		}
+	}
+	return columns
+}
+
+// project returns the runtime with the requested fields only
+func (f fieldSet) project(dto pkg.RuntimeDTO) (interface{}, error) {
+	if f == nil {
+		return dto, nil
+	}
+	data, err := json.Marshal(dto)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshaling runtime")
+	}
+	all := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, errors.Wrap(err, "while unmarshaling runtime")
+	}
+	projection := make(map[string]json.RawMessage, len(f))
+	for field := range f {
+		if value, found := all[field]; found {
+			projection[field] = value
+		}
+	}
+	return projection, nil
+}
+
+func getFields(req *http.Request) (fieldSet, error) {
+	params := req.URL.Query()[pkg.FieldsParam]
+	if len(params) == 0 {
+		return nil, nil
+	}
+	fields := fieldSet{}
+	for _, param := range params {
+		for _, field := range strings.Split(param, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				continue
+			}
+			if _, found := runtimeFieldColumns[field]; !found {
+				return nil, fmt.Errorf("unknown field %s", field)
+			}
+			fields[field] = true
+		}
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+func getSortField(req *http.Request) (pkg.SortField, dbmodel.InstanceSortField, error) {
+	sort := pkg.SortField(req.URL.Query().Get(pkg.SortParam))
+	switch sort {
+	case "", pkg.SortByCreatedAt:
+		return pkg.SortByCreatedAt, dbmodel.InstanceSortByCreatedAt, nil
+	case pkg.SortByGlobalAccountID:
+		return sort, dbmodel.InstanceSortByGlobalAccountID, nil
+	case pkg.SortByState:
+		return sort, dbmodel.InstanceSortByState, nil
+	default:
+		return "", "", fmt.Errorf("unsupported sort field %s", sort)
+	}
+}
+
+func decodeCursor(value string, sort pkg.SortField) (*dbmodel.InstanceCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	c := cursor{}
+	if err := json.Unmarshal(data, &c); err != nil || c.InstanceID == "" {
+		return nil, errors.New("invalid cursor")
+	}
+	if c.Sort != sort {
+		return nil, fmt.Errorf("cursor was created for the runtimes sorted by %s", c.Sort)
+	}
+
+	return &dbmodel.InstanceCursor{SortValue: c.SortValue, InstanceID: c.InstanceID}, nil
+}
+
+// nextCursor returns the cursor pointing at the given instance, which is the last one of the page
+func (h *Handler) nextCursor(instance internal.Instance, sort pkg.SortField) (string, error) {
+	c := cursor{Sort: sort, InstanceID: instance.InstanceID}
+	switch sort {
+	case pkg.SortByGlobalAccountID:
+		c.SortValue = instance.GlobalAccountID
+	case pkg.SortByState:
+		lastOp, err := h.operationsDb.GetLastOperation(instance.InstanceID)
+		if err != nil {
+			return "", errors.Wrapf(err, "while fetching last operation for instance %s", instance.InstanceID)
+		}
+		c.SortValue = string(dbmodel.InstanceStateForOperation(lastOp.Type, lastOp.State))
+	default:
+		c.SortValue = instance.CreatedAt.Format(time.RFC3339Nano)
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", errors.Wrap(err, "while marshaling cursor")
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
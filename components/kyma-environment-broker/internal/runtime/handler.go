@@ -23,6 +23,7 @@ type Handler struct {
 	operationsDb    storage.Operations
 	runtimeStatesDb storage.RuntimeStates
 	converter       Converter
+	watchConfig     WatchConfig
 
 	defaultMaxPage int
 }
@@ -33,25 +34,62 @@ func NewHandler(instanceDb storage.Instances, operationDb storage.Operations, ru
 		operationsDb:    operationDb,
 		runtimeStatesDb: runtimeStatesDb,
 		converter:       NewConverter(defaultRequestRegion),
+		watchConfig:     DefaultWatchConfig(),
 		defaultMaxPage:  defaultMaxPage,
 	}
 }
 
+func (h *Handler) WithWatchConfig(cfg WatchConfig) *Handler {
+	h.watchConfig = cfg
+
+	return h
+}
+
 func (h *Handler) AttachRoutes(router *mux.Router) {
 	router.HandleFunc("/runtimes", h.getRuntimes)
 }
 
 func (h *Handler) getRuntimes(w http.ResponseWriter, req *http.Request) {
+	if getBoolParam(pkg.WatchParam, req) {
+		h.watchRuntimes(w, req)
+		return
+	}
 	toReturn := make([]pkg.RuntimeDTO, 0)
+	// changes made while listing are streamed again by the watch started from this version
+	resourceVersion := newResourceVersion(time.Now().Add(-h.watchConfig.SafetyWindow))
 
 	pageSize, page, err := pagination.ExtractPaginationConfigFromRequest(req, h.defaultMaxPage)
 	if err != nil {
 		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrap(err, "while getting query parameters"))
 		return
 	}
+	sort, sortField, err := getSortField(req)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrap(err, "while getting query parameters"))
+		return
+	}
+	fields, err := getFields(req)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrap(err, "while getting query parameters"))
+		return
+	}
 	filter := h.getFilters(req)
 	filter.PageSize = pageSize
 	filter.Page = page
+	filter.Sort = sortField
+	filter.Columns = fields.columns()
+	if value := req.URL.Query().Get(pkg.CursorParam); value != "" {
+		if req.URL.Query().Has(pagination.PageParam) {
+			httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.New("cursor and page parameters cannot be used together"))
+			return
+		}
+		filter.After, err = decodeCursor(value, sort)
+		if err != nil {
+			httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrap(err, "while getting query parameters"))
+			return
+		}
+		filter.Page = 0
+	}
 	opDetail := getOpDetail(req)
 	kymaConfig := getBoolParam(pkg.KymaConfigParam, req)
 	clusterConfig := getBoolParam(pkg.ClusterConfigParam, req)
@@ -63,43 +101,94 @@ func (h *Handler) getRuntimes(w http.ResponseWriter, req *http.Request) {
 	}
 
 	for _, instance := range instances {
-		dto, err := h.converter.NewDTO(instance)
+		dto, err := h.newRuntimeDTO(instance, opDetail, kymaConfig, clusterConfig, fields)
 		if err != nil {
-			httputil.WriteErrorResponse(w, http.StatusInternalServerError, errors.Wrap(err, "while converting instance to DTO"))
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, err)
 			return
 		}
 
-		switch opDetail {
-		case pkg.AllOperation:
-			err = h.setRuntimeAllOperations(instance, &dto)
-		case pkg.LastOperation:
-			err = h.setRuntimeLastOperation(instance, &dto)
-		}
+		toReturn = append(toReturn, dto)
+	}
+
+	nextCursor := ""
+	if len(instances) > 0 && len(instances) == pageSize {
+		nextCursor, err = h.nextCursor(instances[len(instances)-1], sort)
 		if err != nil {
 			httputil.WriteErrorResponse(w, http.StatusInternalServerError, err)
 			return
 		}
+	}
 
-		err = h.determineStatusModifiedAt(&dto)
+	runtimePage := pkg.RuntimesPage{
+		Data:            toReturn,
+		Count:           count,
+		TotalCount:      totalCount,
+		ResourceVersion: resourceVersion,
+		NextCursor:      nextCursor,
+	}
+	if fields == nil {
+		httputil.WriteResponse(w, http.StatusOK, runtimePage)
+		return
+	}
+
+	projectedPage := runtimesProjectionPage{
+		Data:            make([]interface{}, 0, len(toReturn)),
+		Count:           count,
+		TotalCount:      totalCount,
+		ResourceVersion: resourceVersion,
+		NextCursor:      nextCursor,
+	}
+	for _, dto := range toReturn {
+		projection, err := fields.project(dto)
 		if err != nil {
 			httputil.WriteErrorResponse(w, http.StatusInternalServerError, err)
 			return
 		}
-		err = h.setRuntimeOptionalAttributes(instance, &dto, kymaConfig, clusterConfig)
+		projectedPage.Data = append(projectedPage.Data, projection)
+	}
+	httputil.WriteResponse(w, http.StatusOK, projectedPage)
+}
+
+// runtimesProjectionPage is the RuntimesPage with the runtimes limited to the requested fields
+type runtimesProjectionPage struct {
+	Data            []interface{} `json:"data"`
+	Count           int           `json:"count"`
+	TotalCount      int           `json:"totalCount"`
+	ResourceVersion string        `json:"resourceVersion,omitempty"`
+	NextCursor      string        `json:"nextCursor,omitempty"`
+}
+
+// newRuntimeDTO builds the runtime, the details which are not included in the fields are not fetched
+func (h *Handler) newRuntimeDTO(instance internal.Instance, opDetail pkg.OperationDetail, kymaConfig, clusterConfig bool, fields fieldSet) (pkg.RuntimeDTO, error) {
+	dto, err := h.converter.NewDTO(instance)
+	if err != nil {
+		return pkg.RuntimeDTO{}, errors.Wrap(err, "while converting instance to DTO")
+	}
+
+	if fields.has("status", "avsInternalEvaluationID", "kymaVersion") {
+		switch opDetail {
+		case pkg.AllOperation:
+			err = h.setRuntimeAllOperations(instance, &dto)
+		case pkg.LastOperation:
+			err = h.setRuntimeLastOperation(instance, &dto)
+		}
 		if err != nil {
-			httputil.WriteErrorResponse(w, http.StatusInternalServerError, err)
-			return
+			return pkg.RuntimeDTO{}, err
 		}
-
-		toReturn = append(toReturn, dto)
 	}
 
-	runtimePage := pkg.RuntimesPage{
-		Data:       toReturn,
-		Count:      count,
-		TotalCount: totalCount,
+	if fields.has("status") {
+		err = h.determineStatusModifiedAt(&dto)
+		if err != nil {
+			return pkg.RuntimeDTO{}, err
+		}
 	}
-	httputil.WriteResponse(w, http.StatusOK, runtimePage)
+	err = h.setRuntimeOptionalAttributes(instance, &dto, kymaConfig && fields.has("kymaConfig"), clusterConfig && fields.has("clusterConfig"))
+	if err != nil {
+		return pkg.RuntimeDTO{}, err
+	}
+
+	return dto, nil
 }
 
 func (h *Handler) takeLastNonDryRunOperations(oprs []internal.UpgradeKymaOperation) ([]internal.UpgradeKymaOperation, int) {
@@ -180,7 +269,7 @@ func (h *Handler) setRuntimeAllOperations(instance internal.Instance, dto *pkg.R
 	if err != nil && !dberr.IsNotFound(err) {
 		return errors.Wrap(err, "while fetching upgrade kyma operation for instance")
 	}
-	dto.KymaVersion = determineKymaVersion(provOprs, ukOprs)
+	dto.KymaVersion = DetermineKymaVersion(provOprs, ukOprs)
 	ukOprs, totalCount := h.takeLastNonDryRunOperations(ukOprs)
 	h.converter.ApplyUpgradingKymaOperations(dto, ukOprs, totalCount)
 
@@ -201,6 +290,12 @@ func (h *Handler) setRuntimeAllOperations(instance internal.Instance, dto *pkg.R
 	}
 	h.converter.ApplyUpdateOperations(dto, uOprs, totalCount)
 
+	hOprs, err := h.operationsDb.ListHibernationOperationsByInstanceID(instance.InstanceID)
+	if err != nil && !dberr.IsNotFound(err) {
+		return errors.Wrap(err, "while fetching hibernation operations for instance")
+	}
+	h.converter.ApplyHibernationOperations(dto, hOprs)
+
 	return nil
 }
 
@@ -258,6 +353,13 @@ func (h *Handler) setRuntimeLastOperation(instance internal.Instance, dto *pkg.R
 		}
 		h.converter.ApplyUpdateOperations(dto, []internal.UpdatingOperation{*updOp}, 1)
 
+	case internal.OperationTypeHibernate, internal.OperationTypeWakeUp:
+		hibernationOp, err := h.operationsDb.GetHibernationOperationByID(lastOp.ID)
+		if err != nil {
+			return errors.Wrap(err, "while fetching hibernation operation for instance")
+		}
+		h.converter.ApplyHibernationOperations(dto, []internal.HibernationOperation{*hibernationOp})
+
 	default:
 		return errors.Errorf("unsupported operation type: %s", lastOp.Type)
 	}
@@ -289,7 +391,8 @@ func (h *Handler) setRuntimeOptionalAttributes(instance internal.Instance, dto *
 	return nil
 }
 
-func determineKymaVersion(pOprs []internal.ProvisioningOperation, uOprs []internal.UpgradeKymaOperation) string {
+// DetermineKymaVersion returns the Kyma version of the runtime set by the last provisioning or processed upgrade kyma operation
+func DetermineKymaVersion(pOprs []internal.ProvisioningOperation, uOprs []internal.UpgradeKymaOperation) string {
 	kymaVersion := ""
 	kymaVersionSetAt := time.Time{}
 
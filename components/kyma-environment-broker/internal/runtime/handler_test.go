@@ -364,6 +364,70 @@ func TestRuntimeHandler(t *testing.T) {
 		assert.Equal(t, suspensionOpId, suspensionOps[0].OperationID)
 	})
 
+	t.Run("should show hibernate and wake up operations as suspension and unsuspension", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		instances := memory.NewInstance(operations)
+		states := memory.NewRuntimeStates()
+		testID1 := "Test1"
+		testTime1 := time.Now()
+		testInstance1 := fixInstance(testID1, testTime1)
+
+		err := instances.Insert(testInstance1)
+		require.NoError(t, err)
+
+		err = operations.InsertProvisioningOperation(internal.ProvisioningOperation{
+			Operation: internal.Operation{
+				ID:         "provisioning-id",
+				Version:    0,
+				CreatedAt:  time.Now().Add(-2 * time.Hour),
+				UpdatedAt:  time.Now().Add(-2 * time.Hour),
+				InstanceID: testID1,
+				State:      domain.Succeeded,
+			},
+		})
+		require.NoError(t, err)
+		hibernation := internal.NewHibernationOperationWithID("hibernate-op-id", &testInstance1)
+		hibernation.CreatedAt = time.Now().Add(-time.Hour)
+		hibernation.State = domain.Succeeded
+		err = operations.InsertHibernationOperation(hibernation)
+		require.NoError(t, err)
+		wakeUp := internal.NewWakeUpOperationWithID("wake-up-op-id", &testInstance1)
+		wakeUp.State = domain.InProgress
+		err = operations.InsertHibernationOperation(wakeUp)
+		require.NoError(t, err)
+
+		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+
+		req, err := http.NewRequest("GET", "/runtimes", nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		runtimeHandler.AttachRoutes(router)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var out pkg.RuntimesPage
+
+		err = json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+
+		require.Equal(t, 1, out.Count)
+		suspensionOps := out.Data[0].Status.Suspension.Data
+		require.Equal(t, 1, len(suspensionOps))
+		assert.Equal(t, "hibernate-op-id", suspensionOps[0].OperationID)
+
+		unsuspensionOps := out.Data[0].Status.Unsuspension.Data
+		require.Equal(t, 1, len(unsuspensionOps))
+		assert.Equal(t, "wake-up-op-id", unsuspensionOps[0].OperationID)
+		assert.Equal(t, pkg.StateProvisioning, out.Data[0].Status.State)
+	})
+
 	t.Run("should distinguish between provisioning & unsuspension operations", func(t *testing.T) {
 		// given
 		operations := memory.NewOperation()
@@ -676,6 +740,125 @@ func TestRuntimeHandler(t *testing.T) {
 		require.NotNil(t, out.Data[0].ClusterConfig)
 		assert.Equal(t, "1.19.19", out.Data[0].ClusterConfig.KubernetesVersion)
 	})
+
+	t.Run("test cursor pagination and sorting", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		instances := memory.NewInstance(operations)
+		states := memory.NewRuntimeStates()
+		testTime := time.Now()
+		for i, id := range []string{"c", "a", "b"} {
+			err := instances.Insert(fixInstance(id, testTime.Add(time.Duration(i)*time.Minute)))
+			require.NoError(t, err)
+			provOp := fixture.FixProvisioningOperation(fixRandomID(), id)
+			err = operations.InsertProvisioningOperation(provOp)
+			require.NoError(t, err)
+		}
+
+		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+		router := mux.NewRouter()
+		runtimeHandler.AttachRoutes(router)
+
+		list := func(query string) pkg.RuntimesPage {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest("GET", "/runtimes?"+query, nil)
+			require.NoError(t, err)
+			router.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code)
+
+			var out pkg.RuntimesPage
+			err = json.Unmarshal(rr.Body.Bytes(), &out)
+			require.NoError(t, err)
+			return out
+		}
+
+		// when
+		first := list("page_size=2")
+		// a runtime created meanwhile does not shift the next page
+		err := instances.Insert(fixInstance("0", testTime.Add(-time.Minute)))
+		require.NoError(t, err)
+		second := list("page_size=2&cursor=" + first.NextCursor)
+
+		// then
+		require.Equal(t, 2, first.Count)
+		assert.Equal(t, "c", first.Data[0].InstanceID)
+		assert.Equal(t, "a", first.Data[1].InstanceID)
+		require.NotEmpty(t, first.NextCursor)
+		require.Equal(t, 1, second.Count)
+		assert.Equal(t, "b", second.Data[0].InstanceID)
+		assert.Empty(t, second.NextCursor)
+
+		// when
+		first = list(fmt.Sprintf("page_size=2&sort=%s", pkg.SortByGlobalAccountID))
+		second = list(fmt.Sprintf("page_size=2&sort=%s&cursor=%s", pkg.SortByGlobalAccountID, first.NextCursor))
+
+		// then
+		require.Equal(t, 2, first.Count)
+		assert.Equal(t, "0", first.Data[0].InstanceID)
+		assert.Equal(t, "a", first.Data[1].InstanceID)
+		require.Equal(t, 2, second.Count)
+		assert.Equal(t, "b", second.Data[0].InstanceID)
+		assert.Equal(t, "c", second.Data[1].InstanceID)
+
+		// when
+		for _, query := range []string{
+			"sort=unknown",
+			"cursor=invalid",
+			"page=1&cursor=" + first.NextCursor,
+			fmt.Sprintf("sort=%s&cursor=%s", pkg.SortByState, first.NextCursor),
+		} {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest("GET", "/runtimes?"+query, nil)
+			require.NoError(t, err)
+			router.ServeHTTP(rr, req)
+
+			// then
+			assert.Equal(t, http.StatusBadRequest, rr.Code, query)
+		}
+	})
+
+	t.Run("test fields projection", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		instances := memory.NewInstance(operations)
+		states := memory.NewRuntimeStates()
+		testID := "Test1"
+		err := instances.Insert(fixInstance(testID, time.Now()))
+		require.NoError(t, err)
+		err = operations.InsertProvisioningOperation(fixture.FixProvisioningOperation(fixRandomID(), testID))
+		require.NoError(t, err)
+
+		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+		router := mux.NewRouter()
+		runtimeHandler.AttachRoutes(router)
+
+		// when
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/runtimes?fields=runtimeID,globalAccountID&fields=status", nil)
+		require.NoError(t, err)
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		var out struct {
+			Data []map[string]json.RawMessage `json:"data"`
+		}
+		err = json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+		require.Len(t, out.Data, 1)
+		assert.Len(t, out.Data[0], 3)
+		assert.Equal(t, `"Test1"`, string(out.Data[0]["runtimeID"]))
+		assert.Contains(t, out.Data[0], "status")
+
+		// when
+		rr = httptest.NewRecorder()
+		req, err = http.NewRequest("GET", "/runtimes?fields=unknown", nil)
+		require.NoError(t, err)
+		router.ServeHTTP(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
 }
 
 func fixInstance(id string, t time.Time) internal.Instance {
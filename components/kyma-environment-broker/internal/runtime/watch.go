@@ -0,0 +1,311 @@
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	pkg "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/pkg/errors"
+)
+
+const lastEventIDHeader = "Last-Event-ID"
+
+type WatchConfig struct {
+	// PollInterval is the interval of checking updates of operations
+	PollInterval time.Duration `envconfig:"default=2s"`
+	// BookmarkInterval is the interval of sending the current resource version when nothing changes
+	BookmarkInterval time.Duration `envconfig:"default=30s"`
+	// SafetyWindow is the time for which updated operations are checked again, so updates saved with a delay are not missed
+	SafetyWindow time.Duration `envconfig:"default=5s"`
+	// BatchSize is the maximum number of updated operations read from the database at once
+	BatchSize int `envconfig:"default=500"`
+	// RetentionWindow is the maximum age of the resource version the watch can be started from, older versions are rejected
+	// with 410 Gone and the client must list runtimes again
+	RetentionWindow time.Duration `envconfig:"default=1h"`
+}
+
+func DefaultWatchConfig() WatchConfig {
+	return WatchConfig{
+		PollInterval:     2 * time.Second,
+		BookmarkInterval: 30 * time.Second,
+		SafetyWindow:     5 * time.Second,
+		BatchSize:        500,
+		RetentionWindow:  time.Hour,
+	}
+}
+
+// runtimeWatch holds the state of one watch stream. The resource version is the update time of the last sent operation.
+type runtimeWatch struct {
+	h             *Handler
+	w             http.ResponseWriter
+	flusher       http.Flusher
+	filter        dbmodel.InstanceFilter
+	opDetail      pkg.OperationDetail
+	kymaConfig    bool
+	clusterConfig bool
+
+	resourceVersion time.Time
+	// sent keeps update times of operations sent within the safety window, so they are not sent twice
+	sent map[string]time.Time
+}
+
+// watchRuntimes streams changes of runtimes as server-sent events. The changes are driven by updates of operations.
+func (h *Handler) watchRuntimes(w http.ResponseWriter, req *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, errors.New("streaming is not supported"))
+		return
+	}
+
+	resourceVersion := time.Now()
+	rv := req.URL.Query().Get(pkg.ResourceVersionParam)
+	if rv == "" {
+		rv = req.Header.Get(lastEventIDHeader)
+	}
+	if rv != "" {
+		var err error
+		resourceVersion, err = parseResourceVersion(rv)
+		if err != nil {
+			httputil.WriteErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		if time.Since(resourceVersion) > h.watchConfig.RetentionWindow {
+			httputil.WriteErrorResponse(w, http.StatusGone, errors.Errorf("resource version %s is older than %s, list runtimes again", rv, h.watchConfig.RetentionWindow))
+			return
+		}
+	}
+
+	watch := &runtimeWatch{
+		h:               h,
+		w:               w,
+		flusher:         flusher,
+		filter:          h.getFilters(req),
+		opDetail:        getOpDetail(req),
+		kymaConfig:      getBoolParam(pkg.KymaConfigParam, req),
+		clusterConfig:   getBoolParam(pkg.ClusterConfigParam, req),
+		resourceVersion: resourceVersion,
+		sent:            make(map[string]time.Time),
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	pollTicker := time.NewTicker(h.watchConfig.PollInterval)
+	defer pollTicker.Stop()
+	bookmarkTicker := time.NewTicker(h.watchConfig.BookmarkInterval)
+	defer bookmarkTicker.Stop()
+
+	for {
+		if err := watch.poll(); err != nil {
+			// the client resumes the watch from the last resource version
+			_ = watch.send(pkg.WatchEvent{Type: pkg.WatchEventBookmark, ResourceVersion: newResourceVersion(watch.resourceVersion)})
+			return
+		}
+
+		select {
+		case <-req.Context().Done():
+			return
+		case <-bookmarkTicker.C:
+			if err := watch.send(pkg.WatchEvent{Type: pkg.WatchEventBookmark, ResourceVersion: newResourceVersion(watch.resourceVersion)}); err != nil {
+				return
+			}
+		case <-pollTicker.C:
+		}
+	}
+}
+
+// poll sends one event for every runtime with operations updated after the resource version.
+// Updated operations are read in batches ordered by the update time and ID.
+func (rw *runtimeWatch) poll() error {
+	window := rw.h.watchConfig.SafetyWindow
+	updatedAt, operationID := rw.resourceVersion.Add(-window), ""
+	for {
+		operations, err := rw.h.operationsDb.ListOperationsUpdatedAfter(updatedAt, operationID, rw.h.watchConfig.BatchSize)
+		switch {
+		case dberr.IsNotFound(errors.Cause(err)):
+			return nil
+		case err != nil:
+			return errors.Wrap(err, "while listing updated operations")
+		}
+		if err := rw.sendChanges(operations); err != nil {
+			return err
+		}
+		if len(operations) < rw.h.watchConfig.BatchSize {
+			break
+		}
+		last := operations[len(operations)-1]
+		updatedAt, operationID = last.UpdatedAt, last.ID
+	}
+
+	for id, sentAt := range rw.sent {
+		if sentAt.Before(rw.resourceVersion.Add(-window)) {
+			delete(rw.sent, id)
+		}
+	}
+	return nil
+}
+
+// sendChanges sends one event for every runtime of the operations, which are ordered by the update time
+func (rw *runtimeWatch) sendChanges(operations []internal.Operation) error {
+	// only the last operation of the runtime matters, the event contains the current state of the runtime
+	changed := make(map[string]internal.Operation)
+	var instanceIDs []string
+	for _, op := range operations {
+		if sentAt, found := rw.sent[op.ID]; found && sentAt.Equal(op.UpdatedAt) {
+			continue
+		}
+		rw.sent[op.ID] = op.UpdatedAt
+		if _, found := changed[op.InstanceID]; !found {
+			instanceIDs = append(instanceIDs, op.InstanceID)
+		}
+		changed[op.InstanceID] = op
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	instances, err := rw.listInstances(instanceIDs)
+	if err != nil {
+		return err
+	}
+	sort.Slice(instanceIDs, func(i, j int) bool {
+		return changed[instanceIDs[i]].UpdatedAt.Before(changed[instanceIDs[j]].UpdatedAt)
+	})
+
+	for _, instanceID := range instanceIDs {
+		op := changed[instanceID]
+		event := pkg.WatchEvent{ResourceVersion: newResourceVersion(op.UpdatedAt)}
+
+		instance, found := instances[instanceID]
+		switch {
+		case found:
+			dto, err := rw.h.newRuntimeDTO(instance, rw.opDetail, rw.kymaConfig, rw.clusterConfig, nil)
+			if err != nil {
+				return err
+			}
+			event.Type = pkg.WatchEventModified
+			if instance.CreatedAt.After(rw.resourceVersion) {
+				event.Type = pkg.WatchEventAdded
+			}
+			event.Runtime = &dto
+		case op.Type == internal.OperationTypeDeprovision && rw.matchesDeleted(op):
+			event.Type = pkg.WatchEventDeleted
+			event.Runtime = deletedRuntimeDTO(op)
+		default:
+			continue
+		}
+
+		if err := rw.send(event); err != nil {
+			return err
+		}
+	}
+
+	last := changed[instanceIDs[len(instanceIDs)-1]].UpdatedAt
+	if last.After(rw.resourceVersion) {
+		rw.resourceVersion = last
+	}
+	return nil
+}
+
+// listInstances returns changed instances which match the filter of the watch
+func (rw *runtimeWatch) listInstances(changedIDs []string) (map[string]internal.Instance, error) {
+	filter := rw.filter
+	filter.InstanceIDs = changedIDs
+	if len(rw.filter.InstanceIDs) > 0 {
+		filter.InstanceIDs = intersect(rw.filter.InstanceIDs, changedIDs)
+		if len(filter.InstanceIDs) == 0 {
+			return map[string]internal.Instance{}, nil
+		}
+	}
+
+	instances, _, _, err := rw.h.instancesDb.List(filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching changed instances")
+	}
+	result := make(map[string]internal.Instance, len(instances))
+	for _, instance := range instances {
+		result[instance.InstanceID] = instance
+	}
+	return result, nil
+}
+
+// matchesDeleted checks filters which can be evaluated for runtimes which do not exist anymore
+func (rw *runtimeWatch) matchesDeleted(op internal.Operation) bool {
+	f := rw.filter
+	return matchesAny(f.InstanceIDs, op.InstanceID) &&
+		matchesAny(f.RuntimeIDs, op.RuntimeID) &&
+		matchesAny(f.GlobalAccountIDs, op.ProvisioningParameters.ErsContext.GlobalAccountID) &&
+		matchesAny(f.SubAccountIDs, op.ProvisioningParameters.ErsContext.SubAccountID) &&
+		matchesAny(f.Plans, broker.PlanNamesMapping[op.ProvisioningParameters.PlanID])
+}
+
+func (rw *runtimeWatch) send(event pkg.WatchEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "while encoding watch event")
+	}
+	_, err = fmt.Fprintf(rw.w, "id: %s\nevent: %s\ndata: %s\n\n", event.ResourceVersion, event.Type, data)
+	if err != nil {
+		return errors.Wrap(err, "while writing watch event")
+	}
+	rw.flusher.Flush()
+	return nil
+}
+
+func deletedRuntimeDTO(op internal.Operation) *pkg.RuntimeDTO {
+	return &pkg.RuntimeDTO{
+		InstanceID:      op.InstanceID,
+		RuntimeID:       op.RuntimeID,
+		GlobalAccountID: op.ProvisioningParameters.ErsContext.GlobalAccountID,
+		SubAccountID:    op.ProvisioningParameters.ErsContext.SubAccountID,
+		ServicePlanID:   op.ProvisioningParameters.PlanID,
+		ServicePlanName: broker.PlanNamesMapping[op.ProvisioningParameters.PlanID],
+		ShootName:       op.ShootName,
+	}
+}
+
+func newResourceVersion(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func parseResourceVersion(rv string) (time.Time, error) {
+	nanos, err := strconv.ParseInt(rv, 10, 64)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid resource version %s", rv)
+	}
+	return time.Unix(0, nanos), nil
+}
+
+func matchesAny(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func intersect(a, b []string) []string {
+	var result []string
+	for _, v := range b {
+		if matchesAny(a, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,121 @@
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	pkg "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/runtime"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/memory"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errWatchDone = errors.New("done")
+
+func TestRuntimeHandler_Watch(t *testing.T) {
+	// given
+	operations := memory.NewOperation()
+	instances := memory.NewInstance(operations)
+	states := memory.NewRuntimeStates()
+
+	existing := fixInstance("existing", time.Now().Add(-time.Hour))
+	require.NoError(t, instances.Insert(existing))
+	existingOp := fixture.FixProvisioningOperation("existing-op", existing.InstanceID)
+	existingOp.UpdatedAt = time.Now().Add(-time.Hour)
+	require.NoError(t, operations.InsertProvisioningOperation(existingOp))
+
+	runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "").WithWatchConfig(runtime.WatchConfig{
+		PollInterval:     10 * time.Millisecond,
+		BookmarkInterval: time.Hour,
+		SafetyWindow:     time.Millisecond,
+		BatchSize:        1,
+		RetentionWindow:  time.Hour,
+	})
+	router := mux.NewRouter()
+	runtimeHandler.AttachRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+	client := pkg.NewClient(server.URL, server.Client())
+
+	resourceVersion := time.Now()
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+
+		// a new runtime
+		added := fixInstance("added", time.Now())
+		require.NoError(t, instances.Insert(added))
+		addedOp := fixture.FixProvisioningOperation("added-op", added.InstanceID)
+		addedOp.UpdatedAt = time.Now()
+		require.NoError(t, operations.InsertProvisioningOperation(addedOp))
+		time.Sleep(50 * time.Millisecond)
+
+		// the existing runtime is updated
+		updateOp := fixture.FixUpdatingOperation("update-op", existing.InstanceID)
+		updateOp.UpdatedAt = time.Now()
+		require.NoError(t, operations.InsertUpdatingOperation(updateOp))
+		time.Sleep(50 * time.Millisecond)
+
+		// a runtime was deprovisioned and its instance removed
+		deprovisioningOp := fixture.FixDeprovisioningOperation("deprovisioning-op", "deleted")
+		deprovisioningOp.State = domain.Succeeded
+		deprovisioningOp.UpdatedAt = time.Now()
+		require.NoError(t, operations.InsertDeprovisioningOperation(deprovisioningOp))
+	}()
+
+	// when
+	var events []pkg.WatchEvent
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := client.WatchRuntimes(ctx, pkg.ListParameters{OperationDetail: pkg.LastOperation}, strconv.FormatInt(resourceVersion.UnixNano(), 10), func(event pkg.WatchEvent) error {
+		events = append(events, event)
+		if len(events) == 3 {
+			return errWatchDone
+		}
+		return nil
+	})
+
+	// then
+	require.ErrorIs(t, err, errWatchDone)
+	assert.Equal(t, pkg.WatchEventAdded, events[0].Type)
+	assert.Equal(t, "added", events[0].Runtime.InstanceID)
+	assert.Equal(t, pkg.WatchEventModified, events[1].Type)
+	assert.Equal(t, "existing", events[1].Runtime.InstanceID)
+	require.NotNil(t, events[1].Runtime.Status.Update)
+	assert.Equal(t, pkg.WatchEventDeleted, events[2].Type)
+	assert.Equal(t, "deleted", events[2].Runtime.InstanceID)
+	assert.Greater(t, events[2].ResourceVersion, events[0].ResourceVersion)
+}
+
+func TestRuntimeHandler_WatchExpiredResourceVersion(t *testing.T) {
+	// given
+	operations := memory.NewOperation()
+	runtimeHandler := runtime.NewHandler(memory.NewInstance(operations), operations, memory.NewRuntimeStates(), 2, "").WithWatchConfig(runtime.WatchConfig{
+		PollInterval:     10 * time.Millisecond,
+		BookmarkInterval: time.Hour,
+		SafetyWindow:     time.Millisecond,
+		BatchSize:        10,
+		RetentionWindow:  time.Hour,
+	})
+	router := mux.NewRouter()
+	runtimeHandler.AttachRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+	client := pkg.NewClient(server.URL, server.Client())
+
+	// when
+	resourceVersion := time.Now().Add(-2 * time.Hour)
+	err := client.WatchRuntimes(context.Background(), pkg.ListParameters{}, strconv.FormatInt(resourceVersion.UnixNano(), 10), func(event pkg.WatchEvent) error {
+		return nil
+	})
+
+	// then
+	require.ErrorIs(t, err, pkg.ErrResourceVersionExpired)
+}
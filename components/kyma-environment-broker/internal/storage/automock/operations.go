@@ -7,6 +7,8 @@ import (
 	dbmodel "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Operations is an autogenerated mock type for the Operations type
@@ -60,6 +62,29 @@ func (_m *Operations) GetDeprovisioningOperationByInstanceID(instanceID string)
 	return r0, r1
 }
 
+// GetHibernationOperationByID provides a mock function with given fields: operationID
+func (_m *Operations) GetHibernationOperationByID(operationID string) (*internal.HibernationOperation, error) {
+	ret := _m.Called(operationID)
+
+	var r0 *internal.HibernationOperation
+	if rf, ok := ret.Get(0).(func(string) *internal.HibernationOperation); ok {
+		r0 = rf(operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internal.HibernationOperation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(operationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLastOperation provides a mock function with given fields: instanceID
 func (_m *Operations) GetLastOperation(instanceID string) (*internal.Operation, error) {
 	ret := _m.Called(instanceID)
@@ -244,6 +269,29 @@ func (_m *Operations) GetProvisioningOperationByInstanceID(instanceID string) (*
 	return r0, r1
 }
 
+// GetRunTaskOperationByID provides a mock function with given fields: operationID
+func (_m *Operations) GetRunTaskOperationByID(operationID string) (*internal.RunTaskOperation, error) {
+	ret := _m.Called(operationID)
+
+	var r0 *internal.RunTaskOperation
+	if rf, ok := ret.Get(0).(func(string) *internal.RunTaskOperation); ok {
+		r0 = rf(operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internal.RunTaskOperation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(operationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUpdatingOperationByID provides a mock function with given fields: operationID
 func (_m *Operations) GetUpdatingOperationByID(operationID string) (*internal.UpdatingOperation, error) {
 	ret := _m.Called(operationID)
@@ -350,6 +398,34 @@ func (_m *Operations) InsertDeprovisioningOperation(operation internal.Deprovisi
 	return r0
 }
 
+// InsertHibernationOperation provides a mock function with given fields: operation
+func (_m *Operations) InsertHibernationOperation(operation internal.HibernationOperation) error {
+	ret := _m.Called(operation)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(internal.HibernationOperation) error); ok {
+		r0 = rf(operation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertOperationStep provides a mock function with given fields: step
+func (_m *Operations) InsertOperationStep(step internal.OperationStep) error {
+	ret := _m.Called(step)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(internal.OperationStep) error); ok {
+		r0 = rf(step)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // InsertProvisioningOperation provides a mock function with given fields: operation
 func (_m *Operations) InsertProvisioningOperation(operation internal.ProvisioningOperation) error {
 	ret := _m.Called(operation)
@@ -364,6 +440,20 @@ func (_m *Operations) InsertProvisioningOperation(operation internal.Provisionin
 	return r0
 }
 
+// InsertRunTaskOperation provides a mock function with given fields: operation
+func (_m *Operations) InsertRunTaskOperation(operation internal.RunTaskOperation) error {
+	ret := _m.Called(operation)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(internal.RunTaskOperation) error); ok {
+		r0 = rf(operation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // InsertUpdatingOperation provides a mock function with given fields: operation
 func (_m *Operations) InsertUpdatingOperation(operation internal.UpdatingOperation) error {
 	ret := _m.Called(operation)
@@ -452,6 +542,29 @@ func (_m *Operations) ListDeprovisioningOperationsByInstanceID(instanceID string
 	return r0, r1
 }
 
+// ListHibernationOperationsByInstanceID provides a mock function with given fields: instanceID
+func (_m *Operations) ListHibernationOperationsByInstanceID(instanceID string) ([]internal.HibernationOperation, error) {
+	ret := _m.Called(instanceID)
+
+	var r0 []internal.HibernationOperation
+	if rf, ok := ret.Get(0).(func(string) []internal.HibernationOperation); ok {
+		r0 = rf(instanceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]internal.HibernationOperation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(instanceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListOperations provides a mock function with given fields: filter
 func (_m *Operations) ListOperations(filter dbmodel.OperationFilter) ([]internal.Operation, int, int, error) {
 	ret := _m.Called(filter)
@@ -489,6 +602,52 @@ func (_m *Operations) ListOperations(filter dbmodel.OperationFilter) ([]internal
 	return r0, r1, r2, r3
 }
 
+// ListOperationsUpdatedAfter provides a mock function with given fields: updatedAt, operationID, limit
+func (_m *Operations) ListOperationsUpdatedAfter(updatedAt time.Time, operationID string, limit int) ([]internal.Operation, error) {
+	ret := _m.Called(updatedAt, operationID, limit)
+
+	var r0 []internal.Operation
+	if rf, ok := ret.Get(0).(func(time.Time, string, int) []internal.Operation); ok {
+		r0 = rf(updatedAt, operationID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]internal.Operation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(time.Time, string, int) error); ok {
+		r1 = rf(updatedAt, operationID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOperationSteps provides a mock function with given fields: operationID
+func (_m *Operations) ListOperationSteps(operationID string) ([]internal.OperationStep, error) {
+	ret := _m.Called(operationID)
+
+	var r0 []internal.OperationStep
+	if rf, ok := ret.Get(0).(func(string) []internal.OperationStep); ok {
+		r0 = rf(operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]internal.OperationStep)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(operationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListProvisioningOperationsByInstanceID provides a mock function with given fields: instanceID
 func (_m *Operations) ListProvisioningOperationsByInstanceID(instanceID string) ([]internal.ProvisioningOperation, error) {
 	ret := _m.Called(instanceID)
@@ -512,6 +671,43 @@ func (_m *Operations) ListProvisioningOperationsByInstanceID(instanceID string)
 	return r0, r1
 }
 
+// ListRunTaskOperationsByOrchestrationID provides a mock function with given fields: orchestrationID, filter
+func (_m *Operations) ListRunTaskOperationsByOrchestrationID(orchestrationID string, filter dbmodel.OperationFilter) ([]internal.RunTaskOperation, int, int, error) {
+	ret := _m.Called(orchestrationID, filter)
+
+	var r0 []internal.RunTaskOperation
+	if rf, ok := ret.Get(0).(func(string, dbmodel.OperationFilter) []internal.RunTaskOperation); ok {
+		r0 = rf(orchestrationID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]internal.RunTaskOperation)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(string, dbmodel.OperationFilter) int); ok {
+		r1 = rf(orchestrationID, filter)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 int
+	if rf, ok := ret.Get(2).(func(string, dbmodel.OperationFilter) int); ok {
+		r2 = rf(orchestrationID, filter)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(string, dbmodel.OperationFilter) error); ok {
+		r3 = rf(orchestrationID, filter)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // ListUpdatingOperationsByInstanceID provides a mock function with given fields: instanceID
 func (_m *Operations) ListUpdatingOperationsByInstanceID(instanceID string) ([]internal.UpdatingOperation, error) {
 	ret := _m.Called(instanceID)
@@ -701,6 +897,29 @@ func (_m *Operations) UpdateDeprovisioningOperation(operation internal.Deprovisi
 	return r0, r1
 }
 
+// UpdateHibernationOperation provides a mock function with given fields: operation
+func (_m *Operations) UpdateHibernationOperation(operation internal.HibernationOperation) (*internal.HibernationOperation, error) {
+	ret := _m.Called(operation)
+
+	var r0 *internal.HibernationOperation
+	if rf, ok := ret.Get(0).(func(internal.HibernationOperation) *internal.HibernationOperation); ok {
+		r0 = rf(operation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internal.HibernationOperation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(internal.HibernationOperation) error); ok {
+		r1 = rf(operation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateProvisioningOperation provides a mock function with given fields: operation
 func (_m *Operations) UpdateProvisioningOperation(operation internal.ProvisioningOperation) (*internal.ProvisioningOperation, error) {
 	ret := _m.Called(operation)
@@ -724,6 +943,29 @@ func (_m *Operations) UpdateProvisioningOperation(operation internal.Provisionin
 	return r0, r1
 }
 
+// UpdateRunTaskOperation provides a mock function with given fields: operation
+func (_m *Operations) UpdateRunTaskOperation(operation internal.RunTaskOperation) (*internal.RunTaskOperation, error) {
+	ret := _m.Called(operation)
+
+	var r0 *internal.RunTaskOperation
+	if rf, ok := ret.Get(0).(func(internal.RunTaskOperation) *internal.RunTaskOperation); ok {
+		r0 = rf(operation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internal.RunTaskOperation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(internal.RunTaskOperation) error); ok {
+		r1 = rf(operation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateUpdatingOperation provides a mock function with given fields: operation
 func (_m *Operations) UpdateUpdatingOperation(operation internal.UpdatingOperation) (*internal.UpdatingOperation, error) {
 	ret := _m.Called(operation)
@@ -3,6 +3,9 @@ package dbmodel
 import (
 	"database/sql"
 	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
 )
 
 type InstanceState string
@@ -19,10 +22,33 @@ const (
 	InstanceNotDeprovisioned InstanceState = "notDeprovisioned"
 )
 
+// InstanceSortField is the field by which the instances are sorted, the instance ID is the tie-breaker
+type InstanceSortField string
+
+const (
+	InstanceSortByCreatedAt       InstanceSortField = "created_at"
+	InstanceSortByGlobalAccountID InstanceSortField = "global_account_id"
+	// InstanceSortByState sorts the instances by the state determined by their last operation
+	InstanceSortByState InstanceSortField = "state"
+)
+
+// InstanceCursor points at the last instance of the previous page, the next page starts right after it
+type InstanceCursor struct {
+	// SortValue is the value of the sort field of the instance, the creation time is formatted as RFC3339 with nanoseconds
+	SortValue  string
+	InstanceID string
+}
+
 // InstanceFilter holds the filters when querying Instances
 type InstanceFilter struct {
-	PageSize                     int
-	Page                         int
+	PageSize int
+	Page     int
+	// After replaces the Page for the cursor pagination, the instances following the cursor are returned
+	After *InstanceCursor
+	// Sort defaults to the creation time
+	Sort InstanceSortField
+	// Columns limits the loaded instance columns, all columns are loaded if empty
+	Columns                      []string
 	GlobalAccountIDs             []string
 	SubscriptionGlobalAccountIDs []string
 	SubAccountIDs                []string
@@ -34,6 +60,28 @@ type InstanceFilter struct {
 	States                       []InstanceState
 }
 
+// InstanceStateForOperation returns the state of the instance with the given last operation
+func InstanceStateForOperation(opType internal.OperationType, state domain.LastOperationState) InstanceState {
+	switch {
+	case state == domain.InProgress && opType == internal.OperationTypeProvision:
+		return InstanceProvisioning
+	case state == domain.InProgress && opType == internal.OperationTypeDeprovision:
+		return InstanceDeprovisioning
+	case state == domain.InProgress && (opType == internal.OperationTypeUpgradeKyma || opType == internal.OperationTypeUpgradeCluster):
+		return InstanceUpgrading
+	case state == domain.InProgress && opType == internal.OperationTypeUpdate:
+		return InstanceUpdating
+	case state == domain.Failed && (opType == internal.OperationTypeProvision || opType == internal.OperationTypeDeprovision):
+		return InstanceFailed
+	case state == domain.Failed:
+		return InstanceError
+	case state == domain.Succeeded && opType == internal.OperationTypeDeprovision:
+		return InstanceDeprovisioned
+	default:
+		return InstanceSucceeded
+	}
+}
+
 type InstanceDTO struct {
 	InstanceID                  string
 	RuntimeID                   string
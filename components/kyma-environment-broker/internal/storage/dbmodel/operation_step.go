@@ -0,0 +1,20 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+type OperationStepDTO struct {
+	ID          string
+	OperationID string
+	StepName    string
+	Stage       string
+
+	StartedAt  time.Time
+	FinishedAt time.Time
+
+	Result       string
+	RetryDelay   int64
+	ErrorReason  string
+	ErrorMessage string
+}
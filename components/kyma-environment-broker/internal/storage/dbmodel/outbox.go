@@ -0,0 +1,28 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+type OutboxEventDTO struct {
+	Sequence  int64
+	EventType string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+type OutboxDeadLetterDTO struct {
+	Subscriber string
+	Sequence   int64
+	EventType  string
+	Payload    []byte
+	Error      string
+	Attempts   int
+	CreatedAt  time.Time
+}
+
+type OutboxCheckpointDTO struct {
+	Subscriber string
+	Sequence   int64
+	UpdatedAt  time.Time
+}
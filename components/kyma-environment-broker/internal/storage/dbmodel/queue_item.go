@@ -0,0 +1,19 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+type QueueItemDTO struct {
+	QueueName string
+	ItemID    string
+
+	DueAt      time.Time
+	Generation int
+
+	LeaseOwner     string
+	LeaseExpiresAt time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+)
+
+type bindings struct {
+	mu sync.Mutex
+
+	bindings map[string]internal.Binding
+}
+
+func NewBindings() *bindings {
+	return &bindings{
+		bindings: make(map[string]internal.Binding, 0),
+	}
+}
+
+func (s *bindings) Insert(binding internal.Binding) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.bindings[binding.ID]; exists {
+		return dberr.AlreadyExists("binding with id %s already exist", binding.ID)
+	}
+	s.bindings[binding.ID] = binding
+
+	return nil
+}
+
+func (s *bindings) Get(instanceID, bindingID string) (*internal.Binding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	binding, ok := s.bindings[bindingID]
+	if !ok || binding.InstanceID != instanceID {
+		return nil, dberr.NotFound("binding with id %s for instance %s not exist", bindingID, instanceID)
+	}
+
+	return &binding, nil
+}
+
+func (s *bindings) ListByInstanceID(instanceID string) ([]internal.Binding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]internal.Binding, 0)
+	for _, binding := range s.bindings {
+		if binding.InstanceID == instanceID {
+			result = append(result, binding)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+func (s *bindings) Delete(instanceID, bindingID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if binding, ok := s.bindings[bindingID]; ok && binding.InstanceID == instanceID {
+		delete(s.bindings, bindingID)
+	}
+
+	return nil
+}
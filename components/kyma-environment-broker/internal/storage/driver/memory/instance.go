@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/pagination"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
@@ -208,7 +209,23 @@ func (s *instances) List(filter dbmodel.InstanceFilter) ([]internal.Instance, in
 	offset := pagination.ConvertPageAndPageSizeToOffset(filter.PageSize, filter.Page)
 
 	instances := s.filterInstances(filter)
-	sortInstancesByCreatedAt(instances)
+	keys := make(map[string]instanceSortKey, len(instances))
+	for _, instance := range instances {
+		keys[instance.InstanceID] = s.sortKey(instance, filter.Sort)
+	}
+	sort.Slice(instances, func(i, j int) bool {
+		return keys[instances[i].InstanceID].less(keys[instances[j].InstanceID])
+	})
+
+	if filter.After != nil {
+		after, err := cursorSortKey(*filter.After, filter.Sort)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		offset = sort.Search(len(instances), func(i int) bool {
+			return after.less(keys[instances[i].InstanceID])
+		})
+	}
 
 	for i := offset; (filter.PageSize < 1 || i < offset+filter.PageSize) && i < len(instances); i++ {
 		toReturn = append(toReturn, s.instances[instances[i].InstanceID])
@@ -220,10 +237,53 @@ func (s *instances) List(filter dbmodel.InstanceFilter) ([]internal.Instance, in
 		nil
 }
 
-func sortInstancesByCreatedAt(instances []internal.Instance) {
-	sort.Slice(instances, func(i, j int) bool {
-		return instances[i].CreatedAt.Before(instances[j].CreatedAt)
-	})
+type instanceSortKey struct {
+	createdAt  time.Time
+	value      string
+	instanceID string
+}
+
+func (k instanceSortKey) less(other instanceSortKey) bool {
+	if !k.createdAt.Equal(other.createdAt) {
+		return k.createdAt.Before(other.createdAt)
+	}
+	if k.value != other.value {
+		return k.value < other.value
+	}
+	return k.instanceID < other.instanceID
+}
+
+func (s *instances) sortKey(instance internal.Instance, sortField dbmodel.InstanceSortField) instanceSortKey {
+	key := instanceSortKey{instanceID: instance.InstanceID}
+	switch sortField {
+	case dbmodel.InstanceSortByGlobalAccountID:
+		key.value = instance.GlobalAccountID
+	case dbmodel.InstanceSortByState:
+		key.value = string(dbmodel.InstanceSucceeded)
+		if op, err := s.operationsStorage.GetLastOperation(instance.InstanceID); err == nil {
+			key.value = string(dbmodel.InstanceStateForOperation(op.Type, op.State))
+		}
+	default:
+		key.createdAt = instance.CreatedAt
+	}
+
+	return key
+}
+
+func cursorSortKey(cursor dbmodel.InstanceCursor, sortField dbmodel.InstanceSortField) (instanceSortKey, error) {
+	key := instanceSortKey{instanceID: cursor.InstanceID}
+	switch sortField {
+	case dbmodel.InstanceSortByGlobalAccountID, dbmodel.InstanceSortByState:
+		key.value = cursor.SortValue
+	default:
+		createdAt, err := time.Parse(time.RFC3339Nano, cursor.SortValue)
+		if err != nil {
+			return key, fmt.Errorf("while reading cursor: %w", err)
+		}
+		key.createdAt = createdAt
+	}
+
+	return key, nil
 }
 
 func (s *instances) filterInstances(filter dbmodel.InstanceFilter) []internal.Instance {
@@ -3,6 +3,7 @@ package memory
 import (
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/pagination"
@@ -21,7 +22,12 @@ type operations struct {
 	deprovisioningOperations map[string]internal.DeprovisioningOperation
 	upgradeKymaOperations    map[string]internal.UpgradeKymaOperation
 	upgradeClusterOperations map[string]internal.UpgradeClusterOperation
+	runTaskOperations        map[string]internal.RunTaskOperation
+	hibernationOperations    map[string]internal.HibernationOperation
 	updateOperations         map[string]internal.UpdatingOperation
+	operationSteps           map[string][]internal.OperationStep
+
+	outbox *outbox
 }
 
 // NewOperation creates in-memory storage for OSB operations.
@@ -31,10 +37,20 @@ func NewOperation() *operations {
 		deprovisioningOperations: make(map[string]internal.DeprovisioningOperation, 0),
 		upgradeKymaOperations:    make(map[string]internal.UpgradeKymaOperation, 0),
 		upgradeClusterOperations: make(map[string]internal.UpgradeClusterOperation, 0),
+		runTaskOperations:        make(map[string]internal.RunTaskOperation, 0),
+		hibernationOperations:    make(map[string]internal.HibernationOperation, 0),
 		updateOperations:         make(map[string]internal.UpdatingOperation, 0),
+		operationSteps:           make(map[string][]internal.OperationStep, 0),
 	}
 }
 
+// NewOperationWithOutbox creates in-memory storage for OSB operations, which appends events of saved operations to the outbox.
+func NewOperationWithOutbox(outbox *outbox) *operations {
+	s := NewOperation()
+	s.outbox = outbox
+	return s
+}
+
 func (s *operations) InsertProvisioningOperation(operation internal.ProvisioningOperation) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -44,6 +60,9 @@ func (s *operations) InsertProvisioningOperation(operation internal.Provisioning
 		return dberr.AlreadyExists("instance operation with id %s already exist", id)
 	}
 
+	if err := s.outbox.appendEventsOf(operation.Operation); err != nil {
+		return err
+	}
 	s.provisioningOperations[id] = operation
 	return nil
 }
@@ -89,6 +108,9 @@ func (s *operations) UpdateProvisioningOperation(op internal.ProvisioningOperati
 	if oldOp.Version != op.Version {
 		return nil, dberr.Conflict("unable to update provisioning operation with id %s (for instance id %s) - conflict", op.ID, op.InstanceID)
 	}
+	if err := s.outbox.appendEventsOf(op.Operation); err != nil {
+		return nil, err
+	}
 	op.Version = op.Version + 1
 	s.provisioningOperations[op.ID] = op
 
@@ -123,6 +145,9 @@ func (s *operations) InsertDeprovisioningOperation(operation internal.Deprovisio
 		return dberr.AlreadyExists("instance operation with id %s already exist", id)
 	}
 
+	if err := s.outbox.appendEventsOf(operation.Operation); err != nil {
+		return err
+	}
 	s.deprovisioningOperations[id] = operation
 	return nil
 }
@@ -168,6 +193,9 @@ func (s *operations) UpdateDeprovisioningOperation(op internal.DeprovisioningOpe
 	if oldOp.Version != op.Version {
 		return nil, dberr.Conflict("unable to update deprovisioning operation with id %s (for instance id %s) - conflict", op.ID, op.InstanceID)
 	}
+	if err := s.outbox.appendEventsOf(op.Operation); err != nil {
+		return nil, err
+	}
 	op.Version = op.Version + 1
 	s.deprovisioningOperations[op.ID] = op
 
@@ -215,6 +243,9 @@ func (s *operations) InsertUpgradeKymaOperation(operation internal.UpgradeKymaOp
 		return dberr.AlreadyExists("instance operation with id %s already exist", id)
 	}
 
+	if err := s.outbox.appendEventsOf(operation.Operation); err != nil {
+		return err
+	}
 	s.upgradeKymaOperations[id] = operation
 	return nil
 }
@@ -254,6 +285,9 @@ func (s *operations) UpdateUpgradeKymaOperation(op internal.UpgradeKymaOperation
 	if oldOp.Version != op.Version {
 		return nil, dberr.Conflict("unable to update upgradeKyma operation with id %s (for instance id %s) - conflict", op.Operation.ID, op.InstanceID)
 	}
+	if err := s.outbox.appendEventsOf(op.Operation); err != nil {
+		return nil, err
+	}
 	op.Version = op.Version + 1
 	s.upgradeKymaOperations[op.Operation.ID] = op
 
@@ -269,6 +303,9 @@ func (s *operations) InsertUpgradeClusterOperation(operation internal.UpgradeClu
 		return dberr.AlreadyExists("instance operation with id %s already exist", id)
 	}
 
+	if err := s.outbox.appendEventsOf(operation.Operation); err != nil {
+		return err
+	}
 	s.upgradeClusterOperations[id] = operation
 	return nil
 }
@@ -295,12 +332,127 @@ func (s *operations) UpdateUpgradeClusterOperation(op internal.UpgradeClusterOpe
 	if oldOp.Version != op.Version {
 		return nil, dberr.Conflict("unable to update upgradeKyma operation with id %s (for instance id %s) - conflict", op.Operation.ID, op.InstanceID)
 	}
+	if err := s.outbox.appendEventsOf(op.Operation); err != nil {
+		return nil, err
+	}
 	op.Version = op.Version + 1
 	s.upgradeClusterOperations[op.Operation.ID] = op
 
 	return &op, nil
 }
 
+func (s *operations) InsertRunTaskOperation(operation internal.RunTaskOperation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := operation.Operation.ID
+	if _, exists := s.runTaskOperations[id]; exists {
+		return dberr.AlreadyExists("instance operation with id %s already exist", id)
+	}
+
+	if err := s.outbox.appendEventsOf(operation.Operation); err != nil {
+		return err
+	}
+	s.runTaskOperations[id] = operation
+	return nil
+}
+
+func (s *operations) GetRunTaskOperationByID(operationID string) (*internal.RunTaskOperation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	op, exists := s.runTaskOperations[operationID]
+	if !exists {
+		return nil, dberr.NotFound("instance runTask operation with id %s not found", operationID)
+	}
+	return &op, nil
+}
+
+func (s *operations) UpdateRunTaskOperation(op internal.RunTaskOperation) (*internal.RunTaskOperation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	oldOp, exists := s.runTaskOperations[op.Operation.ID]
+	if !exists {
+		return nil, dberr.NotFound("instance operation with id %s not found", op.Operation.ID)
+	}
+	if oldOp.Version != op.Version {
+		return nil, dberr.Conflict("unable to update runTask operation with id %s (for instance id %s) - conflict", op.Operation.ID, op.InstanceID)
+	}
+	if err := s.outbox.appendEventsOf(op.Operation); err != nil {
+		return nil, err
+	}
+	op.Version = op.Version + 1
+	s.runTaskOperations[op.Operation.ID] = op
+
+	return &op, nil
+}
+
+func (s *operations) InsertHibernationOperation(operation internal.HibernationOperation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := operation.Operation.ID
+	if _, exists := s.hibernationOperations[id]; exists {
+		return dberr.AlreadyExists("instance operation with id %s already exist", id)
+	}
+
+	if err := s.outbox.appendEventsOf(operation.Operation); err != nil {
+		return err
+	}
+	s.hibernationOperations[id] = operation
+	return nil
+}
+
+func (s *operations) GetHibernationOperationByID(operationID string) (*internal.HibernationOperation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	op, exists := s.hibernationOperations[operationID]
+	if !exists {
+		return nil, dberr.NotFound("instance hibernation operation with id %s not found", operationID)
+	}
+	return &op, nil
+}
+
+func (s *operations) UpdateHibernationOperation(op internal.HibernationOperation) (*internal.HibernationOperation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	oldOp, exists := s.hibernationOperations[op.Operation.ID]
+	if !exists {
+		return nil, dberr.NotFound("instance operation with id %s not found", op.Operation.ID)
+	}
+	if oldOp.Version != op.Version {
+		return nil, dberr.Conflict("unable to update hibernation operation with id %s (for instance id %s) - conflict", op.Operation.ID, op.InstanceID)
+	}
+	if err := s.outbox.appendEventsOf(op.Operation); err != nil {
+		return nil, err
+	}
+	op.Version = op.Version + 1
+	s.hibernationOperations[op.Operation.ID] = op
+
+	return &op, nil
+}
+
+func (s *operations) ListHibernationOperationsByInstanceID(instanceID string) ([]internal.HibernationOperation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	operations := make([]internal.HibernationOperation, 0)
+	for _, op := range s.hibernationOperations {
+		if op.InstanceID == instanceID {
+			operations = append(operations, op)
+		}
+	}
+
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].CreatedAt.After(operations[j].CreatedAt)
+	})
+
+	return operations, nil
+}
+
 func (s *operations) GetLastOperation(instanceID string) (*internal.Operation, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -332,6 +484,12 @@ func (s *operations) GetLastOperation(instanceID string) (*internal.Operation, e
 			rows = append(rows, op.Operation)
 		}
 	}
+	for _, op := range s.hibernationOperations {
+		if op.InstanceID == instanceID && op.State != orchestration.Pending {
+			rows = append(rows, op.Operation)
+		}
+	}
+	// run task operations do not change the instance, so they are never the last operation of the instance
 
 	if len(rows) == 0 {
 		return nil, dberr.NotFound("instance operation with instance_id %s not found", instanceID)
@@ -366,10 +524,18 @@ func (s *operations) GetOperationByID(operationID string) (*internal.Operation,
 	if exists {
 		res = &upgradeClusterOp.Operation
 	}
+	runTaskOp, exists := s.runTaskOperations[operationID]
+	if exists {
+		res = &runTaskOp.Operation
+	}
 	updateOp, exists := s.updateOperations[operationID]
 	if exists {
 		res = &updateOp.Operation
 	}
+	hibernationOp, exists := s.hibernationOperations[operationID]
+	if exists {
+		res = &hibernationOp.Operation
+	}
 	if res == nil {
 		return nil, dberr.NotFound("instance operation with id %s not found", operationID)
 	}
@@ -395,6 +561,12 @@ func (s *operations) GetNotFinishedOperationsByType(opType internal.OperationTyp
 				ops = append(ops, op.Operation)
 			}
 		}
+	case internal.OperationTypeHibernate, internal.OperationTypeWakeUp:
+		for _, op := range s.hibernationOperations {
+			if op.Type == opType && op.State == domain.InProgress {
+				ops = append(ops, op.Operation)
+			}
+		}
 	}
 
 	return ops, nil
@@ -426,6 +598,20 @@ func (s *operations) GetOperationsForIDs(opIdList []string) ([]internal.Operatio
 			}
 		}
 	}
+	for _, opID := range opIdList {
+		for _, op := range s.runTaskOperations {
+			if op.Operation.ID == opID {
+				ops = append(ops, op.Operation)
+			}
+		}
+	}
+	for _, opID := range opIdList {
+		for _, op := range s.hibernationOperations {
+			if op.Operation.ID == opID {
+				ops = append(ops, op.Operation)
+			}
+		}
+	}
 
 	for _, opID := range opIdList {
 		for _, op := range s.provisioningOperations {
@@ -506,6 +692,12 @@ func (s *operations) GetOperationStatsForOrchestration(orchestrationID string) (
 			result[string(op.State)] = result[string(op.State)] + 1
 		}
 	}
+
+	for _, op := range s.runTaskOperations {
+		if op.OrchestrationID == orchestrationID {
+			result[string(op.State)] = result[string(op.State)] + 1
+		}
+	}
 	return result, nil
 }
 
@@ -532,6 +724,32 @@ func (s *operations) ListOperations(filter dbmodel.OperationFilter) ([]internal.
 		nil
 }
 
+func (s *operations) ListOperationsUpdatedAfter(updatedAt time.Time, operationID string, limit int) ([]internal.Operation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ops, err := s.getAll()
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing operations")
+	}
+	result := make([]internal.Operation, 0)
+	for _, op := range ops {
+		if op.UpdatedAt.After(updatedAt) || (op.UpdatedAt.Equal(updatedAt) && op.ID > operationID) {
+			result = append(result, op)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].UpdatedAt.Equal(result[j].UpdatedAt) {
+			return result[i].ID < result[j].ID
+		}
+		return result[i].UpdatedAt.Before(result[j].UpdatedAt)
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
 func (s *operations) ListUpgradeKymaOperations() ([]internal.UpgradeKymaOperation, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -613,6 +831,28 @@ func (s *operations) ListUpgradeClusterOperationsByInstanceID(instanceID string)
 	return nil, dberr.NotFound("instance upgrade operations with instanceID %s not found", instanceID)
 }
 
+func (s *operations) ListRunTaskOperationsByOrchestrationID(orchestrationID string, filter dbmodel.OperationFilter) ([]internal.RunTaskOperation, int, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]internal.RunTaskOperation, 0)
+	offset := pagination.ConvertPageAndPageSizeToOffset(filter.PageSize, filter.Page)
+
+	operations := s.filterRunTask(orchestrationID, filter)
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].CreatedAt.Before(operations[j].CreatedAt)
+	})
+
+	for i := offset; (filter.PageSize < 1 || i < offset+filter.PageSize) && i < len(operations); i++ {
+		result = append(result, s.runTaskOperations[operations[i].Operation.ID])
+	}
+
+	return result,
+		len(result),
+		len(operations),
+		nil
+}
+
 func (s *operations) InsertUpdatingOperation(operation internal.UpdatingOperation) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -622,6 +862,9 @@ func (s *operations) InsertUpdatingOperation(operation internal.UpdatingOperatio
 		return dberr.AlreadyExists("instance operation with id %s already exist", id)
 	}
 
+	if err := s.outbox.appendEventsOf(operation.Operation); err != nil {
+		return err
+	}
 	s.updateOperations[id] = operation
 	return nil
 }
@@ -650,6 +893,9 @@ func (s *operations) UpdateUpdatingOperation(op internal.UpdatingOperation) (*in
 	if oldOp.Version != op.Version {
 		return nil, dberr.Conflict("unable to update updating operation with id %s (for instance id %s) - conflict", op.ID, op.InstanceID)
 	}
+	if err := s.outbox.appendEventsOf(op.Operation); err != nil {
+		return nil, err
+	}
 	op.Version = op.Version + 1
 	s.updateOperations[op.ID] = op
 
@@ -726,12 +972,21 @@ func (s *operations) getAll() ([]internal.Operation, error) {
 	for _, op := range s.upgradeClusterOperations {
 		ops = append(ops, op.Operation)
 	}
+	for _, op := range s.runTaskOperations {
+		ops = append(ops, op.Operation)
+	}
 	for _, op := range s.provisioningOperations {
 		ops = append(ops, op.Operation)
 	}
 	for _, op := range s.deprovisioningOperations {
 		ops = append(ops, op.Operation)
 	}
+	for _, op := range s.updateOperations {
+		ops = append(ops, op.Operation)
+	}
+	for _, op := range s.hibernationOperations {
+		ops = append(ops, op.Operation)
+	}
 	if len(ops) == 0 {
 		return nil, dberr.NotFound("operations not found")
 	}
@@ -818,6 +1073,22 @@ func (s *operations) filterUpgradeClusterByInstanceID(instanceID string, filter
 	return operations
 }
 
+func (s *operations) filterRunTask(orchestrationID string, filter dbmodel.OperationFilter) []internal.RunTaskOperation {
+	operations := make([]internal.RunTaskOperation, 0, len(s.runTaskOperations))
+	for _, v := range s.runTaskOperations {
+		if orchestrationID != "" && orchestrationID != v.OrchestrationID {
+			continue
+		}
+		if ok := matchFilter(string(v.State), filter.States, s.equalFilter); !ok {
+			continue
+		}
+
+		operations = append(operations, v)
+	}
+
+	return operations
+}
+
 func (s *operations) equalFilter(a, b string) bool {
 	return a == b
 }
@@ -0,0 +1,35 @@
+package memory
+
+import (
+	"sort"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+)
+
+func (s *operations) InsertOperationStep(step internal.OperationStep) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.operationSteps[step.OperationID] {
+		if existing.ID == step.ID {
+			return dberr.AlreadyExists("operation step with id %s already exist", step.ID)
+		}
+	}
+	s.operationSteps[step.OperationID] = append(s.operationSteps[step.OperationID], step)
+
+	return nil
+}
+
+func (s *operations) ListOperationSteps(operationID string) ([]internal.OperationStep, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	steps := make([]internal.OperationStep, len(s.operationSteps[operationID]))
+	copy(steps, s.operationSteps[operationID])
+	sort.SliceStable(steps, func(i, j int) bool {
+		return steps[i].StartedAt.Before(steps[j].StartedAt)
+	})
+
+	return steps, nil
+}
@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+)
+
+type outbox struct {
+	mu sync.Mutex
+
+	events      []internal.OutboxEvent
+	sequence    int64
+	checkpoints map[string]int64
+	deadLetters []internal.OutboxDeadLetter
+
+	operationEvents func(op internal.Operation) ([]internal.OutboxEvent, error)
+}
+
+func NewOutbox() *outbox {
+	return &outbox{
+		checkpoints: make(map[string]int64, 0),
+	}
+}
+
+func (s *outbox) SetOperationEvents(events func(op internal.Operation) ([]internal.OutboxEvent, error)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.operationEvents = events
+}
+
+// appendEventsOf appends events of the operation, it is called by the storage of operations before the operation is saved
+func (s *outbox) appendEventsOf(op internal.Operation) error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.operationEvents == nil {
+		return nil
+	}
+	events, err := s.operationEvents(op)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		s.append(event)
+	}
+	return nil
+}
+
+func (s *outbox) Append(event internal.OutboxEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.append(event)
+	return nil
+}
+
+func (s *outbox) append(event internal.OutboxEvent) {
+	s.sequence++
+	event.Sequence = s.sequence
+	event.CreatedAt = time.Now()
+	s.events = append(s.events, event)
+}
+
+func (s *outbox) ListAfter(sequence int64, limit int) ([]internal.OutboxEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]internal.OutboxEvent, 0)
+	for _, event := range s.events {
+		if len(result) >= limit {
+			break
+		}
+		if event.Sequence > sequence {
+			result = append(result, event)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *outbox) DeleteUpTo(sequence int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := make([]internal.OutboxEvent, 0, len(s.events))
+	for _, event := range s.events {
+		if event.Sequence > sequence {
+			kept = append(kept, event)
+		}
+	}
+	s.events = kept
+
+	return nil
+}
+
+func (s *outbox) GetCheckpoint(subscriber string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.checkpoints[subscriber], nil
+}
+
+func (s *outbox) SaveCheckpoint(subscriber string, sequence int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkpoints[subscriber] = sequence
+
+	return nil
+}
+
+func (s *outbox) SaveDeadLetter(letter internal.OutboxDeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, l := range s.deadLetters {
+		if l.Subscriber == letter.Subscriber && l.Sequence == letter.Sequence {
+			return nil
+		}
+	}
+	letter.CreatedAt = time.Now()
+	s.deadLetters = append(s.deadLetters, letter)
+
+	return nil
+}
+
+func (s *outbox) ListDeadLetters(subscriber string) ([]internal.OutboxDeadLetter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]internal.OutboxDeadLetter, 0)
+	for _, letter := range s.deadLetters {
+		if letter.Subscriber == subscriber {
+			result = append(result, letter)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+)
+
+type queueItems struct {
+	mu sync.Mutex
+
+	items map[string]map[string]internal.QueueItem
+}
+
+func NewQueueItems() *queueItems {
+	return &queueItems{
+		items: make(map[string]map[string]internal.QueueItem, 0),
+	}
+}
+
+func (s *queueItems) Enqueue(queue, itemID string, dueAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	item, exists := s.queue(queue)[itemID]
+	if !exists {
+		s.queue(queue)[itemID] = internal.QueueItem{Queue: queue, ItemID: itemID, DueAt: dueAt, CreatedAt: now, UpdatedAt: now}
+		return nil
+	}
+
+	if dueAt.Before(item.DueAt) {
+		item.DueAt = dueAt
+	}
+	item.Generation++
+	item.UpdatedAt = now
+	s.queue(queue)[itemID] = item
+
+	return nil
+}
+
+func (s *queueItems) EnqueueIfAbsent(queue, itemID string, dueAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.queue(queue)[itemID]; exists {
+		return nil
+	}
+	now := time.Now()
+	s.queue(queue)[itemID] = internal.QueueItem{Queue: queue, ItemID: itemID, DueAt: dueAt, CreatedAt: now, UpdatedAt: now}
+
+	return nil
+}
+
+func (s *queueItems) Lease(queue, owner string, now time.Time, leaseDuration time.Duration, limit int) ([]internal.QueueItem, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	candidates := make([]internal.QueueItem, 0)
+	for _, item := range s.queue(queue) {
+		if item.DueAt.After(now) {
+			continue
+		}
+		if item.LeaseOwner != "" && !item.LeaseExpiresAt.Before(now) {
+			continue
+		}
+		candidates = append(candidates, item)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].DueAt.Before(candidates[j].DueAt)
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	for i := range candidates {
+		candidates[i].LeaseOwner = owner
+		candidates[i].LeaseExpiresAt = now.Add(leaseDuration)
+		candidates[i].UpdatedAt = now
+		s.queue(queue)[candidates[i].ItemID] = candidates[i]
+	}
+
+	return candidates, nil
+}
+
+func (s *queueItems) RenewLease(item internal.QueueItem, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, exists := s.queue(item.Queue)[item.ItemID]
+	if !exists || stored.LeaseOwner != item.LeaseOwner {
+		return dberr.NotFound("queue item %s/%s leased by %s not exist", item.Queue, item.ItemID, item.LeaseOwner)
+	}
+	stored.LeaseExpiresAt = expiresAt
+	s.queue(item.Queue)[item.ItemID] = stored
+
+	return nil
+}
+
+func (s *queueItems) Complete(item internal.QueueItem) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, exists := s.queue(item.Queue)[item.ItemID]
+	if !exists || stored.LeaseOwner != item.LeaseOwner {
+		return nil
+	}
+	if stored.Generation == item.Generation {
+		delete(s.queue(item.Queue), item.ItemID)
+		return nil
+	}
+	stored.LeaseOwner = ""
+	stored.UpdatedAt = time.Now()
+	s.queue(item.Queue)[item.ItemID] = stored
+
+	return nil
+}
+
+func (s *queueItems) Reschedule(item internal.QueueItem, dueAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, exists := s.queue(item.Queue)[item.ItemID]
+	if !exists || stored.LeaseOwner != item.LeaseOwner {
+		return nil
+	}
+	if stored.Generation == item.Generation || dueAt.Before(stored.DueAt) {
+		stored.DueAt = dueAt
+	}
+	stored.LeaseOwner = ""
+	stored.UpdatedAt = time.Now()
+	s.queue(item.Queue)[item.ItemID] = stored
+
+	return nil
+}
+
+func (s *queueItems) queue(name string) map[string]internal.QueueItem {
+	if _, exists := s.items[name]; !exists {
+		s.items[name] = make(map[string]internal.QueueItem, 0)
+	}
+	return s.items[name]
+}
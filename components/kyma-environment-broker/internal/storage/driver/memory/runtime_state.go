@@ -3,6 +3,7 @@ package memory
 import (
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 
@@ -47,6 +48,17 @@ func (s *runtimeState) ListByRuntimeID(runtimeID string) ([]internal.RuntimeStat
 	return result, nil
 }
 
+func (s *runtimeState) GetByID(stateID string) (internal.RuntimeState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, found := s.runtimeStates[stateID]
+	if !found {
+		return internal.RuntimeState{}, dberr.NotFound("runtime state with ID %s not found", stateID)
+	}
+	return state, nil
+}
+
 func (s *runtimeState) GetByOperationID(operationID string) (internal.RuntimeState, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -87,6 +99,21 @@ func (s *runtimeState) GetLatestWithKymaVersionByRuntimeID(runtimeID string) (in
 	return internal.RuntimeState{}, dberr.NotFound("runtime state with Reconciler input for runtime with ID: %s not found", runtimeID)
 }
 
+func (s *runtimeState) GetLatestWithKymaVersionByRuntimeIDBefore(runtimeID string, before time.Time) (internal.RuntimeState, error) {
+	states, err := s.getRuntimeStatesByRuntimeID(runtimeID)
+	if err != nil {
+		return internal.RuntimeState{}, err
+	}
+
+	for _, state := range states {
+		if state.CreatedAt.Before(before) && state.GetKymaVersion() != "" {
+			return state, nil
+		}
+	}
+
+	return internal.RuntimeState{}, dberr.NotFound("runtime state with kyma version for runtime with ID: %s created before %s not found", runtimeID, before)
+}
+
 func (s *runtimeState) GetLatestWithReconcilerInputByRuntimeID(runtimeID string) (internal.RuntimeState, error) {
 	states, err := s.getRuntimeStatesByRuntimeID(runtimeID)
 	if err != nil {
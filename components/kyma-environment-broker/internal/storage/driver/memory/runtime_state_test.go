@@ -70,3 +70,37 @@ func Test_runtimeState_GetLatestWithReconcilerInputByRuntimeID(t *testing.T) {
 	// then
 	assert.Equal(t, expectedRuntimeState.ID, gotRuntimeState.ID)
 }
+
+func Test_runtimeState_GetLatestWithKymaVersionByRuntimeIDBefore(t *testing.T) {
+	// given
+	runtimeStates := NewRuntimeStates()
+	fixRuntimeID := "runtime1"
+	before := time.Now()
+
+	expectedRuntimeState := fixture.FixRuntimeState("expected", fixRuntimeID, uuid.NewString())
+	expectedRuntimeState.KymaConfig.Version = "2.2.0"
+	expectedRuntimeState.CreatedAt = before.Add(-time.Hour)
+
+	olderRuntimeState := fixture.FixRuntimeState("older", fixRuntimeID, uuid.NewString())
+	olderRuntimeState.KymaConfig.Version = "2.1.0"
+	olderRuntimeState.CreatedAt = before.Add(-2 * time.Hour)
+
+	withoutVersionRuntimeState := fixture.FixRuntimeState("without-version", fixRuntimeID, uuid.NewString())
+	withoutVersionRuntimeState.CreatedAt = before.Add(-time.Minute)
+
+	newerRuntimeState := fixture.FixRuntimeState("newer", fixRuntimeID, uuid.NewString())
+	newerRuntimeState.KymaConfig.Version = "2.3.0"
+	newerRuntimeState.CreatedAt = before.Add(time.Minute)
+
+	runtimeStates.Insert(olderRuntimeState)
+	runtimeStates.Insert(expectedRuntimeState)
+	runtimeStates.Insert(withoutVersionRuntimeState)
+	runtimeStates.Insert(newerRuntimeState)
+
+	// when
+	gotRuntimeState, err := runtimeStates.GetLatestWithKymaVersionByRuntimeIDBefore(fixRuntimeID, before)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, expectedRuntimeState.ID, gotRuntimeState.ID)
+}
@@ -0,0 +1,153 @@
+package postsql
+
+import (
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type bindings struct {
+	postsql.Factory
+
+	cipher Cipher
+}
+
+func NewBindings(sess postsql.Factory, cipher Cipher) *bindings {
+	return &bindings{
+		Factory: sess,
+		cipher:  cipher,
+	}
+}
+
+func (s *bindings) Insert(binding internal.Binding) error {
+	dto, err := s.toBindingDTO(binding)
+	if err != nil {
+		return err
+	}
+
+	sess := s.NewWriteSession()
+	var lastErr dberr.Error
+	err = wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = sess.InsertBinding(dto)
+		if lastErr != nil {
+			if dberr.IsAlreadyExists(lastErr) {
+				return false, lastErr
+			}
+			log.Errorf("while saving binding ID %s: %v", binding.ID, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return lastErr
+	}
+	return nil
+}
+
+func (s *bindings) Get(instanceID, bindingID string) (*internal.Binding, error) {
+	sess := s.NewReadSession()
+	var dto dbmodel.BindingDTO
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		dto, lastErr = sess.GetBinding(instanceID, bindingID)
+		if lastErr != nil {
+			if dberr.IsNotFound(lastErr) {
+				return false, dberr.NotFound("Binding with id %s for instance %s not exist", bindingID, instanceID)
+			}
+			log.Errorf("while getting binding by ID %s: %v", bindingID, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, lastErr
+	}
+
+	binding, err := s.toBinding(dto)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting binding")
+	}
+	return &binding, nil
+}
+
+func (s *bindings) ListByInstanceID(instanceID string) ([]internal.Binding, error) {
+	sess := s.NewReadSession()
+	var dtos []dbmodel.BindingDTO
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		dtos, lastErr = sess.ListBindingsByInstanceID(instanceID)
+		if lastErr != nil {
+			log.Errorf("while getting bindings for instance ID %s: %v", instanceID, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, lastErr
+	}
+
+	result := make([]internal.Binding, 0, len(dtos))
+	for _, dto := range dtos {
+		binding, err := s.toBinding(dto)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting bindings")
+		}
+		result = append(result, binding)
+	}
+	return result, nil
+}
+
+func (s *bindings) Delete(instanceID, bindingID string) error {
+	sess := s.NewWriteSession()
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = sess.DeleteBinding(instanceID, bindingID)
+		if lastErr != nil {
+			log.Errorf("while deleting binding ID %s: %v", bindingID, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return lastErr
+	}
+	return nil
+}
+
+func (s *bindings) toBindingDTO(binding internal.Binding) (dbmodel.BindingDTO, error) {
+	encKubeconfig, err := s.cipher.Encrypt([]byte(binding.Kubeconfig))
+	if err != nil {
+		return dbmodel.BindingDTO{}, errors.Wrap(err, "while encrypting kubeconfig")
+	}
+
+	return dbmodel.BindingDTO{
+		ID:                binding.ID,
+		InstanceID:        binding.InstanceID,
+		CreatedAt:         binding.CreatedAt,
+		UpdatedAt:         binding.UpdatedAt,
+		ExpiresAt:         binding.ExpiresAt,
+		Kubeconfig:        string(encKubeconfig),
+		ExpirationSeconds: binding.ExpirationSeconds,
+	}, nil
+}
+
+func (s *bindings) toBinding(dto dbmodel.BindingDTO) (internal.Binding, error) {
+	kubeconfig, err := s.cipher.Decrypt([]byte(dto.Kubeconfig))
+	if err != nil {
+		return internal.Binding{}, errors.Wrap(err, "while decrypting kubeconfig")
+	}
+
+	return internal.Binding{
+		ID:                dto.ID,
+		InstanceID:        dto.InstanceID,
+		CreatedAt:         dto.CreatedAt,
+		UpdatedAt:         dto.UpdatedAt,
+		ExpiresAt:         dto.ExpiresAt,
+		Kubeconfig:        string(kubeconfig),
+		ExpirationSeconds: dto.ExpirationSeconds,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package postsql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinding(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("should insert, fetch and delete Binding", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		givenBinding := internal.Binding{
+			ID:                "binding-id",
+			InstanceID:        "instance-id",
+			CreatedAt:         time.Now().Truncate(time.Millisecond),
+			UpdatedAt:         time.Now().Truncate(time.Millisecond),
+			ExpiresAt:         time.Now().Add(10 * time.Minute).Truncate(time.Millisecond),
+			Kubeconfig:        "kubeconfig",
+			ExpirationSeconds: 600,
+		}
+
+		svc := brokerStorage.Bindings()
+
+		err = svc.Insert(givenBinding)
+		require.NoError(t, err)
+
+		err = svc.Insert(givenBinding)
+		assert.True(t, dberr.IsAlreadyExists(err))
+
+		binding, err := svc.Get(givenBinding.InstanceID, givenBinding.ID)
+		require.NoError(t, err)
+		assert.Equal(t, givenBinding.Kubeconfig, binding.Kubeconfig)
+		assert.Equal(t, givenBinding.ExpirationSeconds, binding.ExpirationSeconds)
+		assert.True(t, givenBinding.ExpiresAt.Equal(binding.ExpiresAt))
+
+		bindings, err := svc.ListByInstanceID(givenBinding.InstanceID)
+		require.NoError(t, err)
+		assert.Len(t, bindings, 1)
+
+		err = svc.Delete(givenBinding.InstanceID, givenBinding.ID)
+		require.NoError(t, err)
+
+		_, err = svc.Get(givenBinding.InstanceID, givenBinding.ID)
+		assert.True(t, dberr.IsNotFound(err))
+	})
+}
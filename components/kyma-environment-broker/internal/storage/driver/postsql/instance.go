@@ -336,13 +336,16 @@ func (s *Instance) GetByID(instanceID string) (*internal.Instance, error) {
 
 func (s *Instance) toInstance(dto dbmodel.InstanceDTO) (internal.Instance, error) {
 	var params internal.ProvisioningParameters
-	err := json.Unmarshal([]byte(dto.ProvisioningParameters), &params)
-	if err != nil {
-		return internal.Instance{}, errors.Wrap(err, "while unmarshal parameters")
-	}
-	err = s.cipher.DecryptSMCreds(&params)
-	if err != nil {
-		return internal.Instance{}, errors.Wrap(err, "while decrypting parameters")
+	// the parameters are not loaded if the listed columns do not include them
+	if dto.ProvisioningParameters != "" {
+		err := json.Unmarshal([]byte(dto.ProvisioningParameters), &params)
+		if err != nil {
+			return internal.Instance{}, errors.Wrap(err, "while unmarshal parameters")
+		}
+		err = s.cipher.DecryptSMCreds(&params)
+		if err != nil {
+			return internal.Instance{}, errors.Wrap(err, "while decrypting parameters")
+		}
 	}
 	return internal.Instance{
 		InstanceID:                  dto.InstanceID,
@@ -3,6 +3,7 @@ package postsql
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -21,12 +22,16 @@ import (
 type operations struct {
 	postsql.Factory
 	cipher Cipher
+	outbox *outbox
 }
 
-func NewOperation(sess postsql.Factory, cipher Cipher) *operations {
+// NewOperation creates the storage of operations, which saves events of operations in the outbox
+// in the same transaction as the operation
+func NewOperation(sess postsql.Factory, cipher Cipher, outbox *outbox) *operations {
 	return &operations{
 		Factory: sess,
 		cipher:  cipher,
+		outbox:  outbox,
 	}
 }
 
@@ -37,7 +42,7 @@ func (s *operations) InsertProvisioningOperation(operation internal.Provisioning
 		return errors.Wrapf(err, "while inserting provisioning operation (id: %s)", operation.ID)
 	}
 
-	return s.insert(dto)
+	return s.insert(dto, operation.Operation)
 }
 
 // GetProvisioningOperationByID fetches the ProvisioningOperation by given ID, returns error if not found
@@ -79,7 +84,7 @@ func (s *operations) UpdateProvisioningOperation(op internal.ProvisioningOperati
 		return nil, errors.Wrapf(err, "while converting Operation to DTO")
 	}
 
-	lastErr := s.update(dto)
+	lastErr := s.update(dto, op.Operation)
 	op.Version = op.Version + 1
 
 	return &op, lastErr
@@ -108,7 +113,7 @@ func (s *operations) InsertDeprovisioningOperation(operation internal.Deprovisio
 		return errors.Wrapf(err, "while converting Operation to DTO")
 	}
 
-	return s.insert(dto)
+	return s.insert(dto, operation.Operation)
 }
 
 // GetDeprovisioningOperationByID fetches the DeprovisioningOperation by given ID, returns error if not found
@@ -149,7 +154,7 @@ func (s *operations) UpdateDeprovisioningOperation(operation internal.Deprovisio
 		return nil, errors.Wrapf(err, "while converting Operation to DTO")
 	}
 
-	lastErr := s.update(dto)
+	lastErr := s.update(dto, operation.Operation)
 	operation.Version = operation.Version + 1
 	return &operation, lastErr
 }
@@ -193,7 +198,7 @@ func (s *operations) InsertUpgradeKymaOperation(operation internal.UpgradeKymaOp
 		return errors.Wrapf(err, "while inserting upgrade kyma operation (id: %s)", operation.Operation.ID)
 	}
 
-	return s.insert(dto)
+	return s.insert(dto, operation.Operation)
 }
 
 // GetUpgradeKymaOperationByID fetches the UpgradeKymaOperation by given ID, returns error if not found
@@ -262,7 +267,7 @@ func (s *operations) UpdateUpgradeKymaOperation(operation internal.UpgradeKymaOp
 		return nil, errors.Wrapf(err, "while converting Operation to DTO")
 	}
 
-	err = s.update(dto)
+	err = s.update(dto, operation.Operation)
 	operation.Version = operation.Version + 1
 	return &operation, err
 }
@@ -420,6 +425,27 @@ func (s *operations) ListOperations(filter dbmodel.OperationFilter) ([]internal.
 	return result, size, total, err
 }
 
+func (s *operations) ListOperationsUpdatedAfter(updatedAt time.Time, operationID string, limit int) ([]internal.Operation, error) {
+	session := s.NewReadSession()
+	var (
+		operations []dbmodel.OperationDTO
+		lastErr    dberr.Error
+	)
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		operations, lastErr = session.ListOperationsUpdatedAfter(updatedAt, operationID, limit)
+		if lastErr != nil {
+			log.Errorf("while listing updated operations: %v", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, lastErr
+	}
+
+	return s.toOperations(operations)
+}
+
 func (s *operations) ListUpgradeKymaOperationsByOrchestrationID(orchestrationID string, filter dbmodel.OperationFilter) ([]internal.UpgradeKymaOperation, int, int, error) {
 	session := s.NewReadSession()
 	var (
@@ -456,7 +482,7 @@ func (s *operations) InsertUpdatingOperation(operation internal.UpdatingOperatio
 		return errors.Wrapf(err, "while converting update operation (id: %s)", operation.Operation.ID)
 	}
 
-	return s.insert(dto)
+	return s.insert(dto, operation.Operation)
 }
 
 func (s *operations) GetUpdatingOperationByID(operationID string) (*internal.UpdatingOperation, error) {
@@ -474,30 +500,13 @@ func (s *operations) GetUpdatingOperationByID(operationID string) (*internal.Upd
 }
 
 func (s *operations) UpdateUpdatingOperation(operation internal.UpdatingOperation) (*internal.UpdatingOperation, error) {
-	session := s.NewWriteSession()
 	operation.UpdatedAt = time.Now()
 	dto, err := s.updateOperationToDTO(&operation)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while converting Operation to DTO")
 	}
 
-	var lastErr error
-	_ = wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
-		lastErr = session.UpdateOperation(dto)
-		if lastErr != nil && dberr.IsNotFound(lastErr) {
-			_, lastErr = s.NewReadSession().GetOperationByID(operation.Operation.ID)
-			if lastErr != nil {
-				log.Errorf("while getting operation: %v", lastErr)
-				return false, nil
-			}
-
-			// the operation exists but the version is different
-			lastErr = dberr.Conflict("operation update conflict, operation ID: %s", operation.Operation.ID)
-			log.Warn(lastErr.Error())
-			return false, lastErr
-		}
-		return true, nil
-	})
+	lastErr := s.update(dto, operation.Operation)
 	operation.Version = operation.Version + 1
 	return &operation, lastErr
 }
@@ -533,35 +542,18 @@ func (s *operations) InsertUpgradeClusterOperation(operation internal.UpgradeClu
 		return errors.Wrapf(err, "while converting upgrade cluser operation (id: %s)", operation.Operation.ID)
 	}
 
-	return s.insert(dto)
+	return s.insert(dto, operation.Operation)
 }
 
 // UpdateUpgradeClusterOperation updates UpgradeClusterOperation, fails if not exists or optimistic locking failure occurs.
 func (s *operations) UpdateUpgradeClusterOperation(operation internal.UpgradeClusterOperation) (*internal.UpgradeClusterOperation, error) {
-	session := s.NewWriteSession()
 	operation.UpdatedAt = time.Now()
 	dto, err := s.upgradeClusterOperationToDTO(&operation)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while converting Operation to DTO")
 	}
 
-	var lastErr error
-	_ = wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
-		lastErr = session.UpdateOperation(dto)
-		if lastErr != nil && dberr.IsNotFound(lastErr) {
-			_, lastErr = s.NewReadSession().GetOperationByID(operation.Operation.ID)
-			if lastErr != nil {
-				log.Errorf("while getting operation: %v", lastErr)
-				return false, nil
-			}
-
-			// the operation exists but the version is different
-			lastErr = dberr.Conflict("operation update conflict, operation ID: %s", operation.Operation.ID)
-			log.Warn(lastErr.Error())
-			return false, lastErr
-		}
-		return true, nil
-	})
+	lastErr := s.update(dto, operation.Operation)
 	operation.Version = operation.Version + 1
 	return &operation, lastErr
 }
@@ -635,6 +627,137 @@ func (s *operations) ListUpgradeClusterOperationsByOrchestrationID(orchestration
 	return ret, count, totalCount, nil
 }
 
+// InsertRunTaskOperation insert new RunTaskOperation to storage
+func (s *operations) InsertRunTaskOperation(operation internal.RunTaskOperation) error {
+	dto, err := s.runTaskOperationToDTO(&operation)
+	if err != nil {
+		return errors.Wrapf(err, "while converting run task operation (id: %s)", operation.Operation.ID)
+	}
+
+	return s.insert(dto, operation.Operation)
+}
+
+// UpdateRunTaskOperation updates RunTaskOperation, fails if not exists or optimistic locking failure occurs.
+func (s *operations) UpdateRunTaskOperation(operation internal.RunTaskOperation) (*internal.RunTaskOperation, error) {
+	operation.UpdatedAt = time.Now()
+	dto, err := s.runTaskOperationToDTO(&operation)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting Operation to DTO")
+	}
+
+	lastErr := s.update(dto, operation.Operation)
+	operation.Version = operation.Version + 1
+	return &operation, lastErr
+}
+
+// GetRunTaskOperationByID fetches the RunTaskOperation by given ID, returns error if not found
+func (s *operations) GetRunTaskOperationByID(operationID string) (*internal.RunTaskOperation, error) {
+	operation, err := s.getByID(operationID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting operation by ID")
+	}
+	ret, err := s.toRunTaskOperation(operation)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting DTO to Operation")
+	}
+
+	return ret, nil
+}
+
+// ListRunTaskOperationsByOrchestrationID Lists run task operations for the given orchestration, according to filter(s) and/or pagination
+func (s *operations) ListRunTaskOperationsByOrchestrationID(orchestrationID string, filter dbmodel.OperationFilter) ([]internal.RunTaskOperation, int, int, error) {
+	session := s.NewReadSession()
+	var (
+		operations        = make([]dbmodel.OperationDTO, 0)
+		lastErr           error
+		count, totalCount int
+	)
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		operations, count, totalCount, lastErr = session.ListOperationsByOrchestrationID(orchestrationID, filter)
+		if lastErr != nil {
+			if dberr.IsNotFound(lastErr) {
+				lastErr = dberr.NotFound("Operations for orchestration ID %s not exist", orchestrationID)
+				return false, lastErr
+			}
+			log.Errorf("while reading operation from the storage: %v", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, -1, -1, errors.Wrapf(err, "while getting operation by ID: %v", lastErr)
+	}
+	ret, err := s.toRunTaskOperationList(operations)
+	if err != nil {
+		return nil, -1, -1, errors.Wrapf(err, "while converting DTO to Operation")
+	}
+
+	return ret, count, totalCount, nil
+}
+
+// InsertHibernationOperation insert new HibernationOperation to storage
+func (s *operations) InsertHibernationOperation(operation internal.HibernationOperation) error {
+	dto, err := s.hibernationOperationToDTO(&operation)
+	if err != nil {
+		return errors.Wrapf(err, "while converting hibernation operation (id: %s)", operation.Operation.ID)
+	}
+
+	return s.insert(dto, operation.Operation)
+}
+
+// UpdateHibernationOperation updates HibernationOperation, fails if not exists or optimistic locking failure occurs.
+func (s *operations) UpdateHibernationOperation(operation internal.HibernationOperation) (*internal.HibernationOperation, error) {
+	operation.UpdatedAt = time.Now()
+	dto, err := s.hibernationOperationToDTO(&operation)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting Operation to DTO")
+	}
+
+	lastErr := s.update(dto, operation.Operation)
+	operation.Version = operation.Version + 1
+	return &operation, lastErr
+}
+
+// GetHibernationOperationByID fetches the HibernationOperation by given ID, returns error if not found
+func (s *operations) GetHibernationOperationByID(operationID string) (*internal.HibernationOperation, error) {
+	operation, err := s.getByID(operationID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting operation by ID")
+	}
+	ret, err := s.toHibernationOperation(operation)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting DTO to Operation")
+	}
+
+	return ret, nil
+}
+
+// ListHibernationOperationsByInstanceID lists hibernate and wake up operations of the given instance, the latest first
+func (s *operations) ListHibernationOperationsByInstanceID(instanceID string) ([]internal.HibernationOperation, error) {
+	operations := make([]dbmodel.OperationDTO, 0)
+	for _, opType := range []internal.OperationType{internal.OperationTypeHibernate, internal.OperationTypeWakeUp} {
+		ops, err := s.listOperations(instanceID, opType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing %s operations", opType)
+		}
+		operations = append(operations, ops...)
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].CreatedAt.After(operations[j].CreatedAt)
+	})
+
+	ret := make([]internal.HibernationOperation, 0, len(operations))
+	for _, op := range operations {
+		o, err := s.toHibernationOperation(&op)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting DTO to Operation")
+		}
+		ret = append(ret, *o)
+	}
+
+	return ret, nil
+}
+
 func (s *operations) operationToDB(op internal.Operation) (dbmodel.OperationDTO, error) {
 	err := s.cipher.EncryptSMCreds(&op.ProvisioningParameters)
 	if err != nil {
@@ -913,6 +1036,90 @@ func (s *operations) upgradeClusterOperationToDTO(op *internal.UpgradeClusterOpe
 	return ret, nil
 }
 
+func (s *operations) toRunTaskOperation(op *dbmodel.OperationDTO) (*internal.RunTaskOperation, error) {
+	if op.Type != internal.OperationTypeRunTask {
+		return nil, errors.New(fmt.Sprintf("expected operation type runTask, but was %s", op.Type))
+	}
+	var operation internal.RunTaskOperation
+	var err error
+	err = json.Unmarshal([]byte(op.Data), &operation)
+	if err != nil {
+		return nil, errors.New("unable to unmarshall run task data")
+	}
+	operation.Operation, err = s.toOperation(op, operation.InstanceDetails)
+	if err != nil {
+		return nil, err
+	}
+	operation.RuntimeOperation.ID = op.ID
+	if op.OrchestrationID.Valid {
+		operation.OrchestrationID = op.OrchestrationID.String
+	}
+
+	return &operation, nil
+}
+
+func (s *operations) toRunTaskOperationList(ops []dbmodel.OperationDTO) ([]internal.RunTaskOperation, error) {
+	result := make([]internal.RunTaskOperation, 0)
+
+	for _, op := range ops {
+		o, err := s.toRunTaskOperation(&op)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting to run task operation")
+		}
+		result = append(result, *o)
+	}
+
+	return result, nil
+}
+
+func (s *operations) runTaskOperationToDTO(op *internal.RunTaskOperation) (dbmodel.OperationDTO, error) {
+	serialized, err := json.Marshal(op)
+	if err != nil {
+		return dbmodel.OperationDTO{}, errors.Wrapf(err, "while serializing runTask data %v", op)
+	}
+
+	ret, err := s.operationToDB(op.Operation)
+	if err != nil {
+		return dbmodel.OperationDTO{}, errors.Wrapf(err, "while converting to operationDB %v", op)
+	}
+	ret.Data = string(serialized)
+	ret.Type = internal.OperationTypeRunTask
+	ret.OrchestrationID = storage.StringToSQLNullString(op.OrchestrationID)
+	return ret, nil
+}
+
+func (s *operations) toHibernationOperation(op *dbmodel.OperationDTO) (*internal.HibernationOperation, error) {
+	if op.Type != internal.OperationTypeHibernate && op.Type != internal.OperationTypeWakeUp {
+		return nil, errors.New(fmt.Sprintf("expected operation type hibernate or wakeUp, but was %s", op.Type))
+	}
+	var operation internal.HibernationOperation
+	var err error
+	err = json.Unmarshal([]byte(op.Data), &operation)
+	if err != nil {
+		return nil, errors.New("unable to unmarshall hibernation data")
+	}
+	operation.Operation, err = s.toOperation(op, operation.InstanceDetails)
+	if err != nil {
+		return nil, err
+	}
+
+	return &operation, nil
+}
+
+func (s *operations) hibernationOperationToDTO(op *internal.HibernationOperation) (dbmodel.OperationDTO, error) {
+	serialized, err := json.Marshal(op)
+	if err != nil {
+		return dbmodel.OperationDTO{}, errors.Wrapf(err, "while serializing hibernation data %v", op)
+	}
+
+	ret, err := s.operationToDB(op.Operation)
+	if err != nil {
+		return dbmodel.OperationDTO{}, errors.Wrapf(err, "while converting to operationDB %v", op)
+	}
+	ret.Data = string(serialized)
+	return ret, nil
+}
+
 func (s *operations) updateOperationToDTO(op *internal.UpdatingOperation) (dbmodel.OperationDTO, error) {
 	serialized, err := json.Marshal(op)
 	if err != nil {
@@ -986,11 +1193,17 @@ func (s *operations) getByID(id string) (*dbmodel.OperationDTO, error) {
 	return &operation, nil
 }
 
-func (s *operations) insert(dto dbmodel.OperationDTO) error {
-	session := s.NewWriteSession()
+func (s *operations) insert(dto dbmodel.OperationDTO, op internal.Operation) error {
+	events, err := s.outbox.eventsOf(op)
+	if err != nil {
+		return errors.Wrapf(err, "while creating events of operation %s", op.ID)
+	}
+
 	var lastErr error
 	_ = wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
-		lastErr = session.InsertOperation(dto)
+		lastErr = s.writeWithEvents(events, func(session postsql.WriteSession) dberr.Error {
+			return session.InsertOperation(dto)
+		})
 		if lastErr != nil {
 			log.Errorf("while insert operation: %v", lastErr)
 			return false, nil
@@ -1020,12 +1233,17 @@ func (s *operations) getByTypeAndInstanceID(id string, opType internal.Operation
 	return &operation, err
 }
 
-func (s *operations) update(operation dbmodel.OperationDTO) error {
-	session := s.NewWriteSession()
+func (s *operations) update(operation dbmodel.OperationDTO, op internal.Operation) error {
+	events, err := s.outbox.eventsOf(op)
+	if err != nil {
+		return errors.Wrapf(err, "while creating events of operation %s", op.ID)
+	}
 
 	var lastErr error
 	_ = wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
-		lastErr = session.UpdateOperation(operation)
+		lastErr = s.writeWithEvents(events, func(session postsql.WriteSession) dberr.Error {
+			return session.UpdateOperation(operation)
+		})
 		if lastErr != nil && dberr.IsNotFound(lastErr) {
 			_, lastErr = s.NewReadSession().GetOperationByID(operation.ID)
 			if lastErr != nil {
@@ -1043,6 +1261,33 @@ func (s *operations) update(operation dbmodel.OperationDTO) error {
 	return lastErr
 }
 
+// writeWithEvents runs the write of the operation and saves its events in the outbox in one transaction,
+// so events are saved if and only if the operation is saved
+func (s *operations) writeWithEvents(events []internal.OutboxEvent, write func(session postsql.WriteSession) dberr.Error) dberr.Error {
+	if len(events) == 0 {
+		return write(s.NewWriteSession())
+	}
+
+	session, err := s.NewSessionWithinTransaction()
+	if err != nil {
+		return err
+	}
+	defer session.RollbackUnlessCommitted()
+
+	if err := write(session); err != nil {
+		return err
+	}
+	for _, event := range events {
+		if err := session.InsertOutboxEvent(dbmodel.OutboxEventDTO{
+			EventType: event.EventType,
+			Payload:   event.Payload,
+		}); err != nil {
+			return err
+		}
+	}
+	return session.Commit()
+}
+
 func (s *operations) listOperations(instanceId string, operationType internal.OperationType) ([]dbmodel.OperationDTO, error) {
 	session := s.NewReadSession()
 	operations := []dbmodel.OperationDTO{}
@@ -0,0 +1,82 @@
+package postsql
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// InsertOperationStep saves the entry of the operation step journal
+func (s *operations) InsertOperationStep(step internal.OperationStep) error {
+	session := s.NewWriteSession()
+	dto := dbmodel.OperationStepDTO{
+		ID:           step.ID,
+		OperationID:  step.OperationID,
+		StepName:     step.StepName,
+		Stage:        step.Stage,
+		StartedAt:    step.StartedAt,
+		FinishedAt:   step.FinishedAt,
+		Result:       string(step.Result),
+		RetryDelay:   int64(step.RetryDelay),
+		ErrorReason:  step.ErrorReason,
+		ErrorMessage: step.ErrorMessage,
+	}
+
+	var lastErr dberr.Error
+	_ = wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = session.InsertOperationStep(dto)
+		if lastErr != nil {
+			if dberr.IsAlreadyExists(lastErr) {
+				return false, lastErr
+			}
+			log.Errorf("while inserting operation step: %v", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if lastErr != nil {
+		return lastErr
+	}
+	return nil
+}
+
+// ListOperationSteps returns the journal of the operation ordered by the start time of steps
+func (s *operations) ListOperationSteps(operationID string) ([]internal.OperationStep, error) {
+	session := s.NewReadSession()
+	var (
+		dtos    []dbmodel.OperationStepDTO
+		lastErr dberr.Error
+	)
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		dtos, lastErr = session.ListOperationSteps(operationID)
+		if lastErr != nil {
+			log.Errorf("while getting operation steps from the storage: %v", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, lastErr
+	}
+
+	steps := make([]internal.OperationStep, 0, len(dtos))
+	for _, dto := range dtos {
+		steps = append(steps, internal.OperationStep{
+			ID:           dto.ID,
+			OperationID:  dto.OperationID,
+			StepName:     dto.StepName,
+			Stage:        dto.Stage,
+			StartedAt:    dto.StartedAt,
+			FinishedAt:   dto.FinishedAt,
+			Result:       internal.OperationStepResult(dto.Result),
+			RetryDelay:   time.Duration(dto.RetryDelay),
+			ErrorReason:  dto.ErrorReason,
+			ErrorMessage: dto.ErrorMessage,
+		})
+	}
+	return steps, nil
+}
@@ -0,0 +1,82 @@
+package postsql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationSteps(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("should insert and list OperationSteps", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		svc := brokerStorage.Operations()
+		start := time.Now().Truncate(time.Millisecond).UTC()
+		retry := internal.OperationStep{
+			ID:          "step-1",
+			OperationID: "op-1",
+			StepName:    "AVS_Create",
+			Stage:       "create_runtime",
+			StartedAt:   start,
+			FinishedAt:  start.Add(time.Second),
+			Result:      internal.OperationStepRetry,
+			RetryDelay:  10 * time.Second,
+		}
+		failed := internal.OperationStep{
+			ID:           "step-2",
+			OperationID:  "op-1",
+			StepName:     "AVS_Create",
+			Stage:        "create_runtime",
+			StartedAt:    start.Add(time.Minute),
+			FinishedAt:   start.Add(2 * time.Minute),
+			Result:       internal.OperationStepFailed,
+			ErrorReason:  "err_keb_step_timeout",
+			ErrorMessage: "step AVS_Create has not finished within 1m0s",
+		}
+
+		// when
+		err = svc.InsertOperationStep(failed)
+		require.NoError(t, err)
+		err = svc.InsertOperationStep(retry)
+		require.NoError(t, err)
+		err = svc.InsertOperationStep(retry)
+
+		// then
+		assert.True(t, dberr.IsAlreadyExists(err))
+
+		steps, err := svc.ListOperationSteps("op-1")
+		require.NoError(t, err)
+		require.Len(t, steps, 2)
+		assert.Equal(t, "step-1", steps[0].ID)
+		assert.Equal(t, internal.OperationStepRetry, steps[0].Result)
+		assert.Equal(t, 10*time.Second, steps[0].RetryDelay)
+		assert.True(t, start.Equal(steps[0].StartedAt))
+		assert.Equal(t, "step-2", steps[1].ID)
+		assert.Equal(t, "err_keb_step_timeout", steps[1].ErrorReason)
+
+		steps, err = svc.ListOperationSteps("op-2")
+		require.NoError(t, err)
+		assert.Empty(t, steps)
+	})
+}
@@ -0,0 +1,238 @@
+package postsql
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type outbox struct {
+	postsql.Factory
+
+	mu              sync.RWMutex
+	operationEvents func(op internal.Operation) ([]internal.OutboxEvent, error)
+}
+
+func NewOutbox(sess postsql.Factory) *outbox {
+	return &outbox{
+		Factory: sess,
+	}
+}
+
+func (s *outbox) SetOperationEvents(events func(op internal.Operation) ([]internal.OutboxEvent, error)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.operationEvents = events
+}
+
+// eventsOf returns events saved in the outbox together with the operation
+func (s *outbox) eventsOf(op internal.Operation) ([]internal.OutboxEvent, error) {
+	if s == nil {
+		return nil, nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.operationEvents == nil {
+		return nil, nil
+	}
+	return s.operationEvents(op)
+}
+
+func (s *outbox) Append(event internal.OutboxEvent) error {
+	sess := s.NewWriteSession()
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = sess.InsertOutboxEvent(dbmodel.OutboxEventDTO{
+			EventType: event.EventType,
+			Payload:   event.Payload,
+		})
+		if lastErr != nil {
+			log.Errorf("while inserting outbox event %s: %v", event.EventType, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return lastErr
+	}
+	return nil
+}
+
+// ListAfter assigns sequence numbers to committed events first, so events saved by transactions which committed
+// since the last call are listed after the events listed before
+func (s *outbox) ListAfter(sequence int64, limit int) ([]internal.OutboxEvent, error) {
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = s.sequence(limit)
+		if lastErr != nil {
+			log.Errorf("while sequencing outbox events: %v", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, lastErr
+	}
+
+	sess := s.NewReadSession()
+	var dtos []dbmodel.OutboxEventDTO
+	err = wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		dtos, lastErr = sess.ListOutboxEvents(sequence, limit)
+		if lastErr != nil {
+			log.Errorf("while listing outbox events: %v", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, lastErr
+	}
+
+	events := make([]internal.OutboxEvent, 0, len(dtos))
+	for _, dto := range dtos {
+		events = append(events, internal.OutboxEvent{
+			Sequence:  dto.Sequence,
+			EventType: dto.EventType,
+			Payload:   dto.Payload,
+			CreatedAt: dto.CreatedAt,
+		})
+	}
+	return events, nil
+}
+
+// sequence assigns sequence numbers in a short transaction, it is the only one which takes the lock of the outbox
+func (s *outbox) sequence(limit int) dberr.Error {
+	sess, err := s.NewSessionWithinTransaction()
+	if err != nil {
+		return err
+	}
+	defer sess.RollbackUnlessCommitted()
+
+	if _, err := sess.SequenceOutboxEvents(limit); err != nil {
+		return err
+	}
+	return sess.Commit()
+}
+
+func (s *outbox) DeleteUpTo(sequence int64) error {
+	sess := s.NewWriteSession()
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = sess.DeleteOutboxEvents(sequence)
+		if lastErr != nil {
+			log.Errorf("while deleting outbox events: %v", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return lastErr
+	}
+	return nil
+}
+
+func (s *outbox) GetCheckpoint(subscriber string) (int64, error) {
+	sess := s.NewReadSession()
+	var checkpoint dbmodel.OutboxCheckpointDTO
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		checkpoint, lastErr = sess.GetOutboxCheckpoint(subscriber)
+		if lastErr != nil {
+			if dberr.IsNotFound(lastErr) {
+				return true, nil
+			}
+			log.Errorf("while getting outbox checkpoint of %s: %v", subscriber, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return 0, lastErr
+	}
+	return checkpoint.Sequence, nil
+}
+
+func (s *outbox) SaveCheckpoint(subscriber string, sequence int64) error {
+	sess := s.NewWriteSession()
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = sess.UpsertOutboxCheckpoint(dbmodel.OutboxCheckpointDTO{
+			Subscriber: subscriber,
+			Sequence:   sequence,
+			UpdatedAt:  time.Now(),
+		})
+		if lastErr != nil {
+			log.Errorf("while saving outbox checkpoint of %s: %v", subscriber, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return lastErr
+	}
+	return nil
+}
+
+func (s *outbox) SaveDeadLetter(letter internal.OutboxDeadLetter) error {
+	sess := s.NewWriteSession()
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = sess.InsertOutboxDeadLetter(dbmodel.OutboxDeadLetterDTO{
+			Subscriber: letter.Subscriber,
+			Sequence:   letter.Sequence,
+			EventType:  letter.EventType,
+			Payload:    letter.Payload,
+			Error:      letter.Error,
+			Attempts:   letter.Attempts,
+			CreatedAt:  time.Now(),
+		})
+		if lastErr != nil {
+			log.Errorf("while saving outbox dead letter %d of %s: %v", letter.Sequence, letter.Subscriber, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return lastErr
+	}
+	return nil
+}
+
+func (s *outbox) ListDeadLetters(subscriber string) ([]internal.OutboxDeadLetter, error) {
+	sess := s.NewReadSession()
+	var dtos []dbmodel.OutboxDeadLetterDTO
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		dtos, lastErr = sess.ListOutboxDeadLetters(subscriber)
+		if lastErr != nil {
+			log.Errorf("while listing outbox dead letters of %s: %v", subscriber, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, lastErr
+	}
+
+	letters := make([]internal.OutboxDeadLetter, 0, len(dtos))
+	for _, dto := range dtos {
+		letters = append(letters, internal.OutboxDeadLetter{
+			Subscriber: dto.Subscriber,
+			Sequence:   dto.Sequence,
+			EventType:  dto.EventType,
+			Payload:    dto.Payload,
+			Error:      dto.Error,
+			Attempts:   dto.Attempts,
+			CreatedAt:  dto.CreatedAt,
+		})
+	}
+	return letters, nil
+}
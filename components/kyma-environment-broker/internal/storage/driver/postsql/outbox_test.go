@@ -0,0 +1,183 @@
+package postsql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutbox(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("should append, list and delete events and save checkpoints", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		svc := brokerStorage.Outbox()
+
+		// when
+		err = svc.Append(internal.OutboxEvent{EventType: "process.ProvisioningSucceeded", Payload: []byte(`{"operationID":"op-1"}`)})
+		require.NoError(t, err)
+		err = svc.Append(internal.OutboxEvent{EventType: "process.ProvisioningSucceeded", Payload: []byte(`{"operationID":"op-2"}`)})
+		require.NoError(t, err)
+
+		// then
+		events, err := svc.ListAfter(0, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		first, second := events[0].Sequence, events[1].Sequence
+		assert.Greater(t, second, first)
+		assert.JSONEq(t, `{"operationID":"op-1"}`, string(events[0].Payload))
+
+		events, err = svc.ListAfter(first, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, second, events[0].Sequence)
+		assert.JSONEq(t, `{"operationID":"op-2"}`, string(events[0].Payload))
+
+		// when
+		checkpoint, err := svc.GetCheckpoint("metrics")
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), checkpoint)
+
+		err = svc.SaveCheckpoint("metrics", first)
+		require.NoError(t, err)
+		err = svc.SaveCheckpoint("metrics", second)
+		require.NoError(t, err)
+		checkpoint, err = svc.GetCheckpoint("metrics")
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, second, checkpoint)
+
+		// when
+		err = svc.DeleteUpTo(first)
+		require.NoError(t, err)
+		events, err = svc.ListAfter(0, 10)
+		require.NoError(t, err)
+
+		// then
+		require.Len(t, events, 1)
+		assert.Equal(t, second, events[0].Sequence)
+
+		// when
+		letter := internal.OutboxDeadLetter{Subscriber: "metrics", Sequence: second, EventType: events[0].EventType, Payload: events[0].Payload, Error: "unavailable", Attempts: 3}
+		err = svc.SaveDeadLetter(letter)
+		require.NoError(t, err)
+		err = svc.SaveDeadLetter(letter)
+		require.NoError(t, err)
+		letters, err := svc.ListDeadLetters("metrics")
+		require.NoError(t, err)
+
+		// then
+		require.Len(t, letters, 1)
+		assert.Equal(t, second, letters[0].Sequence)
+		assert.Equal(t, "unavailable", letters[0].Error)
+		assert.Equal(t, 3, letters[0].Attempts)
+		assert.JSONEq(t, `{"operationID":"op-2"}`, string(letters[0].Payload))
+	})
+
+	t.Run("should append events of the operation in the transaction which saves the operation", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		svc := brokerStorage.Outbox()
+		svc.SetOperationEvents(func(op internal.Operation) ([]internal.OutboxEvent, error) {
+			return []internal.OutboxEvent{{EventType: "process.ProvisioningStepProcessed", Payload: []byte(fmt.Sprintf(`{"description":%q}`, op.Description))}}, nil
+		})
+		operations := brokerStorage.Operations()
+
+		op := fixture.FixProvisioningOperation("op-1", "inst-1")
+		op.Description = "inserted"
+		err = operations.InsertProvisioningOperation(op)
+		require.NoError(t, err)
+		stale, err := operations.GetProvisioningOperationByID("op-1")
+		require.NoError(t, err)
+		op = *stale
+		op.Description = "updated"
+		_, err = operations.UpdateProvisioningOperation(op)
+		require.NoError(t, err)
+
+		// when
+		stale.Description = "conflicting"
+		_, err = operations.UpdateProvisioningOperation(*stale)
+
+		// then
+		assert.Error(t, err)
+		events, err := svc.ListAfter(0, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.JSONEq(t, `{"description":"inserted"}`, string(events[0].Payload))
+		assert.JSONEq(t, `{"description":"updated"}`, string(events[1].Payload))
+	})
+
+	t.Run("should list events in the order their transactions commit", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, connection, err := storage.NewFromConfig(cfg, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		svc := brokerStorage.Outbox()
+		inFlight, err := postsql.NewFactory(connection).NewSessionWithinTransaction()
+		require.NoError(t, err)
+		defer inFlight.RollbackUnlessCommitted()
+		require.Nil(t, inFlight.InsertOutboxEvent(dbmodel.OutboxEventDTO{EventType: "process.ProvisioningSucceeded", Payload: []byte(`{"operationID":"op-1"}`)}))
+
+		// when
+		err = svc.Append(internal.OutboxEvent{EventType: "process.ProvisioningSucceeded", Payload: []byte(`{"operationID":"op-2"}`)})
+		require.NoError(t, err)
+		events, err := svc.ListAfter(0, 10)
+		require.NoError(t, err)
+
+		// then
+		require.Len(t, events, 1)
+		assert.JSONEq(t, `{"operationID":"op-2"}`, string(events[0].Payload))
+
+		// when
+		require.Nil(t, inFlight.Commit())
+		events, err = svc.ListAfter(events[0].Sequence, 10)
+		require.NoError(t, err)
+
+		// then
+		require.Len(t, events, 1)
+		assert.JSONEq(t, `{"operationID":"op-1"}`, string(events[0].Payload))
+	})
+}
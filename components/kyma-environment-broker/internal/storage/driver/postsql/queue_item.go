@@ -0,0 +1,161 @@
+package postsql
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type queueItems struct {
+	postsql.Factory
+}
+
+func NewQueueItems(sess postsql.Factory) *queueItems {
+	return &queueItems{
+		Factory: sess,
+	}
+}
+
+func (s *queueItems) Enqueue(queue, itemID string, dueAt time.Time) error {
+	return s.upsert(queue, itemID, dueAt, false)
+}
+
+func (s *queueItems) EnqueueIfAbsent(queue, itemID string, dueAt time.Time) error {
+	return s.upsert(queue, itemID, dueAt, true)
+}
+
+func (s *queueItems) Lease(queue, owner string, now time.Time, leaseDuration time.Duration, limit int) ([]internal.QueueItem, error) {
+	sess := s.NewWriteSession()
+	var dtos []dbmodel.QueueItemDTO
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		dtos, lastErr = sess.LeaseQueueItems(queue, owner, now, now.Add(leaseDuration), limit)
+		if lastErr != nil {
+			log.Errorf("while leasing items of queue %s: %v", queue, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, lastErr
+	}
+
+	result := make([]internal.QueueItem, 0, len(dtos))
+	for _, dto := range dtos {
+		result = append(result, toQueueItem(dto))
+	}
+	return result, nil
+}
+
+func (s *queueItems) RenewLease(item internal.QueueItem, expiresAt time.Time) error {
+	sess := s.NewWriteSession()
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = sess.RenewQueueItemLease(toQueueItemDTO(item), expiresAt)
+		if lastErr != nil {
+			if dberr.IsNotFound(lastErr) {
+				return false, lastErr
+			}
+			log.Errorf("while renewing lease of item %s in queue %s: %v", item.ItemID, item.Queue, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return lastErr
+	}
+	return nil
+}
+
+func (s *queueItems) Complete(item internal.QueueItem) error {
+	item.UpdatedAt = time.Now()
+	sess := s.NewWriteSession()
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = sess.CompleteQueueItem(toQueueItemDTO(item))
+		if lastErr != nil {
+			log.Errorf("while completing item %s in queue %s: %v", item.ItemID, item.Queue, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return lastErr
+	}
+	return nil
+}
+
+func (s *queueItems) Reschedule(item internal.QueueItem, dueAt time.Time) error {
+	item.UpdatedAt = time.Now()
+	sess := s.NewWriteSession()
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = sess.RescheduleQueueItem(toQueueItemDTO(item), dueAt)
+		if lastErr != nil {
+			log.Errorf("while rescheduling item %s in queue %s: %v", item.ItemID, item.Queue, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return lastErr
+	}
+	return nil
+}
+
+func (s *queueItems) upsert(queue, itemID string, dueAt time.Time, keepExisting bool) error {
+	now := time.Now()
+	dto := dbmodel.QueueItemDTO{
+		QueueName: queue,
+		ItemID:    itemID,
+		DueAt:     dueAt,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	sess := s.NewWriteSession()
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = sess.UpsertQueueItem(dto, keepExisting)
+		if lastErr != nil {
+			log.Errorf("while adding item %s to queue %s: %v", itemID, queue, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return lastErr
+	}
+	return nil
+}
+
+func toQueueItemDTO(item internal.QueueItem) dbmodel.QueueItemDTO {
+	return dbmodel.QueueItemDTO{
+		QueueName:      item.Queue,
+		ItemID:         item.ItemID,
+		DueAt:          item.DueAt,
+		Generation:     item.Generation,
+		LeaseOwner:     item.LeaseOwner,
+		LeaseExpiresAt: item.LeaseExpiresAt,
+		CreatedAt:      item.CreatedAt,
+		UpdatedAt:      item.UpdatedAt,
+	}
+}
+
+func toQueueItem(dto dbmodel.QueueItemDTO) internal.QueueItem {
+	return internal.QueueItem{
+		Queue:          dto.QueueName,
+		ItemID:         dto.ItemID,
+		DueAt:          dto.DueAt,
+		Generation:     dto.Generation,
+		LeaseOwner:     dto.LeaseOwner,
+		LeaseExpiresAt: dto.LeaseExpiresAt,
+		CreatedAt:      dto.CreatedAt,
+		UpdatedAt:      dto.UpdatedAt,
+	}
+}
@@ -0,0 +1,79 @@
+package postsql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueItems(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("should enqueue, lease, reschedule and complete QueueItems", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		svc := brokerStorage.QueueItems()
+		now := time.Now()
+
+		// when
+		err = svc.Enqueue("provisioning", "op-1", now)
+		require.NoError(t, err)
+		err = svc.Enqueue("provisioning", "op-2", now.Add(time.Hour))
+		require.NoError(t, err)
+		err = svc.EnqueueIfAbsent("provisioning", "op-1", now.Add(-time.Hour))
+		require.NoError(t, err)
+
+		leased, err := svc.Lease("provisioning", "replica-1", now, time.Minute, 10)
+		require.NoError(t, err)
+		notLeased, err := svc.Lease("provisioning", "replica-2", now, time.Minute, 10)
+		require.NoError(t, err)
+
+		// then
+		require.Len(t, leased, 1)
+		assert.Equal(t, "op-1", leased[0].ItemID)
+		assert.Empty(t, notLeased)
+
+		// when
+		err = svc.RenewLease(leased[0], now.Add(2*time.Minute))
+		require.NoError(t, err)
+		err = svc.Reschedule(leased[0], now.Add(time.Minute))
+		require.NoError(t, err)
+
+		// then
+		leased, err = svc.Lease("provisioning", "replica-2", now.Add(2*time.Minute), time.Minute, 1)
+		require.NoError(t, err)
+		require.Len(t, leased, 1)
+		assert.Equal(t, "op-1", leased[0].ItemID)
+
+		// when
+		completed := leased[0]
+		err = svc.Complete(completed)
+		require.NoError(t, err)
+
+		// then
+		leased, err = svc.Lease("provisioning", "replica-2", now.Add(2*time.Minute), time.Minute, 10)
+		require.NoError(t, err)
+		assert.Empty(t, leased)
+
+		// lease of the removed item cannot be renewed
+		err = svc.RenewLease(completed, time.Now())
+		assert.Error(t, err)
+	})
+}
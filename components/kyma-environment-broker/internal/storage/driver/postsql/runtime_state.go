@@ -3,6 +3,7 @@ package postsql
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	reconcilerApi "github.com/kyma-incubator/reconciler/pkg/keb"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
@@ -69,6 +70,32 @@ func (s *runtimeState) ListByRuntimeID(runtimeID string) ([]internal.RuntimeStat
 	return result, nil
 }
 
+func (s *runtimeState) GetByID(stateID string) (internal.RuntimeState, error) {
+	sess := s.NewReadSession()
+	state := dbmodel.RuntimeStateDTO{}
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		state, lastErr = sess.GetRuntimeStateByID(stateID)
+		if lastErr != nil {
+			if dberr.IsNotFound(lastErr) {
+				return false, dberr.NotFound("RuntimeState %s not found", stateID)
+			}
+			log.Errorf("while getting RuntimeState: %v", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return internal.RuntimeState{}, lastErr
+	}
+	result, err := s.toRuntimeState(&state)
+	if err != nil {
+		return internal.RuntimeState{}, errors.Wrap(err, "while converting runtime state")
+	}
+
+	return result, nil
+}
+
 func (s *runtimeState) GetByOperationID(operationID string) (internal.RuntimeState, error) {
 	sess := s.NewReadSession()
 	state := dbmodel.RuntimeStateDTO{}
@@ -183,6 +210,33 @@ func (s *runtimeState) GetLatestWithKymaVersionByRuntimeID(runtimeID string) (in
 	return internal.RuntimeState{}, fmt.Errorf("failed to find RuntimeState with kyma version for runtime %s ", runtimeID)
 }
 
+// GetLatestWithKymaVersionByRuntimeIDBefore returns the latest runtime state with kyma version created before the given time
+func (s *runtimeState) GetLatestWithKymaVersionByRuntimeIDBefore(runtimeID string, before time.Time) (internal.RuntimeState, error) {
+	sess := s.NewReadSession()
+	var state dbmodel.RuntimeStateDTO
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		state, lastErr = sess.GetLatestRuntimeStateWithKymaVersionByRuntimeIDBefore(runtimeID, before)
+		if lastErr != nil {
+			if dberr.IsNotFound(lastErr) {
+				return false, dberr.NotFound("RuntimeState with kyma version for runtime %s created before %s not found", runtimeID, before)
+			}
+			log.Errorf("while getting RuntimeState: %v", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return internal.RuntimeState{}, lastErr
+	}
+
+	result, err := s.toRuntimeState(&state)
+	if err != nil {
+		return internal.RuntimeState{}, errors.Wrap(err, "while converting runtime state")
+	}
+	return result, nil
+}
+
 func (s *runtimeState) GetLatestWithOIDCConfigByRuntimeID(runtimeID string) (internal.RuntimeState, error) {
 	sess := s.NewReadSession()
 	var state dbmodel.RuntimeStateDTO
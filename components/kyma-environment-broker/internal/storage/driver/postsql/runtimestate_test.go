@@ -12,6 +12,7 @@ import (
 	reconcilerApi "github.com/kyma-incubator/reconciler/pkg/keb"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -226,6 +227,17 @@ func TestRuntimeState(t *testing.T) {
 		assert.Equal(t, gotRuntimeState.ID, runtimeStateWithReconcilerInput.ID)
 		assert.NotNil(t, gotRuntimeState.ClusterSetup)
 		assert.Equal(t, fixKymaVersion, gotRuntimeState.ClusterSetup.KymaConfig.Version)
+
+		gotRuntimeState, err = storage.GetLatestWithKymaVersionByRuntimeIDBefore(fixRuntimeID, runtimeStateWithoutReconcilerInput.CreatedAt)
+		require.NoError(t, err)
+		assert.Equal(t, runtimeStateWithReconcilerInput.ID, gotRuntimeState.ID)
+
+		_, err = storage.GetLatestWithKymaVersionByRuntimeIDBefore(fixRuntimeID, runtimeStateWithReconcilerInput.CreatedAt)
+		assert.True(t, dberr.IsNotFound(err))
+
+		gotRuntimeState, err = storage.GetByID(runtimeStateWithReconcilerInput.ID)
+		require.NoError(t, err)
+		assert.Equal(t, fixKymaVersion, gotRuntimeState.ClusterSetup.KymaConfig.Version)
 	})
 
 	t.Run("should fetch latest RuntimeState with Kyma version stored only in the kyma_version field", func(t *testing.T) {
@@ -1,6 +1,8 @@
 package storage
 
 import (
+	"time"
+
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/predicate"
@@ -29,7 +31,10 @@ type Operations interface {
 	Deprovisioning
 	UpgradeKyma
 	UpgradeCluster
+	RunTask
 	Updating
+	Hibernation
+	OperationSteps
 
 	GetLastOperation(instanceID string) (*internal.Operation, error)
 	GetOperationByID(operationID string) (*internal.Operation, error)
@@ -38,6 +43,14 @@ type Operations interface {
 	GetOperationsForIDs(operationIDList []string) ([]internal.Operation, error)
 	GetOperationStatsForOrchestration(orchestrationID string) (map[string]int, error)
 	ListOperations(filter dbmodel.OperationFilter) ([]internal.Operation, int, int, error)
+	// ListOperationsUpdatedAfter lists at most limit operations ordered by the update time and ID, which come after the given update time and ID
+	ListOperationsUpdatedAfter(updatedAt time.Time, operationID string, limit int) ([]internal.Operation, error)
+}
+
+// OperationSteps is the journal of runs of steps of operations
+type OperationSteps interface {
+	InsertOperationStep(step internal.OperationStep) error
+	ListOperationSteps(operationID string) ([]internal.OperationStep, error)
 }
 
 type Provisioning interface {
@@ -66,14 +79,58 @@ type Orchestrations interface {
 
 type RuntimeStates interface {
 	Insert(runtimeState internal.RuntimeState) error
+	GetByID(stateID string) (internal.RuntimeState, error)
 	GetByOperationID(operationID string) (internal.RuntimeState, error)
 	ListByRuntimeID(runtimeID string) ([]internal.RuntimeState, error)
 	GetLatestByRuntimeID(runtimeID string) (internal.RuntimeState, error)
 	GetLatestWithReconcilerInputByRuntimeID(runtimeID string) (internal.RuntimeState, error)
 	GetLatestWithKymaVersionByRuntimeID(runtimeID string) (internal.RuntimeState, error)
+	GetLatestWithKymaVersionByRuntimeIDBefore(runtimeID string, before time.Time) (internal.RuntimeState, error)
 	GetLatestWithOIDCConfigByRuntimeID(runtimeID string) (internal.RuntimeState, error)
 }
 
+type Bindings interface {
+	Insert(binding internal.Binding) error
+	Get(instanceID, bindingID string) (*internal.Binding, error)
+	ListByInstanceID(instanceID string) ([]internal.Binding, error)
+	Delete(instanceID, bindingID string) error
+}
+
+// QueueItems persists the items of durable processing queues
+type QueueItems interface {
+	// Enqueue adds the item to the queue. If the item already exists, the earlier due time is kept.
+	Enqueue(queue, itemID string, dueAt time.Time) error
+	// EnqueueIfAbsent adds the item to the queue only if it does not exist yet.
+	EnqueueIfAbsent(queue, itemID string, dueAt time.Time) error
+	// Lease reserves up to limit due items, which are not leased by other owners.
+	Lease(queue, owner string, now time.Time, leaseDuration time.Duration, limit int) ([]internal.QueueItem, error)
+	RenewLease(item internal.QueueItem, expiresAt time.Time) error
+	// Complete removes the leased item unless it was enqueued again in the meantime.
+	Complete(item internal.QueueItem) error
+	// Reschedule releases the leased item, which was added again during its processing, and sets its due time.
+	Reschedule(item internal.QueueItem, dueAt time.Time) error
+}
+
+// Outbox persists events of the durable event broker and checkpoints of its subscribers
+type Outbox interface {
+	// Append saves the event, its sequence number is assigned after it is committed
+	Append(event internal.OutboxEvent) error
+	// ListAfter returns up to limit events with sequence numbers greater than the given one, in the order of sequence numbers
+	ListAfter(sequence int64, limit int) ([]internal.OutboxEvent, error)
+	// DeleteUpTo removes events with sequence numbers not greater than the given one
+	DeleteUpTo(sequence int64) error
+	// GetCheckpoint returns the sequence number of the last event delivered to the subscriber, 0 if none was delivered
+	GetCheckpoint(subscriber string) (int64, error)
+	SaveCheckpoint(subscriber string, sequence int64) error
+	// SaveDeadLetter keeps the event which could not be delivered to the subscriber, saving the same event again does nothing
+	SaveDeadLetter(letter internal.OutboxDeadLetter) error
+	// ListDeadLetters returns dead letters of the subscriber in the order of sequence numbers
+	ListDeadLetters(subscriber string) ([]internal.OutboxDeadLetter, error)
+	// SetOperationEvents sets the function returning events of the operation, which are appended by the storage of operations
+	// in the same transaction as the inserted or updated operation
+	SetOperationEvents(events func(op internal.Operation) ([]internal.OutboxEvent, error))
+}
+
 type UpgradeKyma interface {
 	InsertUpgradeKymaOperation(operation internal.UpgradeKymaOperation) error
 	UpdateUpgradeKymaOperation(operation internal.UpgradeKymaOperation) (*internal.UpgradeKymaOperation, error)
@@ -92,6 +149,22 @@ type UpgradeCluster interface {
 	ListUpgradeClusterOperationsByOrchestrationID(orchestrationID string, filter dbmodel.OperationFilter) ([]internal.UpgradeClusterOperation, int, int, error)
 }
 
+type RunTask interface {
+	InsertRunTaskOperation(operation internal.RunTaskOperation) error
+	UpdateRunTaskOperation(operation internal.RunTaskOperation) (*internal.RunTaskOperation, error)
+	GetRunTaskOperationByID(operationID string) (*internal.RunTaskOperation, error)
+	ListRunTaskOperationsByOrchestrationID(orchestrationID string, filter dbmodel.OperationFilter) ([]internal.RunTaskOperation, int, int, error)
+}
+
+// Hibernation stores the hibernate and wake up operations of suspended trial instances
+type Hibernation interface {
+	InsertHibernationOperation(operation internal.HibernationOperation) error
+	GetHibernationOperationByID(operationID string) (*internal.HibernationOperation, error)
+	UpdateHibernationOperation(operation internal.HibernationOperation) (*internal.HibernationOperation, error)
+	// ListHibernationOperationsByInstanceID returns both hibernate and wake up operations, the latest first
+	ListHibernationOperationsByInstanceID(instanceID string) ([]internal.HibernationOperation, error)
+}
+
 type Updating interface {
 	InsertUpdatingOperation(operation internal.UpdatingOperation) error
 	GetUpdatingOperationByID(operationID string) (*internal.UpdatingOperation, error)
@@ -1,6 +1,8 @@
 package postsql
 
 import (
+	"time"
+
 	dbr "github.com/gocraft/dbr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
@@ -29,10 +31,12 @@ type ReadSession interface {
 	GetOperationsByTypeAndInstanceID(inID string, opType internal.OperationType) ([]dbmodel.OperationDTO, dberr.Error)
 	GetOperationsForIDs(opIdList []string) ([]dbmodel.OperationDTO, dberr.Error)
 	ListOperations(filter dbmodel.OperationFilter) ([]dbmodel.OperationDTO, int, int, error)
+	ListOperationsUpdatedAfter(updatedAt time.Time, operationID string, limit int) ([]dbmodel.OperationDTO, dberr.Error)
 	ListOperationsByType(operationType internal.OperationType) ([]dbmodel.OperationDTO, dberr.Error)
 	GetOperationStats() ([]dbmodel.OperationStatEntry, error)
 	GetInstanceStats() ([]dbmodel.InstanceByGlobalAccountIDStatEntry, error)
 	GetNumberOfInstancesForGlobalAccountID(globalAccountID string) (int, error)
+	GetRuntimeStateByID(stateID string) (dbmodel.RuntimeStateDTO, dberr.Error)
 	GetRuntimeStateByOperationID(operationID string) (dbmodel.RuntimeStateDTO, dberr.Error)
 	ListRuntimeStateByRuntimeID(runtimeID string) ([]dbmodel.RuntimeStateDTO, dberr.Error)
 	GetOrchestrationByID(oID string) (dbmodel.OrchestrationDTO, dberr.Error)
@@ -43,7 +47,14 @@ type ReadSession interface {
 	GetLatestRuntimeStateByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error)
 	GetLatestRuntimeStateWithReconcilerInputByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error)
 	GetLatestRuntimeStateWithKymaVersionByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error)
+	GetLatestRuntimeStateWithKymaVersionByRuntimeIDBefore(runtimeID string, before time.Time) (dbmodel.RuntimeStateDTO, dberr.Error)
 	GetLatestRuntimeStateWithOIDCConfigByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error)
+	GetBinding(instanceID, bindingID string) (dbmodel.BindingDTO, dberr.Error)
+	ListBindingsByInstanceID(instanceID string) ([]dbmodel.BindingDTO, dberr.Error)
+	ListOperationSteps(operationID string) ([]dbmodel.OperationStepDTO, dberr.Error)
+	ListOutboxEvents(afterSequence int64, limit int) ([]dbmodel.OutboxEventDTO, dberr.Error)
+	GetOutboxCheckpoint(subscriber string) (dbmodel.OutboxCheckpointDTO, dberr.Error)
+	ListOutboxDeadLetters(subscriber string) ([]dbmodel.OutboxDeadLetterDTO, dberr.Error)
 }
 
 //go:generate mockery -name=WriteSession
@@ -56,6 +67,19 @@ type WriteSession interface {
 	InsertOrchestration(o dbmodel.OrchestrationDTO) dberr.Error
 	UpdateOrchestration(o dbmodel.OrchestrationDTO) dberr.Error
 	InsertRuntimeState(state dbmodel.RuntimeStateDTO) dberr.Error
+	InsertBinding(binding dbmodel.BindingDTO) dberr.Error
+	DeleteBinding(instanceID, bindingID string) dberr.Error
+	UpsertQueueItem(item dbmodel.QueueItemDTO, keepExisting bool) dberr.Error
+	LeaseQueueItems(queue, owner string, now, leaseExpiresAt time.Time, limit int) ([]dbmodel.QueueItemDTO, dberr.Error)
+	RenewQueueItemLease(item dbmodel.QueueItemDTO, leaseExpiresAt time.Time) dberr.Error
+	CompleteQueueItem(item dbmodel.QueueItemDTO) dberr.Error
+	RescheduleQueueItem(item dbmodel.QueueItemDTO, dueAt time.Time) dberr.Error
+	InsertOperationStep(step dbmodel.OperationStepDTO) dberr.Error
+	InsertOutboxEvent(event dbmodel.OutboxEventDTO) dberr.Error
+	SequenceOutboxEvents(limit int) (int64, dberr.Error)
+	DeleteOutboxEvents(upToSequence int64) dberr.Error
+	UpsertOutboxCheckpoint(checkpoint dbmodel.OutboxCheckpointDTO) dberr.Error
+	InsertOutboxDeadLetter(letter dbmodel.OutboxDeadLetterDTO) dberr.Error
 }
 
 type Transaction interface {
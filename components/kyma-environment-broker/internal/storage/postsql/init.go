@@ -13,12 +13,18 @@ import (
 )
 
 const (
-	schemaName             = "public"
-	InstancesTableName     = "instances"
-	OperationTableName     = "operations"
-	OrchestrationTableName = "orchestrations"
-	RuntimeStateTableName  = "runtime_states"
-	CreatedAtField         = "created_at"
+	schemaName                 = "public"
+	InstancesTableName         = "instances"
+	OperationTableName         = "operations"
+	OrchestrationTableName     = "orchestrations"
+	RuntimeStateTableName      = "runtime_states"
+	BindingsTableName          = "bindings"
+	QueueItemsTableName        = "queue_items"
+	OperationStepsTableName    = "operation_steps"
+	OutboxEventsTableName      = "outbox_events"
+	OutboxCheckpointsTableName = "outbox_checkpoints"
+	OutboxDeadLettersTableName = "outbox_dead_letters"
+	CreatedAtField             = "created_at"
 )
 
 // InitializeDatabase opens database connection and initializes schema if it does not exist
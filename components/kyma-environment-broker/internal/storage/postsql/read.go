@@ -3,6 +3,7 @@ package postsql
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
@@ -105,7 +106,9 @@ func (r readSession) FindAllInstancesForSubAccounts(subAccountslist []string) ([
 func (r readSession) GetLastOperation(instanceID string) (dbmodel.OperationDTO, dberr.Error) {
 	inst := dbr.Eq("instance_id", instanceID)
 	state := dbr.Neq("state", []string{orchestration.Pending, orchestration.Canceled})
-	condition := dbr.And(inst, state)
+	// run task operations do not change the instance, so they are never the last operation of the instance
+	opType := dbr.Neq("type", internal.OperationTypeRunTask)
+	condition := dbr.And(inst, state, opType)
 	operation, err := r.getLastOperation(condition)
 	if err != nil {
 		switch {
@@ -160,6 +163,23 @@ func (r readSession) ListOperations(filter dbmodel.OperationFilter) ([]dbmodel.O
 		nil
 }
 
+// ListOperationsUpdatedAfter lists at most limit operations ordered by the update time and ID, which were updated after
+// the given time or at the same time but have a greater ID
+func (r readSession) ListOperationsUpdatedAfter(updatedAt time.Time, operationID string, limit int) ([]dbmodel.OperationDTO, dberr.Error) {
+	var operations []dbmodel.OperationDTO
+	_, err := r.session.Select("*").
+		From(OperationTableName).
+		Where("(updated_at, id) > (?, ?)", updatedAt, operationID).
+		OrderBy("updated_at").
+		OrderBy("id").
+		Limit(uint64(limit)).
+		Load(&operations)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get operations: %s", err)
+	}
+	return operations, nil
+}
+
 func (r readSession) GetOrchestrationByID(oID string) (dbmodel.OrchestrationDTO, dberr.Error) {
 	condition := dbr.Eq("orchestration_id", oID)
 	operation, err := r.getOrchestration(condition)
@@ -346,6 +366,24 @@ func (r readSession) ListOperationsByOrchestrationID(orchestrationID string, fil
 		nil
 }
 
+func (r readSession) GetRuntimeStateByID(stateID string) (dbmodel.RuntimeStateDTO, dberr.Error) {
+	var state dbmodel.RuntimeStateDTO
+
+	err := r.session.
+		Select("*").
+		From(RuntimeStateTableName).
+		Where(dbr.Eq("id", stateID)).
+		LoadOne(&state)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return dbmodel.RuntimeStateDTO{}, dberr.NotFound("cannot find runtime state: %s", err)
+		}
+		return dbmodel.RuntimeStateDTO{}, dberr.Internal("Failed to get runtime state: %s", err)
+	}
+	return state, nil
+}
+
 func (r readSession) GetRuntimeStateByOperationID(operationID string) (dbmodel.RuntimeStateDTO, dberr.Error) {
 	var state dbmodel.RuntimeStateDTO
 
@@ -454,6 +492,32 @@ func (r readSession) GetLatestRuntimeStateWithKymaVersionByRuntimeID(runtimeID s
 	return state, nil
 }
 
+func (r readSession) GetLatestRuntimeStateWithKymaVersionByRuntimeIDBefore(runtimeID string, before time.Time) (dbmodel.RuntimeStateDTO, dberr.Error) {
+	var state dbmodel.RuntimeStateDTO
+	condition := dbr.And(dbr.Eq("runtime_id", runtimeID),
+		dbr.Lt(CreatedAtField, before),
+		dbr.And(dbr.Neq("kyma_version", nil), dbr.Neq("kyma_version", "")),
+	)
+
+	count, err := r.session.
+		Select("*").
+		From(RuntimeStateTableName).
+		Where(condition).
+		OrderDesc(CreatedAtField).
+		Limit(1).
+		Load(&state)
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return state, dberr.NotFound("cannot find runtime state with kyma version created before %s: %s", before, err)
+		}
+		return state, dberr.Internal("Failed to get the latest runtime state with kyma version created before %s: %s", before, err)
+	}
+	if count == 0 {
+		return state, dberr.NotFound("found 0 runtime states with kyma version created before %s", before)
+	}
+	return state, nil
+}
+
 func (r readSession) GetLatestRuntimeStateWithOIDCConfigByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error) {
 	var state dbmodel.RuntimeStateDTO
 	condition := dbr.And(dbr.Eq("runtime_id", runtimeID),
@@ -479,6 +543,105 @@ func (r readSession) GetLatestRuntimeStateWithOIDCConfigByRuntimeID(runtimeID st
 	return state, nil
 }
 
+func (r readSession) GetBinding(instanceID, bindingID string) (dbmodel.BindingDTO, dberr.Error) {
+	var binding dbmodel.BindingDTO
+
+	err := r.session.
+		Select("*").
+		From(BindingsTableName).
+		Where(dbr.Eq("instance_id", instanceID)).
+		Where(dbr.Eq("id", bindingID)).
+		LoadOne(&binding)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return dbmodel.BindingDTO{}, dberr.NotFound("cannot find binding: %s", err)
+		}
+		return dbmodel.BindingDTO{}, dberr.Internal("Failed to get binding: %s", err)
+	}
+	return binding, nil
+}
+
+func (r readSession) ListBindingsByInstanceID(instanceID string) ([]dbmodel.BindingDTO, dberr.Error) {
+	var bindings []dbmodel.BindingDTO
+
+	_, err := r.session.
+		Select("*").
+		From(BindingsTableName).
+		Where(dbr.Eq("instance_id", instanceID)).
+		OrderDesc(CreatedAtField).
+		Load(&bindings)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get bindings: %s", err)
+	}
+	return bindings, nil
+}
+
+func (r readSession) ListOperationSteps(operationID string) ([]dbmodel.OperationStepDTO, dberr.Error) {
+	var steps []dbmodel.OperationStepDTO
+
+	_, err := r.session.
+		Select("*").
+		From(OperationStepsTableName).
+		Where(dbr.Eq("operation_id", operationID)).
+		OrderAsc("started_at").
+		Load(&steps)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get operation steps: %s", err)
+	}
+	return steps, nil
+}
+
+// ListOutboxEvents returns events in the order of sequence numbers. Sequence numbers are committed in that order,
+// see SequenceOutboxEvents, so an event with a lower sequence number cannot appear after the listed ones.
+func (r readSession) ListOutboxEvents(afterSequence int64, limit int) ([]dbmodel.OutboxEventDTO, dberr.Error) {
+	var events []dbmodel.OutboxEventDTO
+
+	_, err := r.session.
+		Select("*").
+		From(OutboxEventsTableName).
+		Where(dbr.Gt("sequence", afterSequence)).
+		OrderAsc("sequence").
+		Limit(uint64(limit)).
+		Load(&events)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get outbox events: %s", err)
+	}
+	return events, nil
+}
+
+func (r readSession) GetOutboxCheckpoint(subscriber string) (dbmodel.OutboxCheckpointDTO, dberr.Error) {
+	var checkpoint dbmodel.OutboxCheckpointDTO
+
+	err := r.session.
+		Select("*").
+		From(OutboxCheckpointsTableName).
+		Where(dbr.Eq("subscriber", subscriber)).
+		LoadOne(&checkpoint)
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return dbmodel.OutboxCheckpointDTO{}, dberr.NotFound("Cannot find outbox checkpoint for subscriber: %s", subscriber)
+		}
+		return dbmodel.OutboxCheckpointDTO{}, dberr.Internal("Failed to get outbox checkpoint: %s", err)
+	}
+	return checkpoint, nil
+}
+
+func (r readSession) ListOutboxDeadLetters(subscriber string) ([]dbmodel.OutboxDeadLetterDTO, dberr.Error) {
+	var letters []dbmodel.OutboxDeadLetterDTO
+
+	_, err := r.session.
+		Select("*").
+		From(OutboxDeadLettersTableName).
+		Where(dbr.Eq("subscriber", subscriber)).
+		OrderAsc("sequence").
+		Load(&letters)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get outbox dead letters: %s", err)
+	}
+	return letters, nil
+}
+
 func (r readSession) getOperation(condition dbr.Builder) (dbmodel.OperationDTO, dberr.Error) {
 	var operation dbmodel.OperationDTO
 
@@ -575,17 +738,28 @@ func (r readSession) ListInstances(filter dbmodel.InstanceFilter) ([]dbmodel.Ins
 	var instances []dbmodel.InstanceDTO
 
 	// Base select and order by created at
+	columns := []string{fmt.Sprintf("%s.*", InstancesTableName)}
+	if len(filter.Columns) > 0 {
+		columns = make([]string, 0, len(filter.Columns))
+		for _, column := range filter.Columns {
+			columns = append(columns, fmt.Sprintf("%s.%s", InstancesTableName, column))
+		}
+	}
+	sortExpression := instanceSortExpression("o1", filter.Sort)
+
 	var stmt *dbr.SelectStmt
 	// Find and join the last operation for each instance matching the state filter(s).
 	// Last operation is found with the greatest-n-per-group problem solved with OUTER JOIN, followed by a (INNER) JOIN to get instance columns.
+	// The instance ID breaks the ties of the sort field, so the order is stable for the cursor pagination.
 	stmt = r.session.
-		Select(fmt.Sprintf("%s.*", InstancesTableName)).
+		Select(columns...).
 		From(InstancesTableName).
 		Join(dbr.I(OperationTableName).As("o1"), fmt.Sprintf("%s.instance_id = o1.instance_id", InstancesTableName)).
-		LeftJoin(dbr.I(OperationTableName).As("o2"), fmt.Sprintf("%s.instance_id = o2.instance_id AND o1.created_at < o2.created_at AND o2.state NOT IN ('%s', '%s')", InstancesTableName, orchestration.Pending, orchestration.Canceled)).
+		LeftJoin(dbr.I(OperationTableName).As("o2"), fmt.Sprintf("%s.instance_id = o2.instance_id AND o1.created_at < o2.created_at AND o2.state NOT IN ('%s', '%s') AND o2.type != '%s'", InstancesTableName, orchestration.Pending, orchestration.Canceled, internal.OperationTypeRunTask)).
 		Where("o2.created_at IS NULL").
-		Where(fmt.Sprintf("o1.state NOT IN ('%s', '%s')", orchestration.Pending, orchestration.Canceled)).
-		OrderBy(fmt.Sprintf("%s.%s", InstancesTableName, CreatedAtField))
+		Where(fmt.Sprintf("o1.state NOT IN ('%s', '%s') AND o1.type != '%s'", orchestration.Pending, orchestration.Canceled, internal.OperationTypeRunTask)).
+		OrderBy(sortExpression).
+		OrderBy(fmt.Sprintf("%s.instance_id", InstancesTableName))
 
 	if len(filter.States) > 0 {
 		stateFilters := buildInstanceStateFilters("o1", filter)
@@ -593,7 +767,17 @@ func (r readSession) ListInstances(filter dbmodel.InstanceFilter) ([]dbmodel.Ins
 	}
 
 	// Add pagination
-	if filter.Page > 0 && filter.PageSize > 0 {
+	switch {
+	case filter.After != nil:
+		sortValue, err := instanceSortValue(filter.Sort, filter.After.SortValue)
+		if err != nil {
+			return nil, -1, -1, errors.Wrap(err, "while reading cursor")
+		}
+		stmt.Where(fmt.Sprintf("(%s, %s.instance_id) > (?, ?)", sortExpression, InstancesTableName), sortValue, filter.After.InstanceID)
+		if filter.PageSize > 0 {
+			stmt.Limit(uint64(filter.PageSize))
+		}
+	case filter.Page > 0 && filter.PageSize > 0:
 		stmt = stmt.Paginate(uint64(filter.Page), uint64(filter.PageSize))
 	}
 
@@ -624,9 +808,9 @@ func (r readSession) getInstanceCount(filter dbmodel.InstanceFilter) (int, error
 		Select("count(*) as total").
 		From(InstancesTableName).
 		Join(dbr.I(OperationTableName).As("o1"), fmt.Sprintf("%s.instance_id = o1.instance_id", InstancesTableName)).
-		LeftJoin(dbr.I(OperationTableName).As("o2"), fmt.Sprintf("%s.instance_id = o2.instance_id AND o1.created_at < o2.created_at AND o2.state NOT IN ('%s', '%s')", InstancesTableName, orchestration.Pending, orchestration.Canceled)).
+		LeftJoin(dbr.I(OperationTableName).As("o2"), fmt.Sprintf("%s.instance_id = o2.instance_id AND o1.created_at < o2.created_at AND o2.state NOT IN ('%s', '%s') AND o2.type != '%s'", InstancesTableName, orchestration.Pending, orchestration.Canceled, internal.OperationTypeRunTask)).
 		Where("o2.created_at IS NULL").
-		Where(fmt.Sprintf("o1.state NOT IN ('%s', '%s')", orchestration.Pending, orchestration.Canceled))
+		Where(fmt.Sprintf("o1.state NOT IN ('%s', '%s') AND o1.type != '%s'", orchestration.Pending, orchestration.Canceled, internal.OperationTypeRunTask))
 
 	if len(filter.States) > 0 {
 		stateFilters := buildInstanceStateFilters("o1", filter)
@@ -639,6 +823,43 @@ func (r readSession) getInstanceCount(filter dbmodel.InstanceFilter) (int, error
 	return res.Total, err
 }
 
+func instanceSortExpression(table string, sort dbmodel.InstanceSortField) string {
+	switch sort {
+	case dbmodel.InstanceSortByGlobalAccountID:
+		return fmt.Sprintf("%s.global_account_id", InstancesTableName)
+	case dbmodel.InstanceSortByState:
+		return instanceStateExpression(table)
+	default:
+		return fmt.Sprintf("%s.%s", InstancesTableName, CreatedAtField)
+	}
+}
+
+func instanceSortValue(sort dbmodel.InstanceSortField, value string) (interface{}, error) {
+	switch sort {
+	case dbmodel.InstanceSortByGlobalAccountID, dbmodel.InstanceSortByState:
+		return value, nil
+	default:
+		return time.Parse(time.RFC3339Nano, value)
+	}
+}
+
+// instanceStateExpression determines the state of the instance from its last operation, like dbmodel.InstanceStateForOperation
+func instanceStateExpression(table string) string {
+	return fmt.Sprintf(`(CASE
+		WHEN %[1]s.state = '%[2]s' AND %[1]s.type = '%[5]s' THEN '%[8]s'
+		WHEN %[1]s.state = '%[2]s' AND %[1]s.type = '%[6]s' THEN '%[9]s'
+		WHEN %[1]s.state = '%[2]s' AND %[1]s.type LIKE 'upgrade%%' THEN '%[10]s'
+		WHEN %[1]s.state = '%[2]s' AND %[1]s.type = '%[7]s' THEN '%[11]s'
+		WHEN %[1]s.state = '%[3]s' AND %[1]s.type IN ('%[5]s', '%[6]s') THEN '%[12]s'
+		WHEN %[1]s.state = '%[3]s' THEN '%[13]s'
+		WHEN %[1]s.state = '%[4]s' AND %[1]s.type = '%[6]s' THEN '%[14]s'
+		ELSE '%[15]s' END)`,
+		table, domain.InProgress, domain.Failed, domain.Succeeded,
+		internal.OperationTypeProvision, internal.OperationTypeDeprovision, internal.OperationTypeUpdate,
+		dbmodel.InstanceProvisioning, dbmodel.InstanceDeprovisioning, dbmodel.InstanceUpgrading, dbmodel.InstanceUpdating,
+		dbmodel.InstanceFailed, dbmodel.InstanceError, dbmodel.InstanceDeprovisioned, dbmodel.InstanceSucceeded)
+}
+
 func buildInstanceStateFilters(table string, filter dbmodel.InstanceFilter) dbr.Builder {
 	var exprs []dbr.Builder
 	for _, s := range filter.States {
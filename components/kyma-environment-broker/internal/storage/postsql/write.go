@@ -1,6 +1,7 @@
 package postsql
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
@@ -12,6 +13,11 @@ import (
 
 const (
 	UniqueViolationErrorCode = "23505"
+
+	// outboxLockKey identifies the advisory lock which serializes assigning sequence numbers to outbox events
+	outboxLockKey = 7311200301
+	// outboxSequenceName is the database sequence of the sequence numbers of outbox events
+	outboxSequenceName = "outbox_events_sequence_seq"
 )
 
 type writeSession struct {
@@ -200,6 +206,243 @@ func (ws writeSession) InsertRuntimeState(state dbmodel.RuntimeStateDTO) dberr.E
 	return nil
 }
 
+func (ws writeSession) InsertBinding(binding dbmodel.BindingDTO) dberr.Error {
+	_, err := ws.insertInto(BindingsTableName).
+		Pair("id", binding.ID).
+		Pair("instance_id", binding.InstanceID).
+		Pair("created_at", binding.CreatedAt).
+		Pair("updated_at", binding.UpdatedAt).
+		Pair("expires_at", binding.ExpiresAt).
+		Pair("kubeconfig", binding.Kubeconfig).
+		Pair("expiration_seconds", binding.ExpirationSeconds).
+		Exec()
+
+	if err != nil {
+		if err, ok := err.(*pq.Error); ok {
+			if err.Code == UniqueViolationErrorCode {
+				return dberr.AlreadyExists("Binding with id %s already exist", binding.ID)
+			}
+		}
+		return dberr.Internal("Failed to insert record to Binding table: %s", err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) DeleteBinding(instanceID, bindingID string) dberr.Error {
+	_, err := ws.deleteFrom(BindingsTableName).
+		Where(dbr.Eq("instance_id", instanceID)).
+		Where(dbr.Eq("id", bindingID)).
+		Exec()
+
+	if err != nil {
+		return dberr.Internal("Failed to delete record from Binding table: %s", err)
+	}
+	return nil
+}
+
+func (ws writeSession) InsertOperationStep(step dbmodel.OperationStepDTO) dberr.Error {
+	_, err := ws.insertInto(OperationStepsTableName).
+		Pair("id", step.ID).
+		Pair("operation_id", step.OperationID).
+		Pair("step_name", step.StepName).
+		Pair("stage", step.Stage).
+		Pair("started_at", step.StartedAt).
+		Pair("finished_at", step.FinishedAt).
+		Pair("result", step.Result).
+		Pair("retry_delay", step.RetryDelay).
+		Pair("error_reason", step.ErrorReason).
+		Pair("error_message", step.ErrorMessage).
+		Exec()
+
+	if err != nil {
+		if err, ok := err.(*pq.Error); ok {
+			if err.Code == UniqueViolationErrorCode {
+				return dberr.AlreadyExists("operation step with id %s already exist", step.ID)
+			}
+		}
+		return dberr.Internal("Failed to insert record to operation steps table: %s", err)
+	}
+
+	return nil
+}
+
+// InsertOutboxEvent saves the event without a sequence number, the number is assigned by SequenceOutboxEvents
+// after the transaction which saves the event commits
+func (ws writeSession) InsertOutboxEvent(event dbmodel.OutboxEventDTO) dberr.Error {
+	_, err := ws.insertInto(OutboxEventsTableName).
+		Pair("event_type", event.EventType).
+		Pair("payload", event.Payload).
+		Pair("created_at", time.Now()).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to insert record to outbox events table: %s", err)
+	}
+	return nil
+}
+
+// SequenceOutboxEvents assigns sequence numbers to up to limit committed events in the order they were inserted.
+// The transaction takes the advisory lock kept until it ends, so numbers are committed in ascending order
+// and a reader never sees an event before an earlier one. Transactions which save events do not take the lock.
+func (ws writeSession) SequenceOutboxEvents(limit int) (int64, dberr.Error) {
+	if ws.transaction == nil {
+		return 0, dberr.Internal("Failed to sequence outbox events: transaction is required")
+	}
+	var locked int
+	err := ws.selectBySql(`SELECT 1 FROM pg_advisory_xact_lock(?)`, outboxLockKey).LoadOne(&locked)
+	if err != nil {
+		return 0, dberr.Internal("Failed to lock outbox events table: %s", err)
+	}
+
+	result, err := ws.updateBySql(fmt.Sprintf(`UPDATE %[1]s SET sequence = numbered.sequence
+		FROM (SELECT id, nextval('%[2]s') AS sequence FROM (
+			SELECT id FROM %[1]s WHERE sequence IS NULL ORDER BY id LIMIT ?) unsequenced ORDER BY id) numbered
+		WHERE %[1]s.id = numbered.id`, OutboxEventsTableName, outboxSequenceName), limit).Exec()
+	if err != nil {
+		return 0, dberr.Internal("Failed to sequence records of outbox events table: %s", err)
+	}
+	sequenced, err := result.RowsAffected()
+	if err != nil {
+		return 0, dberr.Internal("Failed to get number of sequenced outbox events: %s", err)
+	}
+	return sequenced, nil
+}
+
+func (ws writeSession) DeleteOutboxEvents(upToSequence int64) dberr.Error {
+	_, err := ws.deleteFrom(OutboxEventsTableName).
+		Where(dbr.Lte("sequence", upToSequence)).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to delete records from outbox events table: %s", err)
+	}
+	return nil
+}
+
+func (ws writeSession) UpsertOutboxCheckpoint(checkpoint dbmodel.OutboxCheckpointDTO) dberr.Error {
+	_, err := ws.insertBySql(fmt.Sprintf(`INSERT INTO %s (subscriber, sequence, updated_at) VALUES (?, ?, ?)
+		ON CONFLICT (subscriber) DO UPDATE SET sequence = EXCLUDED.sequence, updated_at = EXCLUDED.updated_at`, OutboxCheckpointsTableName),
+		checkpoint.Subscriber, checkpoint.Sequence, checkpoint.UpdatedAt).Exec()
+	if err != nil {
+		return dberr.Internal("Failed to upsert record to outbox checkpoints table: %s", err)
+	}
+	return nil
+}
+
+// InsertOutboxDeadLetter keeps the existing dead letter, so saving the same event again does not fail
+func (ws writeSession) InsertOutboxDeadLetter(letter dbmodel.OutboxDeadLetterDTO) dberr.Error {
+	_, err := ws.insertBySql(fmt.Sprintf(`INSERT INTO %s (subscriber, sequence, event_type, payload, error, attempts, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (subscriber, sequence) DO NOTHING`, OutboxDeadLettersTableName),
+		letter.Subscriber, letter.Sequence, letter.EventType, letter.Payload, letter.Error, letter.Attempts, letter.CreatedAt).Exec()
+	if err != nil {
+		return dberr.Internal("Failed to insert record to outbox dead letters table: %s", err)
+	}
+	return nil
+}
+
+func (ws writeSession) UpsertQueueItem(item dbmodel.QueueItemDTO, keepExisting bool) dberr.Error {
+	onConflict := fmt.Sprintf(`DO UPDATE SET
+		due_at = LEAST(%[1]s.due_at, EXCLUDED.due_at),
+		generation = %[1]s.generation + 1,
+		updated_at = EXCLUDED.updated_at`, QueueItemsTableName)
+	if keepExisting {
+		onConflict = "DO NOTHING"
+	}
+
+	_, err := ws.insertBySql(fmt.Sprintf(`INSERT INTO %s (queue_name, item_id, due_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (queue_name, item_id) %s`, QueueItemsTableName, onConflict),
+		item.QueueName, item.ItemID, item.DueAt, item.CreatedAt, item.UpdatedAt).Exec()
+	if err != nil {
+		return dberr.Internal("Failed to upsert record to QueueItems table: %s", err)
+	}
+	return nil
+}
+
+// LeaseQueueItems reserves due items, which are not leased or whose lease expired.
+// Rows locked by concurrent leases are skipped, so several replicas never get the same item.
+func (ws writeSession) LeaseQueueItems(queue, owner string, now, leaseExpiresAt time.Time, limit int) ([]dbmodel.QueueItemDTO, dberr.Error) {
+	var items []dbmodel.QueueItemDTO
+	_, err := ws.selectBySql(fmt.Sprintf(`UPDATE %[1]s SET
+			lease_owner = ?, lease_expires_at = ?, updated_at = ?
+		WHERE (queue_name, item_id) IN (
+			SELECT queue_name, item_id FROM %[1]s
+			WHERE queue_name = ? AND due_at <= ? AND (lease_owner = '' OR lease_expires_at < ?)
+			ORDER BY due_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED)
+		RETURNING *`, QueueItemsTableName),
+		owner, leaseExpiresAt, now, queue, now, now, limit).Load(&items)
+	if err != nil {
+		return nil, dberr.Internal("Failed to lease records from QueueItems table: %s", err)
+	}
+	return items, nil
+}
+
+func (ws writeSession) RenewQueueItemLease(item dbmodel.QueueItemDTO, leaseExpiresAt time.Time) dberr.Error {
+	res, err := ws.update(QueueItemsTableName).
+		Where(dbr.Eq("queue_name", item.QueueName)).
+		Where(dbr.Eq("item_id", item.ItemID)).
+		Where(dbr.Eq("lease_owner", item.LeaseOwner)).
+		Set("lease_expires_at", leaseExpiresAt).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to renew lease in QueueItems table: %s", err)
+	}
+	rAffected, e := res.RowsAffected()
+	if e != nil {
+		return dberr.Internal("the DB driver does not support RowsAffected operation")
+	}
+	if rAffected == int64(0) {
+		return dberr.NotFound("Cannot find QueueItem %s/%s leased by %s", item.QueueName, item.ItemID, item.LeaseOwner)
+	}
+	return nil
+}
+
+func (ws writeSession) CompleteQueueItem(item dbmodel.QueueItemDTO) dberr.Error {
+	res, err := ws.deleteFrom(QueueItemsTableName).
+		Where(dbr.Eq("queue_name", item.QueueName)).
+		Where(dbr.Eq("item_id", item.ItemID)).
+		Where(dbr.Eq("lease_owner", item.LeaseOwner)).
+		Where(dbr.Eq("generation", item.Generation)).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to delete record from QueueItems table: %s", err)
+	}
+	rAffected, e := res.RowsAffected()
+	if e != nil {
+		return dberr.Internal("the DB driver does not support RowsAffected operation")
+	}
+	if rAffected > 0 {
+		return nil
+	}
+
+	// the item was added again while it was processed, release it to be processed once more
+	_, err = ws.update(QueueItemsTableName).
+		Where(dbr.Eq("queue_name", item.QueueName)).
+		Where(dbr.Eq("item_id", item.ItemID)).
+		Where(dbr.Eq("lease_owner", item.LeaseOwner)).
+		Set("lease_owner", "").
+		Set("updated_at", item.UpdatedAt).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to release record in QueueItems table: %s", err)
+	}
+	return nil
+}
+
+func (ws writeSession) RescheduleQueueItem(item dbmodel.QueueItemDTO, dueAt time.Time) dberr.Error {
+	_, err := ws.updateBySql(fmt.Sprintf(`UPDATE %s SET
+			due_at = CASE WHEN generation = ? THEN ?::timestamptz ELSE LEAST(due_at, ?::timestamptz) END,
+			lease_owner = '', updated_at = ?
+		WHERE queue_name = ? AND item_id = ? AND lease_owner = ?`, QueueItemsTableName),
+		item.Generation, dueAt, dueAt, item.UpdatedAt, item.QueueName, item.ItemID, item.LeaseOwner).Exec()
+	if err != nil {
+		return dberr.Internal("Failed to reschedule record in QueueItems table: %s", err)
+	}
+	return nil
+}
+
 func (ws writeSession) UpdateOperation(op dbmodel.OperationDTO) dberr.Error {
 	res, err := ws.update(OperationTableName).
 		Where(dbr.Eq("id", op.ID)).
@@ -272,3 +515,27 @@ func (ws writeSession) update(table string) *dbr.UpdateStmt {
 
 	return ws.session.Update(table)
 }
+
+func (ws writeSession) insertBySql(query string, value ...interface{}) *dbr.InsertStmt {
+	if ws.transaction != nil {
+		return ws.transaction.InsertBySql(query, value...)
+	}
+
+	return ws.session.InsertBySql(query, value...)
+}
+
+func (ws writeSession) updateBySql(query string, value ...interface{}) *dbr.UpdateStmt {
+	if ws.transaction != nil {
+		return ws.transaction.UpdateBySql(query, value...)
+	}
+
+	return ws.session.UpdateBySql(query, value...)
+}
+
+func (ws writeSession) selectBySql(query string, value ...interface{}) *dbr.SelectStmt {
+	if ws.transaction != nil {
+		return ws.transaction.SelectBySql(query, value...)
+	}
+
+	return ws.session.SelectBySql(query, value...)
+}
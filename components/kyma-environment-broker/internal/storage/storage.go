@@ -15,6 +15,9 @@ type BrokerStorage interface {
 	Deprovisioning() Deprovisioning
 	Orchestrations() Orchestrations
 	RuntimeStates() RuntimeStates
+	Bindings() Bindings
+	QueueItems() QueueItems
+	Outbox() Outbox
 }
 
 const (
@@ -36,22 +39,30 @@ func NewFromConfig(cfg Config, cipher postgres.Cipher, log logrus.FieldLogger) (
 
 	fact := postsql.NewFactory(connection)
 
-	operation := postgres.NewOperation(fact, cipher)
+	outbox := postgres.NewOutbox(fact)
+	operation := postgres.NewOperation(fact, cipher, outbox)
 	return storage{
 		instance:       postgres.NewInstance(fact, operation, cipher),
 		operation:      operation,
 		orchestrations: postgres.NewOrchestrations(fact),
 		runtimeStates:  postgres.NewRuntimeStates(fact, cipher),
+		bindings:       postgres.NewBindings(fact, cipher),
+		queueItems:     postgres.NewQueueItems(fact),
+		outbox:         outbox,
 	}, connection, nil
 }
 
 func NewMemoryStorage() BrokerStorage {
-	op := memory.NewOperation()
+	outbox := memory.NewOutbox()
+	op := memory.NewOperationWithOutbox(outbox)
 	return storage{
 		operation:      op,
 		instance:       memory.NewInstance(op),
 		orchestrations: memory.NewOrchestrations(),
 		runtimeStates:  memory.NewRuntimeStates(),
+		bindings:       memory.NewBindings(),
+		queueItems:     memory.NewQueueItems(),
+		outbox:         outbox,
 	}
 }
 
@@ -60,6 +71,9 @@ type storage struct {
 	operation      Operations
 	orchestrations Orchestrations
 	runtimeStates  RuntimeStates
+	bindings       Bindings
+	queueItems     QueueItems
+	outbox         Outbox
 }
 
 func (s storage) Instances() Instances {
@@ -85,3 +99,15 @@ func (s storage) Orchestrations() Orchestrations {
 func (s storage) RuntimeStates() RuntimeStates {
 	return s.runtimeStates
 }
+
+func (s storage) Bindings() Bindings {
+	return s.bindings
+}
+
+func (s storage) QueueItems() QueueItems {
+	return s.queueItems
+}
+
+func (s storage) Outbox() Outbox {
+	return s.outbox
+}
@@ -0,0 +1,19 @@
+package suspension
+
+import "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+
+type Config struct {
+	// HibernationPlans lists names of plans, which instances are suspended by hibernating the cluster instead of deprovisioning it
+	HibernationPlans []string `envconfig:"optional"`
+}
+
+// HibernationEnabled returns true if the instances of the given plan are suspended by the hibernation
+func (c Config) HibernationEnabled(planID string) bool {
+	planName := broker.PlanNamesMapping[planID]
+	for _, p := range c.HibernationPlans {
+		if p == planName {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,8 @@
 package suspension
 
 import (
+	"fmt"
+
 	"github.com/google/uuid"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
@@ -15,6 +17,8 @@ type ContextUpdateHandler struct {
 	operations          storage.Operations
 	provisioningQueue   Adder
 	deprovisioningQueue Adder
+	hibernationQueue    Adder
+	cfg                 Config
 
 	log logrus.FieldLogger
 }
@@ -23,11 +27,13 @@ type Adder interface {
 	Add(processId string)
 }
 
-func NewContextUpdateHandler(operations storage.Operations, provisioningQueue Adder, deprovisioningQueue Adder, l logrus.FieldLogger) *ContextUpdateHandler {
+func NewContextUpdateHandler(operations storage.Operations, provisioningQueue Adder, deprovisioningQueue Adder, hibernationQueue Adder, cfg Config, l logrus.FieldLogger) *ContextUpdateHandler {
 	return &ContextUpdateHandler{
 		operations:          operations,
 		provisioningQueue:   provisioningQueue,
 		deprovisioningQueue: deprovisioningQueue,
+		hibernationQueue:    hibernationQueue,
+		cfg:                 cfg,
 		log:                 l,
 	}
 }
@@ -60,6 +66,13 @@ func (h *ContextUpdateHandler) handleContextChange(newCtx internal.ERSContext, i
 	if err != nil && !dberr.IsNotFound(err) {
 		return false, err
 	}
+	lastHibernation, err := h.lastHibernationOperation(instance.InstanceID)
+	if err != nil {
+		return false, err
+	}
+	// the instance was suspended by the hibernation if the hibernation is more recent than the deprovisioning
+	hibernated := lastHibernation != nil && lastHibernation.Type == internal.OperationTypeHibernate &&
+		(lastDeprovisioning == nil || lastHibernation.CreatedAt.After(lastDeprovisioning.CreatedAt))
 
 	if newCtx.Active == nil || isActivated == *newCtx.Active {
 		l.Debugf("Context.Active flag was not changed, the current value: %v", isActivated)
@@ -70,7 +83,11 @@ func (h *ContextUpdateHandler) handleContextChange(newCtx internal.ERSContext, i
 		}
 		if !isActivated {
 			// instance is inactive and incoming context update is suspension - verify if KEB should retrigger the operation
-			if lastDeprovisioning.Temporary && lastDeprovisioning.State == domain.Failed {
+			if hibernated && lastHibernation.State == domain.Failed {
+				l.Infof("Retriggering hibernation for instance id %s", instance.InstanceID)
+				return true, h.hibernate(instance, l)
+			}
+			if !hibernated && lastDeprovisioning != nil && lastDeprovisioning.Temporary && lastDeprovisioning.State == domain.Failed {
 				l.Infof("Retriggering suspension for instance id %s", instance.InstanceID)
 				return true, h.suspend(instance, l)
 			}
@@ -83,8 +100,14 @@ func (h *ContextUpdateHandler) handleContextChange(newCtx internal.ERSContext, i
 			l.Infof("Instance has a deprovisioning operation %s (%s), skipping unsuspension.", lastDeprovisioning.ID, lastDeprovisioning.State)
 			return false, nil
 		}
+		if hibernated {
+			return true, h.wakeUp(instance, l)
+		}
 		return true, h.unsuspend(instance, l)
 	} else {
+		if h.cfg.HibernationEnabled(instance.ServicePlanID) {
+			return true, h.hibernate(instance, l)
+		}
 		return true, h.suspend(instance, l)
 	}
 }
@@ -134,3 +157,48 @@ func (h *ContextUpdateHandler) unsuspend(instance *internal.Instance, log logrus
 	h.provisioningQueue.Add(operation.ID)
 	return nil
 }
+
+func (h *ContextUpdateHandler) hibernate(instance *internal.Instance, log logrus.FieldLogger) error {
+	return h.startHibernationOperation(internal.NewHibernationOperationWithID(uuid.New().String(), instance), log)
+}
+
+func (h *ContextUpdateHandler) wakeUp(instance *internal.Instance, log logrus.FieldLogger) error {
+	return h.startHibernationOperation(internal.NewWakeUpOperationWithID(uuid.New().String(), instance), log)
+}
+
+// startHibernationOperation inserts and queues the hibernate or wake up operation unless the same operation is already in progress.
+// An unfinished operation of the opposite type is not interrupted, the new operation waits in the pending state until it finishes.
+func (h *ContextUpdateHandler) startHibernationOperation(operation internal.HibernationOperation, log logrus.FieldLogger) error {
+	lastHibernation, err := h.lastHibernationOperation(operation.InstanceID)
+	if err != nil {
+		return err
+	}
+	if lastHibernation != nil && !lastHibernation.IsFinished() {
+		if lastHibernation.Type == operation.Type {
+			log.Infof("The %s operation %s already started", lastHibernation.Type, lastHibernation.Operation.ID)
+			return nil
+		}
+		log.Infof("The %s operation %s is in progress, the %s operation waits for it", lastHibernation.Type, lastHibernation.Operation.ID, operation.Type)
+		operation.Description = fmt.Sprintf("Waiting for the %s operation %s to finish", lastHibernation.Type, lastHibernation.Operation.ID)
+	}
+
+	log.Infof("Starting %s: runtimeID=%s", operation.Type, operation.RuntimeID)
+	err = h.operations.InsertHibernationOperation(operation)
+	if err != nil {
+		return err
+	}
+	h.hibernationQueue.Add(operation.Operation.ID)
+	return nil
+}
+
+// lastHibernationOperation returns the latest hibernate or wake up operation of the instance or nil if there is none
+func (h *ContextUpdateHandler) lastHibernationOperation(instanceID string) (*internal.HibernationOperation, error) {
+	ops, err := h.operations.ListHibernationOperationsByInstanceID(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ops) == 0 {
+		return nil, nil
+	}
+	return &ops[0], nil
+}
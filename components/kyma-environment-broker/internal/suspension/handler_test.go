@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	hibernationProcess "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/hibernation"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
@@ -25,7 +26,7 @@ func TestSuspension(t *testing.T) {
 	deprovisioning := NewDummyQueue()
 	st := storage.NewMemoryStorage()
 
-	svc := NewContextUpdateHandler(st.Operations(), provisioning, deprovisioning, logrus.New())
+	svc := NewContextUpdateHandler(st.Operations(), provisioning, deprovisioning, NewDummyQueue(), Config{}, logrus.New())
 	instance := fixInstance(fixActiveErsContext())
 	st.Instances().Insert(*instance)
 
@@ -50,7 +51,7 @@ func TestSuspension_Retrigger(t *testing.T) {
 		deprovisioning := NewDummyQueue()
 		st := storage.NewMemoryStorage()
 
-		svc := NewContextUpdateHandler(st.Operations(), provisioning, deprovisioning, logrus.New())
+		svc := NewContextUpdateHandler(st.Operations(), provisioning, deprovisioning, NewDummyQueue(), Config{}, logrus.New())
 		instance := fixInstance(fixInactiveErsContext())
 		st.Instances().Insert(*instance)
 		st.Operations().InsertDeprovisioningOperation(internal.DeprovisioningOperation{
@@ -85,7 +86,7 @@ func TestSuspension_Retrigger(t *testing.T) {
 		deprovisioning := NewDummyQueue()
 		st := storage.NewMemoryStorage()
 
-		svc := NewContextUpdateHandler(st.Operations(), provisioning, deprovisioning, logrus.New())
+		svc := NewContextUpdateHandler(st.Operations(), provisioning, deprovisioning, NewDummyQueue(), Config{}, logrus.New())
 		instance := fixInstance(fixInactiveErsContext())
 		st.Instances().Insert(*instance)
 		st.Operations().InsertDeprovisioningOperation(internal.DeprovisioningOperation{
@@ -131,7 +132,7 @@ func TestUnsuspension(t *testing.T) {
 	deprovisioning := NewDummyQueue()
 	st := storage.NewMemoryStorage()
 
-	svc := NewContextUpdateHandler(st.Operations(), provisioning, deprovisioning, logrus.New())
+	svc := NewContextUpdateHandler(st.Operations(), provisioning, deprovisioning, NewDummyQueue(), Config{}, logrus.New())
 	instance := fixInstance(fixInactiveErsContext())
 	instance.InstanceDetails.ShootName = "c-012345"
 	instance.InstanceDetails.ShootDomain = "c-012345.sap.com"
@@ -165,7 +166,7 @@ func TestUnsuspensionForDeprovisioningInstance(t *testing.T) {
 	deprovisioning := NewDummyQueue()
 	st := storage.NewMemoryStorage()
 
-	svc := NewContextUpdateHandler(st.Operations(), provisioning, deprovisioning, logrus.New())
+	svc := NewContextUpdateHandler(st.Operations(), provisioning, deprovisioning, NewDummyQueue(), Config{}, logrus.New())
 	instance := fixInstance(fixInactiveErsContext())
 	instance.InstanceDetails.ShootName = "c-012345"
 	instance.InstanceDetails.ShootDomain = "c-012345.sap.com"
@@ -187,6 +188,187 @@ func TestUnsuspensionForDeprovisioningInstance(t *testing.T) {
 	assertQueue(t, provisioning)
 }
 
+func TestHibernation(t *testing.T) {
+	// given
+	provisioning := NewDummyQueue()
+	deprovisioning := NewDummyQueue()
+	hibernation := NewDummyQueue()
+	st := storage.NewMemoryStorage()
+
+	svc := NewContextUpdateHandler(st.Operations(), provisioning, deprovisioning, hibernation, fixHibernationConfig(), logrus.New())
+	instance := fixInstance(fixActiveErsContext())
+	st.Instances().Insert(*instance)
+
+	// when
+	changed, err := svc.Handle(instance, fixInactiveErsContext())
+	require.NoError(t, err)
+	assert.True(t, changed, "handler to change active flag")
+
+	// then
+	ops, err := st.Operations().ListHibernationOperationsByInstanceID("instance-id")
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	assertQueue(t, hibernation, ops[0].Operation.ID)
+	assertQueue(t, deprovisioning)
+	assertQueue(t, provisioning)
+
+	assert.Equal(t, internal.OperationTypeHibernate, ops[0].Type)
+	assert.Equal(t, domain.LastOperationState(orchestration.Pending), ops[0].State)
+	assert.Equal(t, instance.RuntimeID, ops[0].RuntimeID)
+}
+
+func TestHibernation_Retrigger(t *testing.T) {
+	for name, tc := range map[string]struct {
+		state           domain.LastOperationState
+		expectedChanged bool
+		expectedOps     int
+	}{
+		"should skip hibernation when the hibernation is in progress": {
+			state:       domain.InProgress,
+			expectedOps: 1,
+		},
+		"should skip hibernation when the hibernation succeeded": {
+			state:       domain.Succeeded,
+			expectedOps: 1,
+		},
+		"should retrigger hibernation when the hibernation failed": {
+			state:           domain.Failed,
+			expectedChanged: true,
+			expectedOps:     2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			hibernation := NewDummyQueue()
+			st := storage.NewMemoryStorage()
+
+			svc := NewContextUpdateHandler(st.Operations(), NewDummyQueue(), NewDummyQueue(), hibernation, fixHibernationConfig(), logrus.New())
+			instance := fixInstance(fixInactiveErsContext())
+			st.Instances().Insert(*instance)
+			op := internal.NewHibernationOperationWithID("hibernation-op-id", instance)
+			op.CreatedAt = time.Now().Add(-time.Minute)
+			op.State = tc.state
+			st.Operations().InsertHibernationOperation(op)
+
+			// when
+			changed, err := svc.Handle(instance, fixInactiveErsContext())
+			require.NoError(t, err)
+
+			// then
+			assert.Equal(t, tc.expectedChanged, changed)
+			ops, err := st.Operations().ListHibernationOperationsByInstanceID("instance-id")
+			require.NoError(t, err)
+			assert.Len(t, ops, tc.expectedOps)
+			if tc.expectedChanged {
+				assertQueue(t, hibernation, ops[0].Operation.ID)
+			} else {
+				assertQueue(t, hibernation)
+			}
+		})
+	}
+}
+
+func TestWakeUp(t *testing.T) {
+	// given
+	provisioning := NewDummyQueue()
+	deprovisioning := NewDummyQueue()
+	hibernation := NewDummyQueue()
+	st := storage.NewMemoryStorage()
+
+	// the hibernation is disabled in the meantime, the hibernated cluster must be woken up anyway
+	svc := NewContextUpdateHandler(st.Operations(), provisioning, deprovisioning, hibernation, Config{}, logrus.New())
+	instance := fixInstance(fixInactiveErsContext())
+	st.Instances().Insert(*instance)
+
+	deprovisioningOperation := fixture.FixDeprovisioningOperation("d-op", "instance-id")
+	deprovisioningOperation.Temporary = true
+	deprovisioningOperation.CreatedAt = time.Now().Add(-time.Hour)
+	st.Operations().InsertDeprovisioningOperation(deprovisioningOperation)
+	hibernationOperation := internal.NewHibernationOperationWithID("hibernation-op-id", instance)
+	hibernationOperation.CreatedAt = time.Now().Add(-time.Minute)
+	hibernationOperation.State = domain.Succeeded
+	st.Operations().InsertHibernationOperation(hibernationOperation)
+
+	// when
+	changed, err := svc.Handle(instance, fixActiveErsContext())
+	require.NoError(t, err)
+	assert.True(t, changed, "handler to change active flag")
+
+	// then
+	ops, err := st.Operations().ListHibernationOperationsByInstanceID("instance-id")
+	require.NoError(t, err)
+	require.Len(t, ops, 2)
+	assertQueue(t, hibernation, ops[0].Operation.ID)
+	assertQueue(t, deprovisioning)
+	assertQueue(t, provisioning)
+
+	assert.Equal(t, internal.OperationTypeWakeUp, ops[0].Type)
+	assert.Equal(t, domain.LastOperationState(orchestration.Pending), ops[0].State)
+	_, err = st.Operations().GetProvisioningOperationByInstanceID("instance-id")
+	assert.True(t, dberr.IsNotFound(err))
+}
+
+func TestWakeUp_HibernationInProgress(t *testing.T) {
+	// given
+	hibernation := NewDummyQueue()
+	st := storage.NewMemoryStorage()
+
+	svc := NewContextUpdateHandler(st.Operations(), NewDummyQueue(), NewDummyQueue(), hibernation, fixHibernationConfig(), logrus.New())
+	instance := fixInstance(fixActiveErsContext())
+	st.Instances().Insert(*instance)
+
+	// suspension starts the hibernation
+	changed, err := svc.Handle(instance, fixInactiveErsContext())
+	require.NoError(t, err)
+	require.True(t, changed)
+	ops, err := st.Operations().ListHibernationOperationsByInstanceID("instance-id")
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	hibernationOperation := ops[0]
+	hibernationOperation.State = domain.InProgress
+	updated, err := st.Operations().UpdateHibernationOperation(hibernationOperation)
+	require.NoError(t, err)
+	hibernationOperation = *updated
+	instance.Parameters.ErsContext = fixInactiveErsContext()
+
+	// when
+	changed, err = svc.Handle(instance, fixActiveErsContext())
+	require.NoError(t, err)
+	assert.True(t, changed, "handler to change active flag")
+	// repeated unsuspension does not start another wake up
+	_, err = svc.Handle(instance, fixActiveErsContext())
+	require.NoError(t, err)
+
+	// then
+	ops, err = st.Operations().ListHibernationOperationsByInstanceID("instance-id")
+	require.NoError(t, err)
+	require.Len(t, ops, 2)
+	wakeUpOperation := ops[0]
+	assertQueue(t, hibernation, hibernationOperation.Operation.ID, wakeUpOperation.Operation.ID)
+	assert.Equal(t, internal.OperationTypeWakeUp, wakeUpOperation.Type)
+	assert.Equal(t, domain.LastOperationState(orchestration.Pending), wakeUpOperation.State)
+	assert.Contains(t, wakeUpOperation.Description, hibernationOperation.Operation.ID)
+
+	// the wake up waits until the hibernation finishes
+	step := hibernationProcess.NewInitialisationStep(st.Operations(), st.Instances(), &hibernationProcess.TimeSchedule{Retry: time.Second, StatusCheck: time.Minute})
+	op, backoff, err := step.Run(wakeUpOperation, logrus.New())
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, backoff)
+	assert.Equal(t, domain.LastOperationState(orchestration.Pending), op.State)
+
+	hibernationOperation.State = domain.Succeeded
+	_, err = st.Operations().UpdateHibernationOperation(hibernationOperation)
+	require.NoError(t, err)
+	op, backoff, err = step.Run(wakeUpOperation, logrus.New())
+	require.NoError(t, err)
+	assert.Zero(t, backoff)
+	assert.Equal(t, domain.InProgress, op.State)
+}
+
+func fixHibernationConfig() Config {
+	return Config{HibernationPlans: []string{broker.TrialPlanName}}
+}
+
 func fixInstance(ersContext internal.ERSContext) *internal.Instance {
 	instance := fixture.FixInstance("instance-id")
 	instance.ServicePlanID = broker.TrialPlanID
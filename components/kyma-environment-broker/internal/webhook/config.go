@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+type Config struct {
+	Enabled bool `envconfig:"default=false"`
+	// EndpointsYAMLFilePath is the path of the file with the list of endpoints, see Endpoint
+	EndpointsYAMLFilePath string
+	// Source is the source attribute of sent CloudEvents
+	Source           string        `envconfig:"default=kyma-environment-broker"`
+	Timeout          time.Duration `envconfig:"default=10s"`
+	MaxRetries       int           `envconfig:"default=5"`
+	RetryInterval    time.Duration `envconfig:"default=1s"`
+	MaxRetryInterval time.Duration `envconfig:"default=30s"`
+}
+
+// Endpoint is the receiver of events. Empty filters match all events.
+type Endpoint struct {
+	Name string `yaml:"name"`
+	URL  string `yaml:"url"`
+	// Secret is the key of the HMAC signature of the request body, the request is not signed if the secret is empty
+	Secret string `yaml:"secret"`
+
+	// Plans contains names or IDs of plans
+	Plans          []string `yaml:"plans"`
+	GlobalAccounts []string `yaml:"globalAccounts"`
+	// EventTypes contains types of CloudEvents, for example io.kyma-project.keb.provisioning.succeeded
+	EventTypes []string `yaml:"eventTypes"`
+}
+
+func ReadEndpointsFromYAML(yamlFilePath string) ([]Endpoint, error) {
+	var endpoints struct {
+		Endpoints []Endpoint `yaml:"endpoints"`
+	}
+	yamlFile, err := ioutil.ReadFile(yamlFilePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading YAML file with webhook endpoints")
+	}
+
+	err = yaml.Unmarshal(yamlFile, &endpoints)
+	if err != nil {
+		return nil, errors.Wrap(err, "while unmarshalling YAML file with webhook endpoints")
+	}
+
+	names := map[string]struct{}{}
+	for _, e := range endpoints.Endpoints {
+		if e.Name == "" || e.URL == "" {
+			return nil, errors.New("name and url of the webhook endpoint must not be empty")
+		}
+		if _, found := names[e.Name]; found {
+			return nil, errors.Errorf("webhook endpoint %s is defined more than once", e.Name)
+		}
+		names[e.Name] = struct{}{}
+	}
+	return endpoints.Endpoints, nil
+}
@@ -0,0 +1,38 @@
+package webhook_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadEndpointsFromYAML(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), "webhooks.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`
+endpoints:
+  - name: platform
+    url: https://platform.example.com/events
+    secret: top-secret
+    plans: [azure, trial]
+    eventTypes:
+      - io.kyma-project.keb.provisioning.succeeded
+`), 0644))
+
+	// when
+	endpoints, err := webhook.ReadEndpointsFromYAML(path)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []webhook.Endpoint{{
+		Name:       "platform",
+		URL:        "https://platform.example.com/events",
+		Secret:     "top-secret",
+		Plans:      []string{"azure", "trial"},
+		EventTypes: []string{webhook.EventTypeProvisioningSucceeded},
+	}}, endpoints)
+}
@@ -0,0 +1,227 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	EventTypeProvisioningStepProcessed   = "io.kyma-project.keb.provisioning.step.processed"
+	EventTypeProvisioningSucceeded       = "io.kyma-project.keb.provisioning.succeeded"
+	EventTypeDeprovisioningStepProcessed = "io.kyma-project.keb.deprovisioning.step.processed"
+	EventTypeUpdatingStepProcessed       = "io.kyma-project.keb.update.step.processed"
+	EventTypeUpgradeKymaStepProcessed    = "io.kyma-project.keb.upgradeKyma.step.processed"
+	EventTypeUpgradeClusterStepProcessed = "io.kyma-project.keb.upgradeCluster.step.processed"
+	EventTypeRunTaskStepProcessed        = "io.kyma-project.keb.runTask.step.processed"
+	EventTypeOperationChanged            = "io.kyma-project.keb.operation.changed"
+	cloudEventsSpecVersion               = "1.0"
+	cloudEventsContentType               = "application/cloudevents+json"
+	SignatureHeader                      = "X-KEB-Signature-256"
+)
+
+// eventIDNamespace makes IDs of CloudEvents stable, an event delivered again has the same ID
+var eventIDNamespace = uuid.MustParse("5f3c2bd4-6c39-4b7b-9a43-1d0c0b6a3f11")
+
+// CloudEvent is the structured mode JSON form of a CloudEvent
+type CloudEvent struct {
+	SpecVersion     string                 `json:"specversion"`
+	ID              string                 `json:"id"`
+	Source          string                 `json:"source"`
+	Type            string                 `json:"type"`
+	Subject         string                 `json:"subject,omitempty"`
+	Time            time.Time              `json:"time"`
+	DataContentType string                 `json:"datacontenttype"`
+	Data            process.OperationEvent `json:"data"`
+}
+
+// Sink sends events of operations to webhook endpoints
+type Sink struct {
+	cfg        Config
+	endpoints  []Endpoint
+	httpClient *http.Client
+	log        logrus.FieldLogger
+}
+
+func NewSink(cfg Config, endpoints []Endpoint, log logrus.FieldLogger) *Sink {
+	return &Sink{
+		cfg:        cfg,
+		endpoints:  endpoints,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+		log:        log,
+	}
+}
+
+// Subscribe registers every endpoint as a separate durable subscriber. The outbox dispatcher delivers every durable
+// subscriber by its own worker, so an unavailable endpoint does not delay others. The operation changed event is saved
+// only with the outbox, so without it an endpoint which requests the event is rejected.
+func (s *Sink) Subscribe(subscriber event.DurableSubscriber) error {
+	events := []struct {
+		evType event.DurableEvent
+		ceType string
+		// outboxOnly marks events created only by the storage of operations, they are not published in memory
+		outboxOnly bool
+	}{
+		{evType: process.ProvisioningStepProcessed{}, ceType: EventTypeProvisioningStepProcessed},
+		{evType: process.ProvisioningSucceeded{}, ceType: EventTypeProvisioningSucceeded},
+		{evType: process.DeprovisioningStepProcessed{}, ceType: EventTypeDeprovisioningStepProcessed},
+		{evType: process.UpdatingStepProcessed{}, ceType: EventTypeUpdatingStepProcessed},
+		{evType: process.UpgradeKymaStepProcessed{}, ceType: EventTypeUpgradeKymaStepProcessed},
+		{evType: process.UpgradeClusterStepProcessed{}, ceType: EventTypeUpgradeClusterStepProcessed},
+		{evType: process.RunTaskStepProcessed{}, ceType: EventTypeRunTaskStepProcessed},
+		{evType: process.OperationChanged{}, ceType: EventTypeOperationChanged, outboxOnly: true},
+	}
+	available := map[string]bool{}
+	for _, e := range events {
+		available[e.ceType] = !e.outboxOnly || subscriber.Durable()
+	}
+	for _, endpoint := range s.endpoints {
+		for _, ceType := range endpoint.EventTypes {
+			enabled, known := available[ceType]
+			switch {
+			case !known:
+				return errors.Errorf("webhook endpoint %s requests unknown event type %s", endpoint.Name, ceType)
+			case !enabled:
+				return errors.Errorf("webhook endpoint %s requests event type %s which is sent only when the outbox is enabled", endpoint.Name, ceType)
+			}
+		}
+	}
+
+	for _, endpoint := range s.endpoints {
+		for _, e := range events {
+			if !available[e.ceType] {
+				continue
+			}
+			if len(endpoint.EventTypes) > 0 && !contains(endpoint.EventTypes, e.ceType) {
+				continue
+			}
+			subscriber.SubscribeDurable(fmt.Sprintf("webhook-%s", endpoint.Name), e.evType, s.handler(endpoint, e.ceType))
+		}
+	}
+	return nil
+}
+
+func (s *Sink) handler(endpoint Endpoint, ceType string) event.Handler {
+	return func(ctx context.Context, ev interface{}) error {
+		data, ok := ev.(process.OperationEvent)
+		if !ok {
+			return fmt.Errorf("unexpected event %T", ev)
+		}
+		if !matches(endpoint, data) {
+			return nil
+		}
+		return s.send(ctx, endpoint, s.cloudEvent(ceType, data))
+	}
+}
+
+func (s *Sink) cloudEvent(ceType string, data process.OperationEvent) CloudEvent {
+	payload, _ := json.Marshal(data)
+	return CloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              uuid.NewSHA1(eventIDNamespace, append([]byte(ceType), payload...)).String(),
+		Source:          s.cfg.Source,
+		Type:            ceType,
+		Subject:         data.InstanceID,
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		Data:            data,
+	}
+}
+
+// send posts the event and retries with exponential backoff. The error is returned when all retries fail,
+// so the outbox dispatcher sends the event again in the next poll. When the outbox delivery attempts are exhausted,
+// the dispatcher saves the event as a dead letter of the endpoint and continues with later events.
+func (s *Sink) send(ctx context.Context, endpoint Endpoint, ce CloudEvent) error {
+	body, err := json.Marshal(ce)
+	if err != nil {
+		return fmt.Errorf("while encoding CloudEvent: %w", err)
+	}
+	log := s.log.WithField("endpoint", endpoint.Name).WithField("eventType", ce.Type).WithField("operation", ce.Data.OperationID)
+
+	interval := s.cfg.RetryInterval
+	for attempt := 0; ; attempt++ {
+		err = s.post(ctx, endpoint, body)
+		if err == nil {
+			return nil
+		}
+		if attempt >= s.cfg.MaxRetries {
+			return fmt.Errorf("unable to send event %s after %d retries: %w", ce.ID, attempt, err)
+		}
+		log.Warnf("unable to send event %s, retrying in %s: %s", ce.ID, interval, err)
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		interval *= 2
+		if interval > s.cfg.MaxRetryInterval {
+			interval = s.cfg.MaxRetryInterval
+		}
+	}
+}
+
+func (s *Sink) post(ctx context.Context, endpoint Endpoint, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("while creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", cloudEventsContentType)
+	if endpoint.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(endpoint.Secret, body))
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("while calling %s: %w", endpoint.URL, err)
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("calling %s returned %s status", endpoint.URL, resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the value of the signature header, the hex encoded HMAC SHA256 of the body prefixed with sha256=
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func matches(endpoint Endpoint, data process.OperationEvent) bool {
+	if len(endpoint.Plans) > 0 && !contains(endpoint.Plans, data.PlanID) && !contains(endpoint.Plans, broker.PlanNamesMapping[data.PlanID]) {
+		return false
+	}
+	if len(endpoint.GlobalAccounts) > 0 && !contains(endpoint.GlobalAccounts, data.GlobalAccountID) {
+		return false
+	}
+	return true
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
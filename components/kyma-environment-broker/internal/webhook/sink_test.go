@@ -0,0 +1,239 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/memory"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/webhook"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSink(t *testing.T) {
+	t.Run("should send signed CloudEvents to matching endpoints", func(t *testing.T) {
+		// given
+		receiver := newReceiver(0)
+		server := httptest.NewServer(receiver)
+		defer server.Close()
+
+		outbox := memory.NewOutbox()
+		operations := memory.NewOperationWithOutbox(outbox)
+		pubSub := event.NewDurablePubSub(outbox, process.OperationEvents, event.OutboxConfig{BatchSize: 10}, logrus.New())
+		sink := webhook.NewSink(fixConfig(), []webhook.Endpoint{
+			{Name: "all", URL: server.URL + "/all", Secret: "secret"},
+			{Name: "azure", URL: server.URL + "/azure", Plans: []string{"azure"}, EventTypes: []string{webhook.EventTypeProvisioningSucceeded}},
+			{Name: "other", URL: server.URL + "/other", GlobalAccounts: []string{"other-ga"}},
+		}, logrus.New())
+		require.NoError(t, sink.Subscribe(pubSub))
+
+		op := fixture.FixProvisioningOperation("op-1", "inst-1")
+		op.State = domain.Succeeded
+
+		// when
+		require.NoError(t, operations.InsertProvisioningOperation(op))
+		pubSub.Dispatch()
+
+		// then
+		all := receiver.requests("/all")
+		require.Len(t, all, 2)
+		assert.Equal(t, "application/cloudevents+json", all[0].contentType)
+		assert.Equal(t, webhook.Sign("secret", all[0].body), all[0].signature)
+
+		var ce webhook.CloudEvent
+		require.NoError(t, json.Unmarshal(all[0].body, &ce))
+		assert.Equal(t, "1.0", ce.SpecVersion)
+		assert.Equal(t, webhook.EventTypeOperationChanged, ce.Type)
+		assert.Equal(t, "kyma-environment-broker", ce.Source)
+		assert.Equal(t, "inst-1", ce.Subject)
+		assert.Equal(t, "op-1", ce.Data.OperationID)
+		assert.Equal(t, string(domain.Succeeded), ce.Data.State)
+
+		azure := receiver.requests("/azure")
+		require.Len(t, azure, 1)
+		assert.Empty(t, azure[0].signature)
+		require.NoError(t, json.Unmarshal(azure[0].body, &ce))
+		assert.Equal(t, webhook.EventTypeProvisioningSucceeded, ce.Type)
+
+		assert.Empty(t, receiver.requests("/other"))
+	})
+
+	t.Run("should retry sending the event", func(t *testing.T) {
+		// given
+		receiver := newReceiver(2)
+		server := httptest.NewServer(receiver)
+		defer server.Close()
+
+		outbox := memory.NewOutbox()
+		operations := memory.NewOperationWithOutbox(outbox)
+		pubSub := event.NewDurablePubSub(outbox, process.OperationEvents, event.OutboxConfig{BatchSize: 10}, logrus.New())
+		sink := webhook.NewSink(fixConfig(), []webhook.Endpoint{{Name: "flaky", URL: server.URL, EventTypes: []string{webhook.EventTypeOperationChanged}}}, logrus.New())
+		require.NoError(t, sink.Subscribe(pubSub))
+
+		// when
+		require.NoError(t, operations.InsertProvisioningOperation(fixture.FixProvisioningOperation("op-1", "inst-1")))
+		pubSub.Dispatch()
+
+		// then
+		assert.Len(t, receiver.requests("/"), 1)
+		assert.Equal(t, 3, receiver.calls)
+	})
+
+	t.Run("should send the event again in the next poll when all retries fail", func(t *testing.T) {
+		// given
+		receiver := newReceiver(5)
+		server := httptest.NewServer(receiver)
+		defer server.Close()
+
+		outbox := memory.NewOutbox()
+		operations := memory.NewOperationWithOutbox(outbox)
+		pubSub := event.NewDurablePubSub(outbox, process.OperationEvents, event.OutboxConfig{BatchSize: 10}, logrus.New())
+		sink := webhook.NewSink(fixConfig(), []webhook.Endpoint{{Name: "unavailable", URL: server.URL, EventTypes: []string{webhook.EventTypeOperationChanged}}}, logrus.New())
+		require.NoError(t, sink.Subscribe(pubSub))
+		require.NoError(t, operations.InsertProvisioningOperation(fixture.FixProvisioningOperation("op-1", "inst-1")))
+
+		// when
+		pubSub.Dispatch()
+
+		// then
+		assert.Empty(t, receiver.requests("/"))
+		assert.Equal(t, 4, receiver.calls)
+		events, err := outbox.ListAfter(0, 10)
+		require.NoError(t, err)
+		assert.Len(t, events, 1)
+
+		// when
+		pubSub.Dispatch()
+
+		// then
+		assert.Len(t, receiver.requests("/"), 1)
+		events, err = outbox.ListAfter(0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("should send step events with and without the outbox", func(t *testing.T) {
+		// given
+		receiver := newReceiver(0)
+		server := httptest.NewServer(receiver)
+		defer server.Close()
+
+		outbox := memory.NewOutbox()
+		durable := event.NewDurablePubSub(outbox, process.OperationEvents, event.OutboxConfig{BatchSize: 10}, logrus.New())
+		inMemory := event.NewPubSub(logrus.New())
+		endpoints := []webhook.Endpoint{{Name: "steps", URL: server.URL, EventTypes: []string{webhook.EventTypeProvisioningStepProcessed}}}
+		require.NoError(t, webhook.NewSink(fixConfig(), endpoints, logrus.New()).Subscribe(durable))
+		require.NoError(t, webhook.NewSink(fixConfig(), endpoints, logrus.New()).Subscribe(inMemory))
+		stepProcessed := process.ProvisioningStepProcessed{
+			StepProcessed: process.StepProcessed{StepName: "Create_Runtime"},
+			Operation:     fixture.FixProvisioningOperation("op-1", "inst-1"),
+		}
+
+		// when
+		durable.Publish(context.TODO(), stepProcessed)
+		durable.Dispatch()
+		inMemory.Publish(context.TODO(), stepProcessed)
+
+		// then
+		assert.Eventually(t, func() bool {
+			return len(receiver.requests("/")) == 2
+		}, 5*time.Second, 10*time.Millisecond)
+		for _, r := range receiver.requests("/") {
+			var ce webhook.CloudEvent
+			require.NoError(t, json.Unmarshal(r.body, &ce))
+			assert.Equal(t, webhook.EventTypeProvisioningStepProcessed, ce.Type)
+			assert.Equal(t, "Create_Runtime", ce.Data.StepName)
+		}
+	})
+
+	t.Run("should reject event types which are not sent", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			pubSub     *event.PubSub
+			eventTypes []string
+		}{
+			"operation changed without the outbox": {
+				pubSub:     event.NewPubSub(logrus.New()),
+				eventTypes: []string{webhook.EventTypeProvisioningSucceeded, webhook.EventTypeOperationChanged},
+			},
+			"unknown event type": {
+				pubSub:     event.NewDurablePubSub(memory.NewOutbox(), process.OperationEvents, event.OutboxConfig{}, logrus.New()),
+				eventTypes: []string{"io.kyma-project.keb.unknown"},
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// given
+				sink := webhook.NewSink(fixConfig(), []webhook.Endpoint{{Name: "endpoint", URL: "http://localhost", EventTypes: tc.eventTypes}}, logrus.New())
+
+				// when
+				err := sink.Subscribe(tc.pubSub)
+
+				// then
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func fixConfig() webhook.Config {
+	return webhook.Config{
+		Enabled:          true,
+		Source:           "kyma-environment-broker",
+		Timeout:          time.Second,
+		MaxRetries:       3,
+		RetryInterval:    time.Millisecond,
+		MaxRetryInterval: 5 * time.Millisecond,
+	}
+}
+
+type request struct {
+	body        []byte
+	contentType string
+	signature   string
+}
+
+type receiver struct {
+	mu       sync.Mutex
+	failures int
+	calls    int
+	received map[string][]request
+}
+
+func newReceiver(failures int) *receiver {
+	return &receiver{failures: failures, received: map[string][]request{}}
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls++
+	if r.calls <= r.failures {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	body, _ := ioutil.ReadAll(req.Body)
+	r.received[req.URL.Path] = append(r.received[req.URL.Path], request{
+		body:        body,
+		contentType: req.Header.Get("Content-Type"),
+		signature:   req.Header.Get(webhook.SignatureHeader),
+	})
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (r *receiver) requests(path string) []request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.received[path]
+}
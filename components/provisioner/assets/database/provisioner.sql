@@ -43,6 +43,8 @@ CREATE TABLE gardener_config
     exposure_class_name varchar(256),
     provider_specific_config jsonb,
     shoot_networking_filter_disabled boolean,
+    hibernation_schedules jsonb,
+    worker_pools jsonb,
     UNIQUE(cluster_id),
     foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
 );
@@ -83,6 +85,8 @@ CREATE TABLE operation
     component text NOT NULL
 );
 
+CREATE INDEX operation_by_cluster_id ON operation USING btree (cluster_id, start_timestamp);
+
 -- Kyma Release
 
 CREATE TABLE kyma_release
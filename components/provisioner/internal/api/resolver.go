@@ -167,6 +167,42 @@ func (r *Resolver) RuntimeOperationStatus(ctx context.Context, operationID strin
 	return status, nil
 }
 
+func (r *Resolver) Runtimes(ctx context.Context, filter *gqlschema.RuntimesFilter, first *int, after *string) (*gqlschema.RuntimeConnection, error) {
+	tenant, err := r.tenantUpdater.GetTenant(ctx)
+	if err != nil {
+		log.Errorf("Failed to list Runtimes: %s", err)
+		return nil, err
+	}
+
+	log.Infof("Requested to list Runtimes of tenant %s.", tenant)
+
+	runtimes, err := r.provisioning.ListRuntimes(tenant, filter, first, after)
+	if err != nil {
+		log.Errorf("Failed to list Runtimes of tenant %s: %s", tenant, err)
+		return nil, err
+	}
+
+	return runtimes, nil
+}
+
+func (r *Resolver) Operations(ctx context.Context, runtimeID *string, operationType *gqlschema.OperationType, state *gqlschema.OperationState, since *string, first *int, after *string) (*gqlschema.OperationConnection, error) {
+	tenant, err := r.tenantUpdater.GetTenant(ctx)
+	if err != nil {
+		log.Errorf("Failed to list operations: %s", err)
+		return nil, err
+	}
+
+	log.Infof("Requested to list operations of tenant %s.", tenant)
+
+	operations, err := r.provisioning.ListOperations(tenant, runtimeID, operationType, state, since, first, after)
+	if err != nil {
+		log.Errorf("Failed to list operations of tenant %s: %s", tenant, err)
+		return nil, err
+	}
+
+	return operations, nil
+}
+
 func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, error) {
 	log.Infof("Requested to upgrade Gardener Shoot cluster specification for Runtime : %s.", runtimeID)
 
@@ -211,6 +247,24 @@ func (r *Resolver) HibernateRuntime(ctx context.Context, runtimeID string) (*gql
 	return status, nil
 }
 
+func (r *Resolver) WakeUpRuntime(ctx context.Context, runtimeID string) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested to wake up runtime : %s.", runtimeID)
+
+	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to wake up Runtime  %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	status, err := r.provisioning.WakeUpCluster(runtimeID)
+	if err != nil {
+		log.Errorf("Failed to wake up Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	return status, nil
+}
+
 func getSubAccount(ctx context.Context) string {
 	subAccount, ok := ctx.Value(middlewares.SubAccountID).(string)
 	if !ok {
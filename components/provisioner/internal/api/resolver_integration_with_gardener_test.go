@@ -200,6 +200,9 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 	shootHibernationQueue := queue.CreateHibernationQueue(testHibernationTimeouts(), dbsFactory, directorServiceMock, shootInterface)
 	shootHibernationQueue.Run(queueCtx.Done())
 
+	shootWakeUpQueue := queue.CreateWakeUpQueue(testWakeUpTimeouts(), dbsFactory, directorServiceMock, shootInterface, secretsInterface)
+	shootWakeUpQueue.Run(queueCtx.Done())
+
 	controler, err := gardener.NewShootController(mgr, dbsFactory, auditLogsConfigPath)
 	require.NoError(t, err)
 
@@ -252,7 +255,7 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 			inputConverter := provisioning.NewInputConverter(uuidGenerator, provider, "Project", defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, forceAllowPrivilegedContainers)
 			graphQLConverter := provisioning.NewGraphQLConverter()
 
-			provisioningService := provisioning.NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, dbsFactory, provisioner, uuidGenerator, gardener.NewShootProvider(shootInterface), installationServiceMockForDeprovisiong, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, shootHibernationQueue)
+			provisioningService := provisioning.NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, dbsFactory, provisioner, uuidGenerator, gardener.NewShootProvider(shootInterface), installationServiceMockForDeprovisiong, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, shootHibernationQueue, shootWakeUpQueue)
 
 			validator := api.NewValidator()
 
@@ -366,6 +369,23 @@ func testProvisionRuntime(t *testing.T, ctx context.Context, resolver *api.Resol
 
 	assert.Equal(t, expectedSeed, *runtimeStatusProvisioned.RuntimeConfiguration.ClusterConfig.Seed)
 	assert.Equal(t, fixKymaGraphQLConfig(), runtimeStatusProvisioned.RuntimeConfiguration.KymaConfig)
+
+	// when listing Runtimes
+	runtimes, err := resolver.Runtimes(ctx, &gqlschema.RuntimesFilter{ShootName: &shoot.Name}, nil, nil)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, runtimes.Edges, 1)
+	assert.Equal(t, runtimeID, runtimes.Edges[0].Node.ID)
+	assert.Equal(t, fixOperationStatusProvisioned(provisionRuntime.RuntimeID, provisionRuntime.ID), runtimes.Edges[0].Node.LastOperationStatus)
+
+	// when listing operations of the Runtime
+	operations, err := resolver.Operations(ctx, provisionRuntime.RuntimeID, nil, nil, nil, nil, nil)
+
+	// then
+	require.NoError(t, err)
+	require.NotEmpty(t, operations.Edges)
+	assert.Equal(t, *provisionRuntime.ID, operations.Edges[0].Node.ID)
 }
 
 func testUpgradeRuntimeAndRollback(t *testing.T, ctx context.Context, resolver *api.Resolver, dbsFactory dbsession.Factory, runtimeID string) {
@@ -594,6 +614,13 @@ func testHibernationTimeouts() queue.HibernationTimeouts {
 	}
 }
 
+func testWakeUpTimeouts() queue.WakeUpTimeouts {
+	return queue.WakeUpTimeouts{
+		WaitingForClusterWakeUp: 5 * time.Minute,
+		KubeconfigRefresh:       5 * time.Minute,
+	}
+}
+
 func removeFinalizers(t *testing.T, shootInterface gardener_apis.ShootInterface, shoot *gardener_types.Shoot) *gardener_types.Shoot {
 	shoot.SetFinalizers([]string{})
 
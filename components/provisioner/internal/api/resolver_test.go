@@ -608,6 +608,108 @@ func TestResolver_HibernateRuntime(t *testing.T) {
 	})
 }
 
+func TestResolver_Runtimes(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+	filter := &gqlschema.RuntimesFilter{SubAccountID: util.StringPtr("sub-account")}
+	first := 10
+
+	t.Run("Should return Runtimes of the tenant", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		connection := &gqlschema.RuntimeConnection{
+			TotalCount: 1,
+			Edges: []*gqlschema.RuntimeEdge{
+				{Cursor: "cursor", Node: &gqlschema.Runtime{ID: runtimeID, Tenant: tenant}},
+			},
+			PageInfo: &gqlschema.PageInfo{EndCursor: util.StringPtr("cursor")},
+		}
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+		provisioningService.On("ListRuntimes", tenant, filter, &first, (*string)(nil)).Return(connection, nil)
+
+		//when
+		runtimes, err := provisioner.Runtimes(ctx, filter, &first, nil)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, connection, runtimes)
+	})
+
+	t.Run("Should fail when tenant header is not passed to context", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		ctx := context.Background()
+
+		tenantUpdater.On("GetTenant", ctx).Return("", apperrors.BadRequest("tenant header is empty"))
+
+		//when
+		runtimes, err := provisioner.Runtimes(ctx, filter, &first, nil)
+
+		//then
+		require.Error(t, err)
+		require.Nil(t, runtimes)
+		provisioningService.AssertNotCalled(t, "ListRuntimes")
+	})
+}
+
+func TestResolver_Operations(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+	state := gqlschema.OperationStateFailed
+
+	t.Run("Should return operations of the tenant", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		connection := &gqlschema.OperationConnection{
+			TotalCount: 1,
+			Edges: []*gqlschema.OperationEdge{
+				{Cursor: "cursor", Node: &gqlschema.Operation{ID: operationID, RuntimeID: runtimeID, State: state}},
+			},
+			PageInfo: &gqlschema.PageInfo{EndCursor: util.StringPtr("cursor")},
+		}
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+		provisioningService.On("ListOperations", tenant, util.StringPtr(runtimeID), (*gqlschema.OperationType)(nil), &state, (*string)(nil), (*int)(nil), (*string)(nil)).Return(connection, nil)
+
+		//when
+		operations, err := provisioner.Operations(ctx, util.StringPtr(runtimeID), nil, &state, nil, nil, nil)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, connection, operations)
+	})
+
+	t.Run("Should return error when listing operations fails", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+		provisioningService.On("ListOperations", tenant, (*string)(nil), (*gqlschema.OperationType)(nil), &state, (*string)(nil), (*int)(nil), (*string)(nil)).Return(nil, apperrors.Internal("Some error"))
+
+		//when
+		operations, err := provisioner.Operations(ctx, nil, nil, &state, nil, nil, nil)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeInternal)
+		require.Nil(t, operations)
+	})
+}
+
 func oidcInput() *gqlschema.OIDCConfigInput {
 	return &gqlschema.OIDCConfigInput{
 		ClientID:       "9bd05ed7-a930-44e6-8c79-e6defeb2222",
@@ -1,7 +1,11 @@
 package api
 
 import (
+	"regexp"
 	"strings"
+	"time"
+	// hibernation schedule locations are validated in images without the system time zone database
+	_ "time/tzdata"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
@@ -11,6 +15,13 @@ import (
 
 const RuntimeAgent = "compass-runtime-agent"
 
+var cronFieldRegexp = regexp.MustCompile(`^[0-9A-Za-z*?,/-]+$`)
+
+// Gardener limits the length of the worker pool names and requires them to be DNS labels
+var workerPoolNameRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,13}[a-z0-9])?$`)
+
+const mainWorkerPoolName = "cpu-worker-0"
+
 //go:generate mockery -name=Validator
 type Validator interface {
 	ValidateProvisioningInput(input gqlschema.ProvisionRuntimeInput) apperrors.AppError
@@ -76,6 +87,14 @@ func (v *validator) ValidateUpgradeShootInput(input gqlschema.UpgradeShootInput)
 		return apperrors.BadRequest("empty purpose provided")
 	}
 
+	if err := v.validateHibernationSchedules(config.HibernationSchedules); err != nil {
+		return err
+	}
+
+	if err := v.validateWorkerPools(config.WorkerPools); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -122,6 +141,14 @@ func (v *validator) validateClusterConfig(clusterConfig *gqlschema.ClusterConfig
 		return err
 	}
 
+	if err := v.validateHibernationSchedules(gardenerConfig.HibernationSchedules); err != nil {
+		return err
+	}
+
+	if err := v.validateWorkerPools(gardenerConfig.WorkerPools); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -142,6 +169,85 @@ func (v *validator) validateOpenStackVolume(diskType *string, volumeSizeGb *int,
 	return nil
 }
 
+func (v *validator) validateHibernationSchedules(schedules []*gqlschema.HibernationScheduleInput) apperrors.AppError {
+	for _, schedule := range schedules {
+		if util.IsNilOrEmpty(schedule.Start) && util.IsNilOrEmpty(schedule.End) {
+			return apperrors.BadRequest("error: hibernation schedule must define start or end")
+		}
+		if util.NotNilOrEmpty(schedule.Start) && !isCronExpression(*schedule.Start) {
+			return apperrors.BadRequest("error: hibernation schedule start %q is not a valid cron expression", *schedule.Start)
+		}
+		if util.NotNilOrEmpty(schedule.End) && !isCronExpression(*schedule.End) {
+			return apperrors.BadRequest("error: hibernation schedule end %q is not a valid cron expression", *schedule.End)
+		}
+		if util.NotNilOrEmpty(schedule.Location) {
+			if _, err := time.LoadLocation(*schedule.Location); err != nil {
+				return apperrors.BadRequest("error: hibernation schedule location %q is not a valid time zone", *schedule.Location)
+			}
+		}
+	}
+	return nil
+}
+
+func (v *validator) validateWorkerPools(pools []*gqlschema.WorkerPoolInput) apperrors.AppError {
+	names := make(map[string]struct{}, len(pools))
+	for _, pool := range pools {
+		if !workerPoolNameRegexp.MatchString(pool.Name) {
+			return apperrors.BadRequest("error: worker pool name %q must consist of at most 15 lower case alphanumeric characters or '-'", pool.Name)
+		}
+		if pool.Name == mainWorkerPoolName {
+			return apperrors.BadRequest("error: worker pool name %q is reserved for the main worker pool", pool.Name)
+		}
+		if _, found := names[pool.Name]; found {
+			return apperrors.BadRequest("error: worker pool name %q is not unique", pool.Name)
+		}
+		names[pool.Name] = struct{}{}
+
+		if pool.MachineType == "" {
+			return apperrors.BadRequest("error: empty machine type provided for worker pool %q", pool.Name)
+		}
+		if pool.AutoScalerMin < 0 || pool.AutoScalerMax < pool.AutoScalerMin {
+			return apperrors.BadRequest("error: invalid auto scaler range %d-%d for worker pool %q", pool.AutoScalerMin, pool.AutoScalerMax, pool.Name)
+		}
+		for key, value := range pool.Labels {
+			if _, ok := value.(string); !ok {
+				return apperrors.BadRequest("error: value of label %q of worker pool %q must be a string", key, pool.Name)
+			}
+		}
+		for _, taint := range pool.Taints {
+			if taint.Key == "" {
+				return apperrors.BadRequest("error: empty taint key provided for worker pool %q", pool.Name)
+			}
+			if !isTaintEffect(taint.Effect) {
+				return apperrors.BadRequest("error: taint effect %q of worker pool %q must be one of NoSchedule, PreferNoSchedule, NoExecute", taint.Effect, pool.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func isTaintEffect(effect string) bool {
+	switch effect {
+	case "NoSchedule", "PreferNoSchedule", "NoExecute":
+		return true
+	}
+	return false
+}
+
+// isCronExpression checks if the expression consists of the five standard cron fields
+func isCronExpression(expression string) bool {
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return false
+	}
+	for _, field := range fields {
+		if !cronFieldRegexp.MatchString(field) {
+			return false
+		}
+	}
+	return true
+}
+
 func configContainsRuntimeAgentComponent(components []*gqlschema.ComponentConfigurationInput) bool {
 	for _, component := range components {
 		if component.Component == RuntimeAgent {
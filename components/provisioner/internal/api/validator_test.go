@@ -149,6 +149,137 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 		//then
 		require.Error(t, err)
 	})
+
+	t.Run("should validate hibernation schedules", func(t *testing.T) {
+		for tn, tc := range map[string]struct {
+			schedule *gqlschema.HibernationScheduleInput
+			valid    bool
+		}{
+			"start and end with location": {
+				schedule: &gqlschema.HibernationScheduleInput{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 08 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+				valid:    true,
+			},
+			"start only": {
+				schedule: &gqlschema.HibernationScheduleInput{Start: util.StringPtr("0 18 * * FRI")},
+				valid:    true,
+			},
+			"neither start nor end": {
+				schedule: &gqlschema.HibernationScheduleInput{Location: util.StringPtr("Europe/Berlin")},
+				valid:    false,
+			},
+			"invalid cron expression": {
+				schedule: &gqlschema.HibernationScheduleInput{Start: util.StringPtr("every evening")},
+				valid:    false,
+			},
+			"invalid location": {
+				schedule: &gqlschema.HibernationScheduleInput{End: util.StringPtr("0 8 * * *"), Location: util.StringPtr("Middle/Earth")},
+				valid:    false,
+			},
+		} {
+			t.Run(tn, func(t *testing.T) {
+				//given
+				clusterConfig, runtimeInput, kymaConfig := initializeConfigs()
+				clusterConfig.GardenerConfig.HibernationSchedules = []*gqlschema.HibernationScheduleInput{tc.schedule}
+
+				config := gqlschema.ProvisionRuntimeInput{
+					RuntimeInput:  runtimeInput,
+					ClusterConfig: clusterConfig,
+					KymaConfig:    kymaConfig,
+				}
+
+				validator := NewValidator()
+
+				//when
+				err := validator.ValidateProvisioningInput(config)
+
+				//then
+				if tc.valid {
+					require.NoError(t, err)
+				} else {
+					require.Error(t, err)
+					util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+				}
+			})
+		}
+	})
+
+	t.Run("should validate worker pools", func(t *testing.T) {
+		for tn, tc := range map[string]struct {
+			pools []*gqlschema.WorkerPoolInput
+			valid bool
+		}{
+			"pool with labels and taints": {
+				pools: []*gqlschema.WorkerPoolInput{{
+					Name: "gpu", MachineType: "n1-highmem-8", AutoScalerMin: 0, AutoScalerMax: 2,
+					Labels: gqlschema.Labels{"accelerator": "gpu"},
+					Taints: []*gqlschema.TaintInput{{Key: "nvidia.com/gpu", Effect: "NoSchedule"}},
+				}},
+				valid: true,
+			},
+			"invalid name": {
+				pools: []*gqlschema.WorkerPoolInput{{Name: "GPU_Pool", MachineType: "n1-highmem-8", AutoScalerMax: 2}},
+				valid: false,
+			},
+			"name of the main worker pool": {
+				pools: []*gqlschema.WorkerPoolInput{{Name: "cpu-worker-0", MachineType: "n1-highmem-8", AutoScalerMax: 2}},
+				valid: false,
+			},
+			"duplicated name": {
+				pools: []*gqlschema.WorkerPoolInput{
+					{Name: "gpu", MachineType: "n1-highmem-8", AutoScalerMax: 2},
+					{Name: "gpu", MachineType: "n1-highmem-4", AutoScalerMax: 2},
+				},
+				valid: false,
+			},
+			"empty machine type": {
+				pools: []*gqlschema.WorkerPoolInput{{Name: "gpu", AutoScalerMax: 2}},
+				valid: false,
+			},
+			"minimum greater than maximum": {
+				pools: []*gqlschema.WorkerPoolInput{{Name: "gpu", MachineType: "n1-highmem-8", AutoScalerMin: 3, AutoScalerMax: 2}},
+				valid: false,
+			},
+			"invalid taint effect": {
+				pools: []*gqlschema.WorkerPoolInput{{
+					Name: "gpu", MachineType: "n1-highmem-8", AutoScalerMax: 2,
+					Taints: []*gqlschema.TaintInput{{Key: "dedicated", Effect: "Sometimes"}},
+				}},
+				valid: false,
+			},
+			"label value which is not a string": {
+				pools: []*gqlschema.WorkerPoolInput{{
+					Name: "gpu", MachineType: "n1-highmem-8", AutoScalerMax: 2,
+					Labels: gqlschema.Labels{"replicas": 3},
+				}},
+				valid: false,
+			},
+		} {
+			t.Run(tn, func(t *testing.T) {
+				//given
+				clusterConfig, runtimeInput, kymaConfig := initializeConfigs()
+				clusterConfig.GardenerConfig.WorkerPools = tc.pools
+
+				config := gqlschema.ProvisionRuntimeInput{
+					RuntimeInput:  runtimeInput,
+					ClusterConfig: clusterConfig,
+					KymaConfig:    kymaConfig,
+				}
+
+				validator := NewValidator()
+
+				//when
+				err := validator.ValidateProvisioningInput(config)
+
+				//then
+				if tc.valid {
+					require.NoError(t, err)
+				} else {
+					require.Error(t, err)
+					util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+				}
+			})
+		}
+	})
 }
 
 func TestValidator_ValidateUpgradeInput(t *testing.T) {
@@ -366,6 +497,46 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 		require.Error(t, err)
 		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
 	})
+
+	t.Run("Should return error when Gardener config input provide invalid hibernation schedule", func(t *testing.T) {
+		//given
+		validator := NewValidator()
+
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				HibernationSchedules: []*gqlschema.HibernationScheduleInput{
+					{Start: util.StringPtr("00 20 * *"), Location: util.StringPtr("Europe/Berlin")},
+				},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
+	t.Run("Should return error when Gardener config input provide invalid worker pool", func(t *testing.T) {
+		//given
+		validator := NewValidator()
+
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				WorkerPools: []*gqlschema.WorkerPoolInput{
+					{Name: "gpu", MachineType: "", AutoScalerMin: 1, AutoScalerMax: 2},
+				},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
 }
 
 func initializeConfigs() (*gqlschema.ClusterConfigInput, *gqlschema.RuntimeInput, *gqlschema.KymaConfigInput) {
@@ -8,6 +8,7 @@ import (
 	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/aws"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/azure"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -47,6 +48,34 @@ type DNSProvider struct {
 	Type           string   `json:"type" db:"type"`
 }
 
+type HibernationSchedule struct {
+	Start    *string `json:"start,omitempty"`
+	End      *string `json:"end,omitempty"`
+	Location *string `json:"location,omitempty"`
+}
+
+// WorkerPool is an additional worker pool created next to the main one described by the GardenerConfig,
+// the values which are not provided are taken from the main worker pool
+type WorkerPool struct {
+	Name           string            `json:"name"`
+	MachineType    string            `json:"machineType"`
+	AutoScalerMin  int               `json:"autoScalerMin"`
+	AutoScalerMax  int               `json:"autoScalerMax"`
+	MaxSurge       *int              `json:"maxSurge,omitempty"`
+	MaxUnavailable *int              `json:"maxUnavailable,omitempty"`
+	VolumeSizeGB   *int              `json:"volumeSizeGB,omitempty"`
+	DiskType       *string           `json:"diskType,omitempty"`
+	Zones          []string          `json:"zones,omitempty"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	Taints         []Taint           `json:"taints,omitempty"`
+}
+
+type Taint struct {
+	Key    string  `json:"key"`
+	Value  *string `json:"value,omitempty"`
+	Effect string  `json:"effect"`
+}
+
 type GardenerConfig struct {
 	ID                                  string
 	ClusterID                           string
@@ -77,6 +106,8 @@ type GardenerConfig struct {
 	DNSConfig                           *DNSConfig
 	ExposureClassName                   *string
 	ShootNetworkingFilterDisabled       *bool
+	HibernationSchedules                []HibernationSchedule `db:"-"`
+	WorkerPools                         []WorkerPool          `db:"-"`
 }
 
 type ExtensionProviderConfig struct {
@@ -186,7 +217,8 @@ func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subA
 					MachineImageVersion: c.EnableMachineImageVersionAutoUpdate,
 				},
 			},
-			DNS: gardenerDnsConfig(dnsInputConfig),
+			DNS:         gardenerDnsConfig(dnsInputConfig),
+			Hibernation: gardenerHibernation(c.HibernationSchedules),
 			Extensions: []gardener_types.Extension{
 				{Type: "shoot-dns-service", ProviderConfig: &apimachineryRuntime.RawExtension{Raw: jsonDNSConfig}},
 				{Type: "shoot-cert-service", ProviderConfig: &apimachineryRuntime.RawExtension{Raw: jsonCertConfig}},
@@ -217,6 +249,27 @@ func gardenerOidcConfig(oidcConfig *OIDCConfig) *gardener_types.OIDCConfig {
 	return nil
 }
 
+func gardenerHibernation(schedules []HibernationSchedule) *gardener_types.Hibernation {
+	if len(schedules) == 0 {
+		return nil
+	}
+	return &gardener_types.Hibernation{
+		Schedules: gardenerHibernationSchedules(schedules),
+	}
+}
+
+func gardenerHibernationSchedules(schedules []HibernationSchedule) []gardener_types.HibernationSchedule {
+	gardenerSchedules := make([]gardener_types.HibernationSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		gardenerSchedules = append(gardenerSchedules, gardener_types.HibernationSchedule{
+			Start:    schedule.Start,
+			End:      schedule.End,
+			Location: schedule.Location,
+		})
+	}
+	return gardenerSchedules
+}
+
 func gardenerDnsConfig(dnsConfig *DNSConfig) *gardener_types.DNS {
 	dns := gardener_types.DNS{}
 
@@ -347,7 +400,7 @@ func (c GCPGardenerConfig) ValidateShootConfigChange(shoot *gardener_types.Shoot
 func (c GCPGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 	shoot.Spec.CloudProfileName = "gcp"
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, c.input.Zones)}
+	workers := getWorkersConfig(gardenerConfig, c.input.Zones)
 
 	gcpInfra := NewGCPInfrastructure(gardenerConfig.WorkerCidr)
 	jsonData, err := json.Marshal(gcpInfra)
@@ -499,7 +552,7 @@ func (c AzureGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, sh
 	if len(c.input.AzureZones) > 0 {
 		zoneNames = getAzureZonesNames(c.input.AzureZones)
 	}
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zoneNames)}
+	workers := getWorkersConfig(gardenerConfig, zoneNames)
 
 	azInfra := NewAzureInfrastructure(gardenerConfig.WorkerCidr, c)
 	jsonData, err := json.Marshal(azInfra)
@@ -599,7 +652,7 @@ func (c AWSGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoo
 
 	zoneNames := getAWSZonesNames(c.input.AwsZones)
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zoneNames)}
+	workers := getWorkersConfig(gardenerConfig, zoneNames)
 
 	awsInfra := NewAWSInfrastructure(c)
 	jsonData, err := json.Marshal(awsInfra)
@@ -664,7 +717,7 @@ func (c OpenStackGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig,
 func (c OpenStackGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 	shoot.Spec.CloudProfileName = c.input.CloudProfileName
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, c.input.Zones)}
+	workers := getWorkersConfig(gardenerConfig, c.input.Zones)
 
 	openStackInfra := NewOpenStackInfrastructure(c.input.FloatingPoolName, gardenerConfig.WorkerCidr)
 	jsonData, err := json.Marshal(openStackInfra)
@@ -688,6 +741,19 @@ func (c OpenStackGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig
 	return nil
 }
 
+func getWorkersConfig(gardenerConfig GardenerConfig, zones []string) []gardener_types.Worker {
+	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zones)}
+	for _, pool := range gardenerConfig.WorkerPools {
+		worker := gardener_types.Worker{
+			Name:    pool.Name,
+			Machine: getMachineConfig(gardenerConfig),
+		}
+		applyWorkerPool(&worker, gardenerConfig, pool, zones)
+		workers = append(workers, worker)
+	}
+	return workers
+}
+
 func getWorkerConfig(gardenerConfig GardenerConfig, zones []string) gardener_types.Worker {
 	worker := gardener_types.Worker{
 		Name:           "cpu-worker-0",
@@ -709,6 +775,68 @@ func getWorkerConfig(gardenerConfig GardenerConfig, zones []string) gardener_typ
 	return worker
 }
 
+// applyWorkerPool sets the configuration of the worker pool on the worker, missing values are taken from the main worker pool
+func applyWorkerPool(worker *gardener_types.Worker, gardenerConfig GardenerConfig, pool WorkerPool, zones []string) {
+	worker.Machine.Type = pool.MachineType
+	worker.Minimum = int32(pool.AutoScalerMin)
+	worker.Maximum = int32(pool.AutoScalerMax)
+	worker.MaxSurge = util.IntOrStringPtr(intstr.FromInt(util.UnwrapIntOrDefault(pool.MaxSurge, gardenerConfig.MaxSurge)))
+	worker.MaxUnavailable = util.IntOrStringPtr(intstr.FromInt(util.UnwrapIntOrDefault(pool.MaxUnavailable, gardenerConfig.MaxUnavailable)))
+	worker.Zones = zones
+	if len(pool.Zones) > 0 {
+		worker.Zones = pool.Zones
+	}
+	worker.Labels = pool.Labels
+	worker.Taints = gardenerTaints(pool.Taints)
+
+	diskType := util.DefaultStrIfNil(pool.DiskType, gardenerConfig.DiskType)
+	volumeSizeGB := util.DefaultIntIfNil(pool.VolumeSizeGB, gardenerConfig.VolumeSizeGB)
+	if diskType != nil && volumeSizeGB != nil {
+		worker.Volume = &gardener_types.Volume{
+			Type:       diskType,
+			VolumeSize: fmt.Sprintf("%dGi", *volumeSizeGB),
+		}
+	}
+}
+
+func gardenerTaints(taints []Taint) []corev1.Taint {
+	if len(taints) == 0 {
+		return nil
+	}
+	result := make([]corev1.Taint, 0, len(taints))
+	for _, taint := range taints {
+		result = append(result, corev1.Taint{
+			Key:    taint.Key,
+			Value:  util.UnwrapStr(taint.Value),
+			Effect: corev1.TaintEffect(taint.Effect),
+		})
+	}
+	return result
+}
+
+// updateWorkerPools replaces the additional worker pools of the shoot with the ones from the configuration,
+// the first worker is the main worker pool and is always kept
+func updateWorkerPools(upgradeConfig GardenerConfig, workers []gardener_types.Worker, zones []string) []gardener_types.Worker {
+	existing := make(map[string]gardener_types.Worker, len(workers))
+	for _, worker := range workers[1:] {
+		existing[worker.Name] = worker
+	}
+
+	result := []gardener_types.Worker{workers[0]}
+	for _, pool := range upgradeConfig.WorkerPools {
+		worker, found := existing[pool.Name]
+		if !found {
+			worker = gardener_types.Worker{
+				Name:    pool.Name,
+				Machine: *workers[0].Machine.DeepCopy(),
+			}
+		}
+		applyWorkerPool(&worker, upgradeConfig, pool, zones)
+		result = append(result, worker)
+	}
+	return result
+}
+
 func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot, zones []string) apperrors.AppError {
 
 	if upgradeConfig.KubernetesVersion != "" {
@@ -735,7 +863,7 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 		shoot.Spec.Provider.Workers[0].Volume.VolumeSize = fmt.Sprintf("%dGi", *upgradeConfig.VolumeSizeGB)
 	}
 
-	// We support only single working group during provisioning
+	// The first worker is the main worker pool, the additional ones are updated from the worker pools below
 	shoot.Spec.Provider.Workers[0].MaxSurge = util.IntOrStringPtr(intstr.FromInt(upgradeConfig.MaxSurge))
 	shoot.Spec.Provider.Workers[0].MaxUnavailable = util.IntOrStringPtr(intstr.FromInt(upgradeConfig.MaxUnavailable))
 	shoot.Spec.Provider.Workers[0].Machine.Type = upgradeConfig.MachineType
@@ -779,6 +907,17 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 		shoot.Spec.Extensions = upgradedExtensions
 	}
 
+	if upgradeConfig.HibernationSchedules != nil {
+		if shoot.Spec.Hibernation == nil {
+			shoot.Spec.Hibernation = &gardener_types.Hibernation{}
+		}
+		shoot.Spec.Hibernation.Schedules = gardenerHibernationSchedules(upgradeConfig.HibernationSchedules)
+	}
+
+	if upgradeConfig.WorkerPools != nil {
+		shoot.Spec.Provider.Workers = updateWorkerPools(upgradeConfig, shoot.Spec.Provider.Workers, zones)
+	}
+
 	return nil
 }
 
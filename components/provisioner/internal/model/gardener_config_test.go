@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -570,6 +571,48 @@ func TestGardenerConfig_ToShootTemplate(t *testing.T) {
 		})
 	}
 
+	t.Run("should set hibernation schedules in Shoot template", func(t *testing.T) {
+		// given
+		gardenerProviderConfig := fixGardenerConfig("gcp", gcpGardenerProvider)
+		gardenerProviderConfig.HibernationSchedules = fixHibernationSchedules()
+
+		// when
+		template, err := gardenerProviderConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &gardener_types.Hibernation{
+			Schedules: []gardener_types.HibernationSchedule{
+				{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 08 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+			},
+		}, template.Spec.Hibernation)
+	})
+
+	t.Run("should add worker pools to Shoot template", func(t *testing.T) {
+		// given
+		gardenerProviderConfig := fixGardenerConfig("gcp", gcpGardenerProvider)
+		gardenerProviderConfig.WorkerPools = fixWorkerPools()
+
+		// when
+		template, err := gardenerProviderConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, template.Spec.Provider.Workers, 2)
+		assert.Equal(t, "cpu-worker-0", template.Spec.Provider.Workers[0].Name)
+		assert.Equal(t, testkit.NewTestWorker("gpu").
+			WithMachineType("n1-highmem-8").
+			WithMachineImageAndVersion("gardenlinux", "25.0.0").
+			WithVolume("SSD", 30).
+			WithMinMax(0, 2).
+			WithMaxSurge(1).
+			WithMaxUnavailable(0).
+			WithZones("fix-zone-1", "fix-zone-2").
+			WithLabels(map[string]string{"accelerator": "gpu"}).
+			WithTaints(corev1.Taint{Key: "nvidia.com/gpu", Value: "present", Effect: corev1.TaintEffectNoSchedule}).
+			ToWorker(), template.Spec.Provider.Workers[1])
+	})
+
 }
 
 func TestEditShootConfig(t *testing.T) {
@@ -651,6 +694,102 @@ func TestEditShootConfig(t *testing.T) {
 				return shoot
 			}(expectedShoot),
 		},
+		{description: "should update hibernation schedules and keep hibernation state",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.HibernationSchedules = fixHibernationSchedules()
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Hibernation = &gardener_types.Hibernation{Enabled: util.BoolPtr(true)}
+				return shoot
+			}(initialShoot),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Hibernation = &gardener_types.Hibernation{
+					Enabled: util.BoolPtr(true),
+					Schedules: []gardener_types.HibernationSchedule{
+						{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 08 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+					},
+				}
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should remove hibernation schedules",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.HibernationSchedules = []HibernationSchedule{}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Hibernation = &gardener_types.Hibernation{
+					Schedules: []gardener_types.HibernationSchedule{{Start: util.StringPtr("00 20 * * *")}},
+				}
+				return shoot
+			}(initialShoot),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Hibernation = &gardener_types.Hibernation{Schedules: []gardener_types.HibernationSchedule{}}
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should add, resize and remove worker pools",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.WorkerPools = []WorkerPool{
+					{Name: "gpu", MachineType: "n1-highmem-8", AutoScalerMin: 1, AutoScalerMax: 4},
+					{Name: "memory", MachineType: "n1-ultramem-40", AutoScalerMin: 0, AutoScalerMax: 1, VolumeSizeGB: util.IntPtr(100)},
+				}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers,
+					testkit.NewTestWorker("gpu").WithMachineType("n1-highmem-8").WithMachineImageAndVersion("gardenlinux", "24.0.0").WithMinMax(0, 2).ToWorker(),
+					testkit.NewTestWorker("obsolete").WithMachineType("n1-standard-2").ToWorker(),
+				)
+				return shoot
+			}(initialShoot),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers,
+					testkit.NewTestWorker("gpu").
+						WithMachineType("n1-highmem-8").
+						WithMachineImageAndVersion("gardenlinux", "24.0.0").
+						WithVolume("SSD", 30).
+						WithMinMax(1, 4).
+						WithMaxSurge(30).
+						WithMaxUnavailable(1).
+						WithZones("fix-zone-1", "fix-zone-2").
+						ToWorker(),
+					testkit.NewTestWorker("memory").
+						WithMachineType("n1-ultramem-40").
+						WithMachineImageAndVersion("gardenlinux", "25.0.0").
+						WithVolume("SSD", 100).
+						WithMinMax(0, 1).
+						WithMaxSurge(30).
+						WithMaxUnavailable(1).
+						WithZones("fix-zone-1", "fix-zone-2").
+						ToWorker(),
+				)
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should remove all worker pools",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.WorkerPools = []WorkerPool{}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, testkit.NewTestWorker("gpu").ToWorker())
+				return shoot
+			}(initialShoot),
+			expectedShoot: expectedShoot.DeepCopy(),
+		},
 	} {
 		t.Run(testCase.description, func(t *testing.T) {
 			// given
@@ -721,6 +860,27 @@ func fixGardenerConfig(provider string, providerCfg GardenerProviderConfig) Gard
 	}
 }
 
+func fixHibernationSchedules() []HibernationSchedule {
+	return []HibernationSchedule{
+		{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 08 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+	}
+}
+
+func fixWorkerPools() []WorkerPool {
+	return []WorkerPool{
+		{
+			Name:           "gpu",
+			MachineType:    "n1-highmem-8",
+			AutoScalerMin:  0,
+			AutoScalerMax:  2,
+			MaxSurge:       util.IntPtr(1),
+			MaxUnavailable: util.IntPtr(0),
+			Labels:         map[string]string{"accelerator": "gpu"},
+			Taints:         []Taint{{Key: "nvidia.com/gpu", Value: util.StringPtr("present"), Effect: "NoSchedule"}},
+		},
+	}
+}
+
 func fixAWSGardenerInput() *gqlschema.AWSProviderConfigInput {
 	return &gqlschema.AWSProviderConfigInput{
 		AwsZones: []*gqlschema.AWSZoneInput{
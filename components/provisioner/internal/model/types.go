@@ -27,6 +27,7 @@ const (
 	DeprovisionNoInstall OperationType = "DEPROVISION_NO_INSTALL"
 	ReconnectRuntime     OperationType = "RECONNECT_RUNTIME"
 	Hibernate            OperationType = "HIBERNATE"
+	WakeUp               OperationType = "WAKE_UP"
 )
 
 type OperationStage string
@@ -53,6 +54,9 @@ const (
 
 	WaitForHibernation OperationStage = "WaitForHibernation"
 
+	WaitForWakeUp     OperationStage = "WaitForWakeUp"
+	RefreshKubeconfig OperationStage = "RefreshKubeconfig"
+
 	FinishedStage OperationStage = "Finished"
 )
 
@@ -70,6 +74,12 @@ type Cluster struct {
 	KymaConfig    *KymaConfig    `db:"-"`
 }
 
+// ClusterWithLastOperation is a Runtime returned by the Runtimes list, LastOperation is nil if the Runtime has no operations
+type ClusterWithLastOperation struct {
+	Cluster
+	LastOperation *Operation
+}
+
 type LastError struct {
 	ErrMessage string
 	Reason     string
@@ -108,6 +118,33 @@ type OperationsCount struct {
 	Count map[OperationType]int
 }
 
+// ListCursor points at the last item of the previously returned page
+type ListCursor struct {
+	Timestamp time.Time
+	ID        string
+}
+
+type RuntimeFilter struct {
+	Tenant         string
+	SubAccountID   *string
+	ShootName      *string
+	Provider       *string
+	Region         *string
+	IncludeDeleted bool
+	Limit          int
+	After          *ListCursor
+}
+
+type OperationFilter struct {
+	Tenant    string
+	RuntimeID *string
+	Type      *OperationType
+	State     *OperationState
+	Since     *time.Time
+	Limit     int
+	After     *ListCursor
+}
+
 type HibernationStatus struct {
 	Hibernated          bool
 	HibernationPossible bool
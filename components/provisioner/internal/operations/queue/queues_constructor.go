@@ -16,6 +16,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/provisioning"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/shootupgrade"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/upgrade"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/wakeup"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/runtime"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util/k8s"
@@ -58,6 +59,11 @@ type HibernationTimeouts struct {
 	WaitingForClusterHibernation time.Duration `envconfig:"default=60m"`
 }
 
+type WakeUpTimeouts struct {
+	WaitingForClusterWakeUp time.Duration `envconfig:"default=60m"`
+	KubeconfigRefresh       time.Duration `envconfig:"default=5m"`
+}
+
 func CreateProvisioningQueue(
 	timeouts ProvisioningTimeouts,
 	factory dbsession.Factory,
@@ -266,3 +272,29 @@ func CreateHibernationQueue(
 
 	return NewQueue(hibernateClusterExecutor)
 }
+
+func CreateWakeUpQueue(
+	timeouts WakeUpTimeouts,
+	factory dbsession.Factory,
+	directorClient director.DirectorClient,
+	shootClient gardener_apis.ShootInterface,
+	secretsClient v1core.SecretInterface) OperationQueue {
+
+	refreshKubeconfig := wakeup.NewRefreshKubeconfigStep(factory.NewWriteSession(), gardener.NewKubeconfigProvider(secretsClient), model.FinishedStage, timeouts.KubeconfigRefresh)
+	waitForWakeUp := wakeup.NewWaitForWakeUpStep(shootClient, refreshKubeconfig.Name(), timeouts.WaitingForClusterWakeUp)
+
+	wakeUpSteps := map[model.OperationStage]operations.Step{
+		model.WaitForWakeUp:     waitForWakeUp,
+		model.RefreshKubeconfig: refreshKubeconfig,
+	}
+
+	wakeUpClusterExecutor := operations.NewExecutor(
+		factory.NewReadWriteSession(),
+		model.WakeUp,
+		wakeUpSteps,
+		failure.NewNoopFailureHandler(),
+		directorClient,
+	)
+
+	return NewQueue(wakeUpClusterExecutor)
+}
@@ -0,0 +1,55 @@
+package wakeup
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockery -name=KubeconfigProvider
+type KubeconfigProvider interface {
+	FetchRaw(shootName string) ([]byte, error)
+}
+
+// RefreshKubeconfig stores the current kubeconfig of the woken up cluster, the certificates may have been rotated meanwhile
+type RefreshKubeconfig struct {
+	dbSession          dbsession.WriteSession
+	kubeconfigProvider KubeconfigProvider
+	nextStep           model.OperationStage
+	timeLimit          time.Duration
+}
+
+func NewRefreshKubeconfigStep(dbSession dbsession.WriteSession, kubeconfigProvider KubeconfigProvider, nextStep model.OperationStage, timeLimit time.Duration) *RefreshKubeconfig {
+	return &RefreshKubeconfig{
+		dbSession:          dbSession,
+		kubeconfigProvider: kubeconfigProvider,
+		nextStep:           nextStep,
+		timeLimit:          timeLimit,
+	}
+}
+
+func (s *RefreshKubeconfig) Name() model.OperationStage {
+	return model.RefreshKubeconfig
+}
+
+func (s *RefreshKubeconfig) TimeLimit() time.Duration {
+	return s.timeLimit
+}
+
+func (s *RefreshKubeconfig) Run(cluster model.Cluster, _ model.Operation, log logrus.FieldLogger) (operations.StageResult, error) {
+	kubeconfig, err := s.kubeconfigProvider.FetchRaw(cluster.ClusterConfig.Name)
+	if err != nil {
+		return operations.StageResult{}, err
+	}
+
+	dberr := s.dbSession.UpdateKubeconfig(cluster.ID, string(kubeconfig))
+	if dberr != nil {
+		return operations.StageResult{}, dberr
+	}
+	log.Debugf("Kubeconfig of cluster: %s refreshed", cluster.ID)
+
+	return operations.StageResult{Stage: s.nextStep, Delay: 0}, nil
+}
@@ -0,0 +1,89 @@
+package wakeup
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/wakeup/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	dbMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshKubeconfig(t *testing.T) {
+
+	const (
+		nextStageName = model.FinishedStage
+		clusterName   = "test"
+		runtimeID     = "runtimeID"
+	)
+
+	cluster := model.Cluster{
+		ID: runtimeID,
+		ClusterConfig: model.GardenerConfig{
+			Name: clusterName,
+		},
+	}
+
+	t.Run("should store the current kubeconfig and go to the next stage", func(t *testing.T) {
+		// given
+		dbSession := &dbMocks.WriteSession{}
+		kubeconfigProvider := &mocks.KubeconfigProvider{}
+
+		kubeconfigProvider.On("FetchRaw", clusterName).Return([]byte("kubeconfig"), nil)
+		dbSession.On("UpdateKubeconfig", runtimeID, "kubeconfig").Return(nil)
+
+		refreshKubeconfigStep := NewRefreshKubeconfigStep(dbSession, kubeconfigProvider, nextStageName, time.Minute)
+
+		// when
+		result, err := refreshKubeconfigStep.Run(cluster, model.Operation{}, logrus.New())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, nextStageName, result.Stage)
+		assert.Equal(t, time.Duration(0), result.Delay)
+		dbSession.AssertExpectations(t)
+		kubeconfigProvider.AssertExpectations(t)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		mockFunc    func(dbSession *dbMocks.WriteSession, kubeconfigProvider *mocks.KubeconfigProvider)
+	}{
+		{
+			description: "should return error if failed to fetch kubeconfig",
+			mockFunc: func(dbSession *dbMocks.WriteSession, kubeconfigProvider *mocks.KubeconfigProvider) {
+				kubeconfigProvider.On("FetchRaw", clusterName).Return(nil, errors.New("some error"))
+			},
+		},
+		{
+			description: "should return error if failed to update kubeconfig in database",
+			mockFunc: func(dbSession *dbMocks.WriteSession, kubeconfigProvider *mocks.KubeconfigProvider) {
+				kubeconfigProvider.On("FetchRaw", clusterName).Return([]byte("kubeconfig"), nil)
+				dbSession.On("UpdateKubeconfig", runtimeID, "kubeconfig").Return(dberrors.Internal("some error"))
+			},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			dbSession := &dbMocks.WriteSession{}
+			kubeconfigProvider := &mocks.KubeconfigProvider{}
+
+			testCase.mockFunc(dbSession, kubeconfigProvider)
+
+			refreshKubeconfigStep := NewRefreshKubeconfigStep(dbSession, kubeconfigProvider, nextStageName, time.Minute)
+
+			// when
+			_, err := refreshKubeconfigStep.Run(cluster, model.Operation{}, logrus.New())
+
+			// then
+			require.Error(t, err)
+			dbSession.AssertExpectations(t)
+			kubeconfigProvider.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package provisioning
 
 import (
+	"time"
+
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
@@ -8,6 +10,8 @@ import (
 type GraphQLConverter interface {
 	RuntimeStatusToGraphQLStatus(status model.RuntimeStatus) *gqlschema.RuntimeStatus
 	OperationStatusToGQLOperationStatus(operation model.Operation) *gqlschema.OperationStatus
+	ClusterToGraphQLRuntime(cluster model.Cluster, lastOperation *model.Operation) *gqlschema.Runtime
+	OperationToGraphQLOperation(operation model.Operation) *gqlschema.Operation
 }
 
 func NewGraphQLConverter() GraphQLConverter {
@@ -43,6 +47,45 @@ func (c graphQLConverter) OperationStatusToGQLOperationStatus(operation model.Op
 	}
 }
 
+func (c graphQLConverter) ClusterToGraphQLRuntime(cluster model.Cluster, lastOperation *model.Operation) *gqlschema.Runtime {
+	runtime := &gqlschema.Runtime{
+		ID:                cluster.ID,
+		Tenant:            cluster.Tenant,
+		SubAccountID:      cluster.SubAccountId,
+		CreationTimestamp: cluster.CreationTimestamp.UTC().Format(time.RFC3339),
+		Deleted:           cluster.Deleted,
+		ClusterConfig:     c.gardenerConfigToGraphQLConfig(cluster.ClusterConfig),
+	}
+	if lastOperation != nil {
+		runtime.LastOperationStatus = c.OperationStatusToGQLOperationStatus(*lastOperation)
+	}
+	return runtime
+}
+
+func (c graphQLConverter) OperationToGraphQLOperation(operation model.Operation) *gqlschema.Operation {
+	var endTimestamp *string
+	if operation.EndTimestamp != nil {
+		formatted := operation.EndTimestamp.UTC().Format(time.RFC3339)
+		endTimestamp = &formatted
+	}
+
+	return &gqlschema.Operation{
+		ID:             operation.ID,
+		Operation:      c.operationTypeToGraphQLType(operation.Type),
+		State:          c.operationStateToGraphQLState(operation.State),
+		Stage:          string(operation.Stage),
+		Message:        &operation.Message,
+		RuntimeID:      operation.ClusterID,
+		StartTimestamp: operation.StartTimestamp.UTC().Format(time.RFC3339),
+		EndTimestamp:   endTimestamp,
+		LastError: &gqlschema.LastError{
+			ErrMessage: operation.ErrMessage,
+			Reason:     operation.Reason,
+			Component:  operation.Component,
+		},
+	}
+}
+
 func (c graphQLConverter) runtimeConnectionStatusToGraphQLStatus(status model.RuntimeAgentConnectionStatus) *gqlschema.RuntimeConnectionStatus {
 	return &gqlschema.RuntimeConnectionStatus{Status: c.runtimeAgentConnectionStatusToGraphQLStatus(status)}
 }
@@ -105,6 +148,8 @@ func (c graphQLConverter) gardenerConfigToGraphQLConfig(config model.GardenerCon
 		DNSConfig:                           c.dnsConfigToGraphQLConfig(config.DNSConfig),
 		ExposureClassName:                   config.ExposureClassName,
 		ShootNetworkingFilterDisabled:       config.ShootNetworkingFilterDisabled,
+		HibernationSchedules:                c.hibernationSchedulesToGraphQLSchedules(config.HibernationSchedules),
+		WorkerPools:                         c.workerPoolsToGraphQLWorkerPools(config.WorkerPools),
 	}
 }
 
@@ -178,6 +223,8 @@ func (c graphQLConverter) operationTypeToGraphQLType(operationType model.Operati
 		return gqlschema.OperationTypeReconnectRuntime
 	case model.Hibernate:
 		return gqlschema.OperationTypeHibernate
+	case model.WakeUp:
+		return gqlschema.OperationTypeWakeUp
 	default:
 		return ""
 	}
@@ -238,3 +285,57 @@ func (c graphQLConverter) dnsConfigToGraphQLConfig(config *model.DNSConfig) *gql
 
 	return &gqlConfig
 }
+
+func (c graphQLConverter) hibernationSchedulesToGraphQLSchedules(schedules []model.HibernationSchedule) []*gqlschema.HibernationSchedule {
+	if len(schedules) == 0 {
+		return nil
+	}
+
+	gqlSchedules := make([]*gqlschema.HibernationSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		gqlSchedules = append(gqlSchedules, &gqlschema.HibernationSchedule{
+			Start:    schedule.Start,
+			End:      schedule.End,
+			Location: schedule.Location,
+		})
+	}
+
+	return gqlSchedules
+}
+
+func (c graphQLConverter) workerPoolsToGraphQLWorkerPools(pools []model.WorkerPool) []*gqlschema.WorkerPool {
+	if len(pools) == 0 {
+		return nil
+	}
+
+	gqlPools := make([]*gqlschema.WorkerPool, 0, len(pools))
+	for _, pool := range pools {
+		gqlPool := &gqlschema.WorkerPool{
+			Name:           pool.Name,
+			MachineType:    pool.MachineType,
+			AutoScalerMin:  pool.AutoScalerMin,
+			AutoScalerMax:  pool.AutoScalerMax,
+			MaxSurge:       pool.MaxSurge,
+			MaxUnavailable: pool.MaxUnavailable,
+			VolumeSizeGb:   pool.VolumeSizeGB,
+			DiskType:       pool.DiskType,
+			Zones:          pool.Zones,
+		}
+		if len(pool.Labels) != 0 {
+			gqlPool.Labels = gqlschema.Labels{}
+			for key, value := range pool.Labels {
+				gqlPool.Labels[key] = value
+			}
+		}
+		for _, taint := range pool.Taints {
+			gqlPool.Taints = append(gqlPool.Taints, &gqlschema.Taint{
+				Key:    taint.Key,
+				Value:  taint.Value,
+				Effect: taint.Effect,
+			})
+		}
+		gqlPools = append(gqlPools, gqlPool)
+	}
+
+	return gqlPools
+}
@@ -2,6 +2,7 @@ package provisioning
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -60,6 +61,70 @@ func TestOperationStatusToGQLOperationStatus(t *testing.T) {
 	})
 }
 
+func TestOperationToGraphQLOperation(t *testing.T) {
+
+	graphQLConverter := NewGraphQLConverter()
+
+	t.Run("Should create proper operation struct", func(t *testing.T) {
+		//given
+		endTimestamp := time.Date(2026, 10, 17, 12, 30, 0, 0, time.UTC)
+		operation := model.Operation{
+			ID:             "5f6e3ab6-d803-430a-8fac-29c9c9b4485a",
+			Type:           model.Hibernate,
+			State:          model.Failed,
+			Stage:          model.FinishedStage,
+			Message:        "Some message",
+			ClusterID:      "6af76034-272a-42be-ac39-30e075f515a3",
+			StartTimestamp: time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+			EndTimestamp:   &endTimestamp,
+			LastError: model.LastError{
+				ErrMessage: "error msg",
+				Reason:     "ERR_INFRA_QUOTA_EXCEEDED",
+				Component:  "gardener",
+			},
+		}
+
+		expectedOperation := &gqlschema.Operation{
+			ID:             "5f6e3ab6-d803-430a-8fac-29c9c9b4485a",
+			Operation:      gqlschema.OperationTypeHibernate,
+			State:          gqlschema.OperationStateFailed,
+			Stage:          string(model.FinishedStage),
+			Message:        util.StringPtr("Some message"),
+			RuntimeID:      "6af76034-272a-42be-ac39-30e075f515a3",
+			StartTimestamp: "2026-10-17T12:00:00Z",
+			EndTimestamp:   util.StringPtr("2026-10-17T12:30:00Z"),
+			LastError: &gqlschema.LastError{
+				ErrMessage: "error msg",
+				Reason:     "ERR_INFRA_QUOTA_EXCEEDED",
+				Component:  "gardener",
+			},
+		}
+
+		//when
+		gqlOperation := graphQLConverter.OperationToGraphQLOperation(operation)
+
+		//then
+		assert.Equal(t, expectedOperation, gqlOperation)
+	})
+
+	t.Run("Should leave end timestamp empty for operation in progress", func(t *testing.T) {
+		//given
+		operation := model.Operation{
+			ID:             "5f6e3ab6-d803-430a-8fac-29c9c9b4485a",
+			Type:           model.Provision,
+			State:          model.InProgress,
+			StartTimestamp: time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+		}
+
+		//when
+		gqlOperation := graphQLConverter.OperationToGraphQLOperation(operation)
+
+		//then
+		assert.Equal(t, gqlschema.OperationStateInProgress, gqlOperation.State)
+		assert.Nil(t, gqlOperation.EndTimestamp)
+	})
+}
+
 func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 
 	graphQLConverter := NewGraphQLConverter()
@@ -135,6 +200,8 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 					DNSConfig:                           dnsConfig(),
 					ExposureClassName:                   &exposureClassName,
 					ShootNetworkingFilterDisabled:       &shootNetworkingFilterDisabled,
+					HibernationSchedules:                fixHibernationSchedules(),
+					WorkerPools:                         fixWorkerPools(),
 				},
 				Kubeconfig: &kubeconfig,
 				KymaConfig: fixKymaConfig(nil),
@@ -211,6 +278,20 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 					},
 					ExposureClassName:             &exposureClassName,
 					ShootNetworkingFilterDisabled: &shootNetworkingFilterDisabled,
+					HibernationSchedules: []*gqlschema.HibernationSchedule{
+						{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 08 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+					},
+					WorkerPools: []*gqlschema.WorkerPool{
+						{
+							Name:          "gpu",
+							MachineType:   "n1-highmem-8",
+							AutoScalerMin: 0,
+							AutoScalerMax: 2,
+							Zones:         []string{"europe-west1-b"},
+							Labels:        gqlschema.Labels{"accelerator": "gpu"},
+							Taints:        []*gqlschema.Taint{{Key: "nvidia.com/gpu", Value: util.StringPtr("present"), Effect: "NoSchedule"}},
+						},
+					},
 				},
 				KymaConfig: fixKymaGraphQLConfig(nil),
 				Kubeconfig: &kubeconfig,
@@ -1,6 +1,8 @@
 package provisioning
 
 import (
+	"fmt"
+
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/installation/release"
@@ -127,6 +129,8 @@ func (c converter) gardenerConfigFromInput(runtimeID string, input *gqlschema.Ga
 		DNSConfig:                           dnsConfigFromInput(input.DNSConfig),
 		ExposureClassName:                   input.ExposureClassName,
 		ShootNetworkingFilterDisabled:       input.ShootNetworkingFilterDisabled,
+		HibernationSchedules:                hibernationSchedulesFromInput(input.HibernationSchedules),
+		WorkerPools:                         workerPoolsFromInput(input.WorkerPools),
 	}, nil
 }
 
@@ -165,6 +169,60 @@ func dnsConfigFromInput(input *gqlschema.DNSConfigInput) *model.DNSConfig {
 	return nil
 }
 
+func hibernationSchedulesFromInput(input []*gqlschema.HibernationScheduleInput) []model.HibernationSchedule {
+	if input == nil {
+		return nil
+	}
+
+	schedules := make([]model.HibernationSchedule, 0, len(input))
+	for _, v := range input {
+		schedules = append(schedules, model.HibernationSchedule{
+			Start:    v.Start,
+			End:      v.End,
+			Location: v.Location,
+		})
+	}
+
+	return schedules
+}
+
+func workerPoolsFromInput(input []*gqlschema.WorkerPoolInput) []model.WorkerPool {
+	if input == nil {
+		return nil
+	}
+
+	pools := make([]model.WorkerPool, 0, len(input))
+	for _, v := range input {
+		pool := model.WorkerPool{
+			Name:           v.Name,
+			MachineType:    v.MachineType,
+			AutoScalerMin:  v.AutoScalerMin,
+			AutoScalerMax:  v.AutoScalerMax,
+			MaxSurge:       v.MaxSurge,
+			MaxUnavailable: v.MaxUnavailable,
+			VolumeSizeGB:   v.VolumeSizeGb,
+			DiskType:       v.DiskType,
+			Zones:          v.Zones,
+		}
+		if len(v.Labels) != 0 {
+			pool.Labels = make(map[string]string, len(v.Labels))
+			for key, value := range v.Labels {
+				pool.Labels[key] = fmt.Sprint(value)
+			}
+		}
+		for _, taint := range v.Taints {
+			pool.Taints = append(pool.Taints, model.Taint{
+				Key:    taint.Key,
+				Value:  taint.Value,
+				Effect: taint.Effect,
+			})
+		}
+		pools = append(pools, pool)
+	}
+
+	return pools
+}
+
 func (c converter) shouldAllowPrivilegedContainers(inputAllowPrivilegedContainers *bool, tillerYaml string) bool {
 	if c.forceAllowPrivilegedContainers {
 		return true
@@ -216,9 +274,25 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		OIDCConfig:                          oidcConfigFromInput(input.OidcConfig),
 		ExposureClassName:                   util.DefaultStrIfNil(input.ExposureClassName, config.ExposureClassName),
 		ShootNetworkingFilterDisabled:       util.DefaultBoolIfNil(input.ShootNetworkingFilterDisabled, config.ShootNetworkingFilterDisabled),
+		HibernationSchedules:                defaultHibernationSchedulesIfNil(input.HibernationSchedules, config.HibernationSchedules),
+		WorkerPools:                         defaultWorkerPoolsIfNil(input.WorkerPools, config.WorkerPools),
 	}, nil
 }
 
+func defaultHibernationSchedulesIfNil(input []*gqlschema.HibernationScheduleInput, defaultSchedules []model.HibernationSchedule) []model.HibernationSchedule {
+	if input == nil {
+		return defaultSchedules
+	}
+	return hibernationSchedulesFromInput(input)
+}
+
+func defaultWorkerPoolsIfNil(input []*gqlschema.WorkerPoolInput, defaultPools []model.WorkerPool) []model.WorkerPool {
+	if input == nil {
+		return defaultPools
+	}
+	return workerPoolsFromInput(input)
+}
+
 func (c converter) providerSpecificConfigFromInput(input *gqlschema.ProviderSpecificInput) (model.GardenerProviderConfig, apperrors.AppError) {
 	if input == nil {
 		return nil, apperrors.Internal("provider config not specified")
@@ -74,6 +74,20 @@ func Test_ProvisioningInputToCluster(t *testing.T) {
 				DNSConfig:                     dnsInput(),
 				ExposureClassName:             util.StringPtr("internet"),
 				ShootNetworkingFilterDisabled: util.BoolPtr(true),
+				HibernationSchedules: []*gqlschema.HibernationScheduleInput{
+					{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 08 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+				},
+				WorkerPools: []*gqlschema.WorkerPoolInput{
+					{
+						Name:          "gpu",
+						MachineType:   "n1-highmem-8",
+						AutoScalerMin: 0,
+						AutoScalerMax: 2,
+						Zones:         []string{"europe-west1-b"},
+						Labels:        gqlschema.Labels{"accelerator": "gpu"},
+						Taints:        []*gqlschema.TaintInput{{Key: "nvidia.com/gpu", Value: util.StringPtr("present"), Effect: "NoSchedule"}},
+					},
+				},
 			},
 			Administrators: []string{administrator},
 		},
@@ -114,6 +128,8 @@ func Test_ProvisioningInputToCluster(t *testing.T) {
 			DNSConfig:                           dnsConfig(),
 			ExposureClassName:                   util.StringPtr("internet"),
 			ShootNetworkingFilterDisabled:       util.BoolPtr(true),
+			HibernationSchedules:                fixHibernationSchedules(),
+			WorkerPools:                         fixWorkerPools(),
 		},
 		Kubeconfig:     nil,
 		KymaConfig:     fixKymaConfig(&modelProductionProfile),
@@ -897,6 +913,7 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 				OIDCConfig:                    oidcConfig(),
 				ExposureClassName:             util.StringPtr("internet"),
 				ShootNetworkingFilterDisabled: util.BoolPtr(false),
+				HibernationSchedules:          fixHibernationSchedules(),
 			},
 			upgradedConfig: model.GardenerConfig{
 				KubernetesVersion:             "1.20.7",
@@ -913,6 +930,90 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 				OIDCConfig:                    upgradedOidcConfig(),
 				ExposureClassName:             util.StringPtr("internet"),
 				ShootNetworkingFilterDisabled: util.BoolPtr(false),
+				HibernationSchedules:          fixHibernationSchedules(),
+			},
+		},
+		{
+			description: "shoot upgrade with hibernation schedules",
+			upgradeInput: func(input gqlschema.UpgradeShootInput) gqlschema.UpgradeShootInput {
+				input.GardenerConfig.HibernationSchedules = []*gqlschema.HibernationScheduleInput{
+					{Start: util.StringPtr("00 18 * * 5"), End: util.StringPtr("00 06 * * 1"), Location: util.StringPtr("America/New_York")},
+				}
+				return input
+			}(newUpgradeShootInputWithNilValues()),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion:    "1.20.7",
+				MachineType:          "1",
+				AutoScalerMin:        1,
+				AutoScalerMax:        2,
+				MaxSurge:             1,
+				MaxUnavailable:       1,
+				HibernationSchedules: fixHibernationSchedules(),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AutoScalerMin:     1,
+				AutoScalerMax:     2,
+				MaxSurge:          1,
+				MaxUnavailable:    1,
+				OIDCConfig:        upgradedOidcConfig(),
+				HibernationSchedules: []model.HibernationSchedule{
+					{Start: util.StringPtr("00 18 * * 5"), End: util.StringPtr("00 06 * * 1"), Location: util.StringPtr("America/New_York")},
+				},
+			},
+		},
+		{
+			description: "shoot upgrade with worker pools",
+			upgradeInput: func(input gqlschema.UpgradeShootInput) gqlschema.UpgradeShootInput {
+				input.GardenerConfig.WorkerPools = []*gqlschema.WorkerPoolInput{
+					{Name: "memory", MachineType: "n1-ultramem-40", AutoScalerMin: 1, AutoScalerMax: 3, MaxSurge: util.IntPtr(2)},
+				}
+				return input
+			}(newUpgradeShootInputWithNilValues()),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AutoScalerMin:     1,
+				AutoScalerMax:     2,
+				MaxSurge:          1,
+				MaxUnavailable:    1,
+				WorkerPools:       fixWorkerPools(),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AutoScalerMin:     1,
+				AutoScalerMax:     2,
+				MaxSurge:          1,
+				MaxUnavailable:    1,
+				OIDCConfig:        upgradedOidcConfig(),
+				WorkerPools: []model.WorkerPool{
+					{Name: "memory", MachineType: "n1-ultramem-40", AutoScalerMin: 1, AutoScalerMax: 3, MaxSurge: util.IntPtr(2)},
+				},
+			},
+		},
+		{
+			description:  "shoot upgrade keeping worker pools",
+			upgradeInput: newUpgradeShootInputWithNilValues(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AutoScalerMin:     1,
+				AutoScalerMax:     2,
+				MaxSurge:          1,
+				MaxUnavailable:    1,
+				WorkerPools:       fixWorkerPools(),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AutoScalerMin:     1,
+				AutoScalerMax:     2,
+				MaxSurge:          1,
+				MaxUnavailable:    1,
+				OIDCConfig:        upgradedOidcConfig(),
+				WorkerPools:       fixWorkerPools(),
 			},
 		},
 	}
@@ -1140,3 +1241,23 @@ func fixKymaReleaseWithoutTiller() model.Release {
 		InstallerYAML: "installer yaml",
 	}
 }
+
+func fixHibernationSchedules() []model.HibernationSchedule {
+	return []model.HibernationSchedule{
+		{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 08 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+	}
+}
+
+func fixWorkerPools() []model.WorkerPool {
+	return []model.WorkerPool{
+		{
+			Name:          "gpu",
+			MachineType:   "n1-highmem-8",
+			AutoScalerMin: 0,
+			AutoScalerMax: 2,
+			Zones:         []string{"europe-west1-b"},
+			Labels:        map[string]string{"accelerator": "gpu"},
+			Taints:        []model.Taint{{Key: "nvidia.com/gpu", Value: util.StringPtr("present"), Effect: "NoSchedule"}},
+		},
+	}
+}
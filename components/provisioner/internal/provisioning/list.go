@@ -0,0 +1,168 @@
+package provisioning
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+
+	cursorSeparator = "|"
+)
+
+func runtimeFilterFromInput(tenant string, input *gqlschema.RuntimesFilter, first *int, after *string) (model.RuntimeFilter, apperrors.AppError) {
+	filter := model.RuntimeFilter{Tenant: tenant}
+
+	pageSize, err := pageSizeFromInput(first)
+	if err != nil {
+		return model.RuntimeFilter{}, err
+	}
+	// one additional item is fetched to find out if there is a next page
+	filter.Limit = pageSize + 1
+
+	filter.After, err = decodeListCursor(after)
+	if err != nil {
+		return model.RuntimeFilter{}, err
+	}
+
+	if input != nil {
+		filter.SubAccountID = input.SubAccountID
+		filter.ShootName = input.ShootName
+		filter.Provider = input.Provider
+		filter.Region = input.Region
+		filter.IncludeDeleted = util.UnwrapBoolOrDefault(input.IncludeDeleted, false)
+	}
+
+	return filter, nil
+}
+
+func operationFilterFromInput(tenant string, runtimeID *string, operationType *gqlschema.OperationType, state *gqlschema.OperationState, since *string, first *int, after *string) (model.OperationFilter, apperrors.AppError) {
+	filter := model.OperationFilter{
+		Tenant:    tenant,
+		RuntimeID: runtimeID,
+	}
+
+	pageSize, err := pageSizeFromInput(first)
+	if err != nil {
+		return model.OperationFilter{}, err
+	}
+	// one additional item is fetched to find out if there is a next page
+	filter.Limit = pageSize + 1
+
+	filter.After, err = decodeListCursor(after)
+	if err != nil {
+		return model.OperationFilter{}, err
+	}
+
+	if operationType != nil {
+		modelType, err := operationTypeFromGraphQLType(*operationType)
+		if err != nil {
+			return model.OperationFilter{}, err
+		}
+		filter.Type = &modelType
+	}
+
+	if state != nil {
+		modelState, err := operationStateFromGraphQLState(*state)
+		if err != nil {
+			return model.OperationFilter{}, err
+		}
+		filter.State = &modelState
+	}
+
+	if since != nil {
+		sinceTime, parseErr := time.Parse(time.RFC3339, *since)
+		if parseErr != nil {
+			return model.OperationFilter{}, apperrors.BadRequest("since must be an RFC 3339 timestamp: %s", parseErr.Error())
+		}
+		filter.Since = &sinceTime
+	}
+
+	return filter, nil
+}
+
+func pageSizeFromInput(first *int) (int, apperrors.AppError) {
+	if first == nil {
+		return defaultPageSize, nil
+	}
+	if *first < 1 || *first > maxPageSize {
+		return 0, apperrors.BadRequest("first must be between 1 and %d", maxPageSize)
+	}
+	return *first, nil
+}
+
+func encodeListCursor(timestamp time.Time, id string) string {
+	return base64.URLEncoding.EncodeToString([]byte(timestamp.UTC().Format(time.RFC3339Nano) + cursorSeparator + id))
+}
+
+func decodeListCursor(cursor *string) (*model.ListCursor, apperrors.AppError) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	decoded, err := base64.URLEncoding.DecodeString(*cursor)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid cursor %s", *cursor)
+	}
+
+	parts := strings.SplitN(string(decoded), cursorSeparator, 2)
+	if len(parts) != 2 {
+		return nil, apperrors.BadRequest("invalid cursor %s", *cursor)
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid cursor %s", *cursor)
+	}
+
+	return &model.ListCursor{
+		Timestamp: timestamp,
+		ID:        parts[1],
+	}, nil
+}
+
+func operationTypeFromGraphQLType(operationType gqlschema.OperationType) (model.OperationType, apperrors.AppError) {
+	switch operationType {
+	case gqlschema.OperationTypeProvision:
+		return model.Provision, nil
+	case gqlschema.OperationTypeProvisionNoInstall:
+		return model.ProvisionNoInstall, nil
+	case gqlschema.OperationTypeDeprovision:
+		return model.Deprovision, nil
+	case gqlschema.OperationTypeDeprovisionNoInstall:
+		return model.DeprovisionNoInstall, nil
+	case gqlschema.OperationTypeUpgrade:
+		return model.Upgrade, nil
+	case gqlschema.OperationTypeUpgradeShoot:
+		return model.UpgradeShoot, nil
+	case gqlschema.OperationTypeReconnectRuntime:
+		return model.ReconnectRuntime, nil
+	case gqlschema.OperationTypeHibernate:
+		return model.Hibernate, nil
+	case gqlschema.OperationTypeWakeUp:
+		return model.WakeUp, nil
+	default:
+		return "", apperrors.BadRequest("unknown operation type %s", operationType)
+	}
+}
+
+func operationStateFromGraphQLState(state gqlschema.OperationState) (model.OperationState, apperrors.AppError) {
+	switch state {
+	case gqlschema.OperationStateInProgress:
+		return model.InProgress, nil
+	case gqlschema.OperationStateSucceeded:
+		return model.Succeeded, nil
+	case gqlschema.OperationStateFailed:
+		return model.Failed, nil
+	default:
+		return "", apperrors.BadRequest("filtering operations by %s state is not supported", state)
+	}
+}
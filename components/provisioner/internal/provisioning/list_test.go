@@ -0,0 +1,48 @@
+package provisioning
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+)
+
+func TestListCursor(t *testing.T) {
+	t.Run("Should decode encoded cursor", func(t *testing.T) {
+		// given
+		timestamp := time.Date(2026, 10, 17, 12, 0, 0, 123456000, time.UTC)
+		cursor := encodeListCursor(timestamp, runtimeID)
+
+		// when
+		decoded, err := decodeListCursor(&cursor)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, timestamp.Equal(decoded.Timestamp))
+		assert.Equal(t, runtimeID, decoded.ID)
+	})
+
+	t.Run("Should return nil when cursor is not provided", func(t *testing.T) {
+		// when
+		decoded, err := decodeListCursor(nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, decoded)
+	})
+
+	for _, cursor := range []string{"not base64!", "bm8tc2VwYXJhdG9y", "bm90LWEtdGltZXxpZA=="} {
+		t.Run("Should return error for invalid cursor "+cursor, func(t *testing.T) {
+			// when
+			_, err := decodeListCursor(util.StringPtr(cursor))
+
+			// then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		})
+	}
+}
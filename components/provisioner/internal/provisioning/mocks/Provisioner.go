@@ -107,3 +107,19 @@ func (_m *Provisioner) UpgradeCluster(clusterID string, upgradeConfig model.Gard
 
 	return r0
 }
+
+// WakeUpCluster provides a mock function with given fields: clusterID, gardenerConfig
+func (_m *Provisioner) WakeUpCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError {
+	ret := _m.Called(clusterID, gardenerConfig)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig) apperrors.AppError); ok {
+		r0 = rf(clusterID, gardenerConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
@@ -62,6 +62,56 @@ func (_m *Service) HibernateCluster(clusterID string) (*gqlschema.OperationStatu
 	return r0, r1
 }
 
+// ListOperations provides a mock function with given fields: tenant, runtimeID, operationType, state, since, first, after
+func (_m *Service) ListOperations(tenant string, runtimeID *string, operationType *gqlschema.OperationType, state *gqlschema.OperationState, since *string, first *int, after *string) (*gqlschema.OperationConnection, apperrors.AppError) {
+	ret := _m.Called(tenant, runtimeID, operationType, state, since, first, after)
+
+	var r0 *gqlschema.OperationConnection
+	if rf, ok := ret.Get(0).(func(string, *string, *gqlschema.OperationType, *gqlschema.OperationState, *string, *int, *string) *gqlschema.OperationConnection); ok {
+		r0 = rf(tenant, runtimeID, operationType, state, since, first, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationConnection)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(string, *string, *gqlschema.OperationType, *gqlschema.OperationState, *string, *int, *string) apperrors.AppError); ok {
+		r1 = rf(tenant, runtimeID, operationType, state, since, first, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListRuntimes provides a mock function with given fields: tenant, filter, first, after
+func (_m *Service) ListRuntimes(tenant string, filter *gqlschema.RuntimesFilter, first *int, after *string) (*gqlschema.RuntimeConnection, apperrors.AppError) {
+	ret := _m.Called(tenant, filter, first, after)
+
+	var r0 *gqlschema.RuntimeConnection
+	if rf, ok := ret.Get(0).(func(string, *gqlschema.RuntimesFilter, *int, *string) *gqlschema.RuntimeConnection); ok {
+		r0 = rf(tenant, filter, first, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.RuntimeConnection)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(string, *gqlschema.RuntimesFilter, *int, *string) apperrors.AppError); ok {
+		r1 = rf(tenant, filter, first, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ProvisionRuntime provides a mock function with given fields: config, tenant, subAccount
 func (_m *Service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant string, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
 	ret := _m.Called(config, tenant, subAccount)
@@ -234,3 +284,28 @@ func (_m *Service) UpgradeRuntime(id string, config gqlschema.UpgradeRuntimeInpu
 
 	return r0, r1
 }
+
+// WakeUpCluster provides a mock function with given fields: clusterID
+func (_m *Service) WakeUpCluster(clusterID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(clusterID)
+
+	var r0 *gqlschema.OperationStatus
+	if rf, ok := ret.Get(0).(func(string) *gqlschema.OperationStatus); ok {
+		r0 = rf(clusterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(clusterID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
@@ -27,6 +27,8 @@ type ReadSession interface {
 	GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, dberrors.Error)
 	GetTenantForOperation(operationID string) (string, dberrors.Error)
 	InProgressOperationsCount() (model.OperationsCount, dberrors.Error)
+	ListClusters(filter model.RuntimeFilter) ([]model.ClusterWithLastOperation, int, dberrors.Error)
+	ListOperations(filter model.OperationFilter) ([]model.Operation, int, dberrors.Error)
 	//TODO:Remove after schema migration
 	GetProviderSpecificConfigsByProvider(provider string) ([]ProviderData, dberrors.Error)
 	GetUpdatedProviderSpecificConfigByID(id string) (string, dberrors.Error)
@@ -248,6 +248,38 @@ func (_m *ReadSession) InProgressOperationsCount() (model.OperationsCount, dberr
 	return r0, r1
 }
 
+// ListClusters provides a mock function with given fields: filter
+func (_m *ReadSession) ListClusters(filter model.RuntimeFilter) ([]model.ClusterWithLastOperation, int, dberrors.Error) {
+	ret := _m.Called(filter)
+
+	var r0 []model.ClusterWithLastOperation
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter) []model.ClusterWithLastOperation); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ClusterWithLastOperation)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(model.RuntimeFilter) int); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 dberrors.Error
+	if rf, ok := ret.Get(2).(func(model.RuntimeFilter) dberrors.Error); ok {
+		r2 = rf(filter)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(dberrors.Error)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // ListInProgressOperations provides a mock function with given fields:
 func (_m *ReadSession) ListInProgressOperations() ([]model.Operation, dberrors.Error) {
 	ret := _m.Called()
@@ -272,3 +304,35 @@ func (_m *ReadSession) ListInProgressOperations() ([]model.Operation, dberrors.E
 
 	return r0, r1
 }
+
+// ListOperations provides a mock function with given fields: filter
+func (_m *ReadSession) ListOperations(filter model.OperationFilter) ([]model.Operation, int, dberrors.Error) {
+	ret := _m.Called(filter)
+
+	var r0 []model.Operation
+	if rf, ok := ret.Get(0).(func(model.OperationFilter) []model.Operation); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Operation)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(model.OperationFilter) int); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 dberrors.Error
+	if rf, ok := ret.Get(2).(func(model.OperationFilter) dberrors.Error); ok {
+		r2 = rf(filter)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(dberrors.Error)
+		}
+	}
+
+	return r0, r1, r2
+}
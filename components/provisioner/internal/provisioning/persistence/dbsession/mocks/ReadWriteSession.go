@@ -393,6 +393,38 @@ func (_m *ReadWriteSession) InsertRuntimeUpgrade(runtimeUpgrade model.RuntimeUpg
 	return r0
 }
 
+// ListClusters provides a mock function with given fields: filter
+func (_m *ReadWriteSession) ListClusters(filter model.RuntimeFilter) ([]model.ClusterWithLastOperation, int, apperrors.AppError) {
+	ret := _m.Called(filter)
+
+	var r0 []model.ClusterWithLastOperation
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter) []model.ClusterWithLastOperation); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ClusterWithLastOperation)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(model.RuntimeFilter) int); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 apperrors.AppError
+	if rf, ok := ret.Get(2).(func(model.RuntimeFilter) apperrors.AppError); ok {
+		r2 = rf(filter)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // ListInProgressOperations provides a mock function with given fields:
 func (_m *ReadWriteSession) ListInProgressOperations() ([]model.Operation, apperrors.AppError) {
 	ret := _m.Called()
@@ -418,6 +450,38 @@ func (_m *ReadWriteSession) ListInProgressOperations() ([]model.Operation, apper
 	return r0, r1
 }
 
+// ListOperations provides a mock function with given fields: filter
+func (_m *ReadWriteSession) ListOperations(filter model.OperationFilter) ([]model.Operation, int, apperrors.AppError) {
+	ret := _m.Called(filter)
+
+	var r0 []model.Operation
+	if rf, ok := ret.Get(0).(func(model.OperationFilter) []model.Operation); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Operation)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(model.OperationFilter) int); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 apperrors.AppError
+	if rf, ok := ret.Get(2).(func(model.OperationFilter) apperrors.AppError); ok {
+		r2 = rf(filter)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // MarkClusterAsDeleted provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
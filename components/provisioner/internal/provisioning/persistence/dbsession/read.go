@@ -174,7 +174,7 @@ func (r readSession) GetGardenerClusterByName(name string) (model.Cluster, dberr
 			"provider", "purpose", "seed", "target_secret", "worker_cidr", "region", "auto_scaler_min",
 			"auto_scaler_max", "max_surge", "max_unavailable", "enable_kubernetes_version_auto_update",
 			"enable_machine_image_version_auto_update", "allow_privileged_containers", "provider_specific_config",
-			"shoot_networking_filter_disabled").
+			"shoot_networking_filter_disabled", "hibernation_schedules", "worker_pools").
 		From("gardener_config").
 		Join("cluster", "gardener_config.cluster_id=cluster.id").
 		Where(dbr.Eq("name", name)).
@@ -193,6 +193,16 @@ func (r readSession) GetGardenerClusterByName(name string) (model.Cluster, dberr
 	if err != nil {
 		return model.Cluster{}, dberrors.Internal("Failed to decode Gardener provider config fetched from database: %s", err.Error())
 	}
+
+	err = clusterWithProvider.gardenerConfigRead.DecodeHibernationSchedules()
+	if err != nil {
+		return model.Cluster{}, dberrors.Internal("Failed to decode hibernation schedules fetched from database: %s", err.Error())
+	}
+
+	err = clusterWithProvider.gardenerConfigRead.DecodeWorkerPools()
+	if err != nil {
+		return model.Cluster{}, dberrors.Internal("Failed to decode worker pools fetched from database: %s", err.Error())
+	}
 	cluster.ClusterConfig = clusterWithProvider.gardenerConfigRead.GardenerConfig
 
 	if cluster.ActiveKymaConfigId != nil {
@@ -333,7 +343,9 @@ func (r readSession) getClusterAdministrator(runtimeID string) ([]model.ClusterA
 
 type gardenerConfigRead struct {
 	model.GardenerConfig
-	ProviderSpecificConfig string `db:"provider_specific_config"`
+	ProviderSpecificConfig  string `db:"provider_specific_config"`
+	RawHibernationSchedules []byte `db:"hibernation_schedules"`
+	RawWorkerPools          []byte `db:"worker_pools"`
 }
 
 func (gcr *gardenerConfigRead) DecodeProviderConfig() error {
@@ -346,6 +358,30 @@ func (gcr *gardenerConfigRead) DecodeProviderConfig() error {
 	return nil
 }
 
+func (gcr *gardenerConfigRead) DecodeHibernationSchedules() error {
+	if len(gcr.RawHibernationSchedules) == 0 {
+		return nil
+	}
+
+	err := json.Unmarshal(gcr.RawHibernationSchedules, &gcr.HibernationSchedules)
+	if err != nil {
+		return fmt.Errorf("error decoding hibernation schedules: %s", err.Error())
+	}
+	return nil
+}
+
+func (gcr *gardenerConfigRead) DecodeWorkerPools() error {
+	if len(gcr.RawWorkerPools) == 0 {
+		return nil
+	}
+
+	err := json.Unmarshal(gcr.RawWorkerPools, &gcr.WorkerPools)
+	if err != nil {
+		return fmt.Errorf("error decoding worker pools: %s", err.Error())
+	}
+	return nil
+}
+
 func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig, dberrors.Error) {
 	gardenerConfig := gardenerConfigRead{}
 
@@ -356,7 +392,7 @@ func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig,
 			"auto_scaler_min", "auto_scaler_max", "max_surge", "max_unavailable",
 			"enable_kubernetes_version_auto_update", "enable_machine_image_version_auto_update",
 			"allow_privileged_containers", "exposure_class_name", "provider_specific_config",
-			"shoot_networking_filter_disabled").
+			"shoot_networking_filter_disabled", "hibernation_schedules", "worker_pools").
 		From("cluster").
 		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
 		Where(dbr.Eq("cluster.id", runtimeID)).
@@ -375,6 +411,16 @@ func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig,
 		return model.GardenerConfig{}, dberrors.Internal("Failed to decode Gardener provider config fetched from database: %s", err.Error())
 	}
 
+	err = gardenerConfig.DecodeHibernationSchedules()
+	if err != nil {
+		return model.GardenerConfig{}, dberrors.Internal("Failed to decode hibernation schedules fetched from database: %s", err.Error())
+	}
+
+	err = gardenerConfig.DecodeWorkerPools()
+	if err != nil {
+		return model.GardenerConfig{}, dberrors.Internal("Failed to decode worker pools fetched from database: %s", err.Error())
+	}
+
 	return gardenerConfig.GardenerConfig, nil
 }
 
@@ -494,6 +540,180 @@ func (r readSession) InProgressOperationsCount() (model.OperationsCount, dberror
 	return operationsCount, nil
 }
 
+type clusterWithGardenerConfig struct {
+	model.Cluster
+	gardenerConfigRead
+}
+
+func (r readSession) ListClusters(filter model.RuntimeFilter) ([]model.ClusterWithLastOperation, int, dberrors.Error) {
+	conditions := []dbr.Builder{dbr.Eq("cluster.tenant", filter.Tenant)}
+	if !filter.IncludeDeleted {
+		conditions = append(conditions, dbr.Eq("cluster.deleted", false))
+	}
+	if filter.SubAccountID != nil {
+		conditions = append(conditions, dbr.Eq("cluster.sub_account_id", *filter.SubAccountID))
+	}
+	if filter.ShootName != nil {
+		conditions = append(conditions, dbr.Eq("gardener_config.name", *filter.ShootName))
+	}
+	if filter.Provider != nil {
+		conditions = append(conditions, dbr.Eq("gardener_config.provider", *filter.Provider))
+	}
+	if filter.Region != nil {
+		conditions = append(conditions, dbr.Eq("gardener_config.region", *filter.Region))
+	}
+
+	var totalCount int
+	err := r.session.
+		Select("count(*)").
+		From("cluster").
+		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
+		Where(dbr.And(conditions...)).
+		LoadOne(&totalCount)
+	if err != nil {
+		return nil, 0, dberrors.Internal("Failed to count Clusters: %s", err)
+	}
+
+	if filter.After != nil {
+		conditions = append(conditions, dbr.Expr("(cluster.creation_timestamp, cluster.id) > (?, ?)", filter.After.Timestamp, filter.After.ID))
+	}
+
+	var clustersWithProvider []clusterWithGardenerConfig
+	_, err = r.session.
+		Select(
+			"cluster.id", "cluster.tenant", "cluster.creation_timestamp", "cluster.deleted", "cluster.sub_account_id",
+			"gardener_config.cluster_id", "name", "project_name", "kubernetes_version",
+			"volume_size_gb", "disk_type", "machine_type", "machine_image", "machine_image_version",
+			"provider", "purpose", "seed", "target_secret", "worker_cidr", "region", "auto_scaler_min",
+			"auto_scaler_max", "max_surge", "max_unavailable", "enable_kubernetes_version_auto_update",
+			"enable_machine_image_version_auto_update", "allow_privileged_containers", "exposure_class_name",
+			"provider_specific_config", "shoot_networking_filter_disabled", "hibernation_schedules", "worker_pools").
+		From("cluster").
+		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
+		Where(dbr.And(conditions...)).
+		OrderAsc("cluster.creation_timestamp").
+		OrderAsc("cluster.id").
+		Limit(uint64(filter.Limit)).
+		Load(&clustersWithProvider)
+	if err != nil && err != dbr.ErrNotFound {
+		return nil, 0, dberrors.Internal("Failed to list Clusters: %s", err)
+	}
+
+	runtimeIDs := make([]string, 0, len(clustersWithProvider))
+	for _, clusterWithProvider := range clustersWithProvider {
+		runtimeIDs = append(runtimeIDs, clusterWithProvider.ID)
+	}
+	lastOperations, dberr := r.getLastOperations(runtimeIDs)
+	if dberr != nil {
+		return nil, 0, dberr
+	}
+
+	clusters := make([]model.ClusterWithLastOperation, 0, len(clustersWithProvider))
+	for _, clusterWithProvider := range clustersWithProvider {
+		err = clusterWithProvider.gardenerConfigRead.DecodeProviderConfig()
+		if err != nil {
+			return nil, 0, dberrors.Internal("Failed to decode Gardener provider config fetched from database: %s", err.Error())
+		}
+
+		err = clusterWithProvider.gardenerConfigRead.DecodeHibernationSchedules()
+		if err != nil {
+			return nil, 0, dberrors.Internal("Failed to decode hibernation schedules fetched from database: %s", err.Error())
+		}
+
+		err = clusterWithProvider.gardenerConfigRead.DecodeWorkerPools()
+		if err != nil {
+			return nil, 0, dberrors.Internal("Failed to decode worker pools fetched from database: %s", err.Error())
+		}
+
+		cluster := model.ClusterWithLastOperation{Cluster: clusterWithProvider.Cluster}
+		cluster.ClusterConfig = clusterWithProvider.gardenerConfigRead.GardenerConfig
+		if operation, found := lastOperations[cluster.ID]; found {
+			cluster.LastOperation = &operation
+		}
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters, totalCount, nil
+}
+
+// getLastOperations returns the last operation of each of the given Runtimes which has any operation
+func (r readSession) getLastOperations(runtimeIDs []string) (map[string]model.Operation, dberrors.Error) {
+	lastOperations := make(map[string]model.Operation, len(runtimeIDs))
+	if len(runtimeIDs) == 0 {
+		return lastOperations, nil
+	}
+
+	columns := append([]string{"DISTINCT ON (cluster_id) " + operationColumns[0]}, operationColumns[1:]...)
+	var operations []model.Operation
+	_, err := r.session.
+		Select(columns...).
+		From("operation").
+		Where(dbr.Eq("cluster_id", runtimeIDs)).
+		OrderAsc("cluster_id").
+		OrderDesc("start_timestamp").
+		Load(&operations)
+	if err != nil && err != dbr.ErrNotFound {
+		return nil, dberrors.Internal("Failed to get last operations: %s", err)
+	}
+
+	for _, operation := range operations {
+		lastOperations[operation.ClusterID] = operation
+	}
+	return lastOperations, nil
+}
+
+func (r readSession) ListOperations(filter model.OperationFilter) ([]model.Operation, int, dberrors.Error) {
+	conditions := []dbr.Builder{dbr.Eq("cluster.tenant", filter.Tenant)}
+	if filter.RuntimeID != nil {
+		conditions = append(conditions, dbr.Eq("operation.cluster_id", *filter.RuntimeID))
+	}
+	if filter.Type != nil {
+		conditions = append(conditions, dbr.Eq("operation.type", *filter.Type))
+	}
+	if filter.State != nil {
+		conditions = append(conditions, dbr.Eq("operation.state", *filter.State))
+	}
+	if filter.Since != nil {
+		conditions = append(conditions, dbr.Gte("operation.start_timestamp", *filter.Since))
+	}
+
+	var totalCount int
+	err := r.session.
+		Select("count(*)").
+		From("operation").
+		Join("cluster", "operation.cluster_id=cluster.id").
+		Where(dbr.And(conditions...)).
+		LoadOne(&totalCount)
+	if err != nil {
+		return nil, 0, dberrors.Internal("Failed to count operations: %s", err)
+	}
+
+	if filter.After != nil {
+		conditions = append(conditions, dbr.Expr("(operation.start_timestamp, operation.id) > (?, ?)", filter.After.Timestamp, filter.After.ID))
+	}
+
+	columns := make([]string, 0, len(operationColumns))
+	for _, column := range operationColumns {
+		columns = append(columns, "operation."+column)
+	}
+
+	var operations []model.Operation
+	_, err = r.session.
+		Select(columns...).
+		From("operation").
+		Join("cluster", "operation.cluster_id=cluster.id").
+		Where(dbr.And(conditions...)).
+		OrderAsc("operation.start_timestamp").
+		OrderAsc("operation.id").
+		Limit(uint64(filter.Limit)).
+		Load(&operations)
+	if err != nil && err != dbr.ErrNotFound {
+		return nil, 0, dberrors.Internal("Failed to list operations: %s", err)
+	}
+
+	return operations, totalCount, nil
+}
+
 func (r readSession) getOidcConfig(gardenerConfigID string) (model.OIDCConfig, dberrors.Error) {
 	var oidc model.OIDCConfig
 	var algorithms []string
@@ -97,7 +97,17 @@ func (ws writeSession) InsertAdministrators(clusterId string, administrators []s
 }
 
 func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberrors.Error {
-	_, err := ws.insertInto("gardener_config").
+	hibernationSchedules, err := json.Marshal(config.HibernationSchedules)
+	if err != nil {
+		return dberrors.Internal("Failed to marshal hibernation schedules: %s", err.Error())
+	}
+
+	workerPools, err := json.Marshal(config.WorkerPools)
+	if err != nil {
+		return dberrors.Internal("Failed to marshal worker pools: %s", err.Error())
+	}
+
+	_, err = ws.insertInto("gardener_config").
 		Pair("id", config.ID).
 		Pair("cluster_id", config.ClusterID).
 		Pair("project_name", config.ProjectName).
@@ -125,6 +135,8 @@ func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberror
 		Pair("exposure_class_name", config.ExposureClassName).
 		Pair("provider_specific_config", config.GardenerProviderConfig.RawJSON()).
 		Pair("shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled).
+		Pair("hibernation_schedules", hibernationSchedules).
+		Pair("worker_pools", workerPools).
 		Exec()
 
 	if err != nil {
@@ -208,6 +220,16 @@ func (ws writeSession) insertDNSConfig(config model.GardenerConfig) dberrors.Err
 }
 
 func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig) dberrors.Error {
+	hibernationSchedules, err := json.Marshal(config.HibernationSchedules)
+	if err != nil {
+		return dberrors.Internal("Failed to marshal hibernation schedules: %s", err.Error())
+	}
+
+	workerPools, err := json.Marshal(config.WorkerPools)
+	if err != nil {
+		return dberrors.Internal("Failed to marshal worker pools: %s", err.Error())
+	}
+
 	res, err := ws.update("gardener_config").
 		Where(dbr.Eq("cluster_id", config.ClusterID)).
 		Set("kubernetes_version", config.KubernetesVersion).
@@ -227,6 +249,8 @@ func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig)
 		Set("exposure_class_name", config.ExposureClassName).
 		Set("provider_specific_config", config.GardenerProviderConfig.RawJSON()).
 		Set("shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled).
+		Set("hibernation_schedules", hibernationSchedules).
+		Set("worker_pools", workerPools).
 		Exec()
 
 	if config.OIDCConfig != nil {
@@ -29,8 +29,11 @@ type Service interface {
 	ReconnectRuntimeAgent(id string) (string, apperrors.AppError)
 	RuntimeStatus(id string) (*gqlschema.RuntimeStatus, apperrors.AppError)
 	RuntimeOperationStatus(id string) (*gqlschema.OperationStatus, apperrors.AppError)
+	ListRuntimes(tenant string, filter *gqlschema.RuntimesFilter, first *int, after *string) (*gqlschema.RuntimeConnection, apperrors.AppError)
+	ListOperations(tenant string, runtimeID *string, operationType *gqlschema.OperationType, state *gqlschema.OperationState, since *string, first *int, after *string) (*gqlschema.OperationConnection, apperrors.AppError)
 	RollBackLastUpgrade(runtimeID string) (*gqlschema.RuntimeStatus, apperrors.AppError)
 	HibernateCluster(clusterID string) (*gqlschema.OperationStatus, apperrors.AppError)
+	WakeUpCluster(clusterID string) (*gqlschema.OperationStatus, apperrors.AppError)
 }
 
 //go:generate mockery --name=Provisioner
@@ -39,6 +42,7 @@ type Provisioner interface {
 	DeprovisionCluster(cluster model.Cluster, withoutInstallation bool, operationId string) (model.Operation, apperrors.AppError)
 	UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError
 	HibernateCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError
+	WakeUpCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError
 	GetHibernationStatus(clusterID string, gardenerConfig model.GardenerConfig) (model.HibernationStatus, apperrors.AppError)
 }
 
@@ -65,6 +69,7 @@ type service struct {
 	upgradeQueue                 queue.OperationQueue
 	shootUpgradeQueue            queue.OperationQueue
 	hibernationQueue             queue.OperationQueue
+	wakeUpQueue                  queue.OperationQueue
 }
 
 func NewProvisioningService(
@@ -83,6 +88,7 @@ func NewProvisioningService(
 	upgradeQueue queue.OperationQueue,
 	shootUpgradeQueue queue.OperationQueue,
 	hibernationQueue queue.OperationQueue,
+	wakeUpQueue queue.OperationQueue,
 
 ) Service {
 	return &service{
@@ -99,6 +105,7 @@ func NewProvisioningService(
 		upgradeQueue:                 upgradeQueue,
 		shootUpgradeQueue:            shootUpgradeQueue,
 		hibernationQueue:             hibernationQueue,
+		wakeUpQueue:                  wakeUpQueue,
 		shootProvider:                shootProvider,
 		installationClient:           installationClient,
 	}
@@ -327,6 +334,47 @@ func (r *service) HibernateCluster(runtimeID string) (*gqlschema.OperationStatus
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
+func (r *service) WakeUpCluster(runtimeID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Starting wake up for Runtime '%s'...", runtimeID)
+
+	session := r.dbSessionFactory.NewReadSession()
+
+	err := r.verifyLastOperationFinished(session, runtimeID)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, dberr := session.GetCluster(runtimeID)
+	if dberr != nil {
+		return nil, apperrors.Internal("Failed to find shoot cluster to wake up in database: %s", dberr.Error())
+	}
+
+	txSession, dbErr := r.dbSessionFactory.NewSessionWithinTransaction()
+	if dbErr != nil {
+		return nil, apperrors.Internal("Failed to start database transaction: %s", dbErr.Error())
+	}
+	defer txSession.RollbackUnlessCommitted()
+
+	operation, gardError := r.setWakeUpStarted(txSession, cluster)
+	if gardError != nil {
+		return nil, apperrors.Internal("Failed to set wake up started: %s", gardError.Error())
+	}
+
+	err = r.provisioner.WakeUpCluster(cluster.ID, cluster.ClusterConfig)
+	if err != nil {
+		return nil, err.Append("Failed to wake up Cluster")
+	}
+
+	dbErr = txSession.Commit()
+	if dbErr != nil {
+		return nil, apperrors.Internal("Failed to commit wake up transaction: %s", dbErr.Error())
+	}
+
+	r.wakeUpQueue.Add(operation.ID)
+
+	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
+}
+
 func (r *service) verifyLastOperationFinished(session dbsession.ReadSession, runtimeId string) apperrors.AppError {
 	lastOperation, dberr := session.GetLastOperation(runtimeId)
 	if dberr != nil {
@@ -437,6 +485,76 @@ func (r *service) RuntimeOperationStatus(operationID string) (*gqlschema.Operati
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
+func (r *service) ListRuntimes(tenant string, filter *gqlschema.RuntimesFilter, first *int, after *string) (*gqlschema.RuntimeConnection, apperrors.AppError) {
+	runtimeFilter, err := runtimeFilterFromInput(tenant, filter, first, after)
+	if err != nil {
+		return nil, err.Append("invalid Runtimes query")
+	}
+
+	readSession := r.dbSessionFactory.NewReadSession()
+
+	clusters, totalCount, dberr := readSession.ListClusters(runtimeFilter)
+	if dberr != nil {
+		return nil, dberr.Append("failed to list Runtimes")
+	}
+
+	hasNextPage := len(clusters) == runtimeFilter.Limit
+	if hasNextPage {
+		clusters = clusters[:len(clusters)-1]
+	}
+
+	connection := &gqlschema.RuntimeConnection{
+		TotalCount: totalCount,
+		Edges:      make([]*gqlschema.RuntimeEdge, 0, len(clusters)),
+		PageInfo:   &gqlschema.PageInfo{HasNextPage: hasNextPage},
+	}
+	for _, cluster := range clusters {
+		cursor := encodeListCursor(cluster.CreationTimestamp, cluster.ID)
+		connection.Edges = append(connection.Edges, &gqlschema.RuntimeEdge{
+			Cursor: cursor,
+			Node:   r.graphQLConverter.ClusterToGraphQLRuntime(cluster.Cluster, cluster.LastOperation),
+		})
+		connection.PageInfo.EndCursor = &cursor
+	}
+
+	return connection, nil
+}
+
+func (r *service) ListOperations(tenant string, runtimeID *string, operationType *gqlschema.OperationType, state *gqlschema.OperationState, since *string, first *int, after *string) (*gqlschema.OperationConnection, apperrors.AppError) {
+	operationFilter, err := operationFilterFromInput(tenant, runtimeID, operationType, state, since, first, after)
+	if err != nil {
+		return nil, err.Append("invalid operations query")
+	}
+
+	readSession := r.dbSessionFactory.NewReadSession()
+
+	operations, totalCount, dberr := readSession.ListOperations(operationFilter)
+	if dberr != nil {
+		return nil, dberr.Append("failed to list operations")
+	}
+
+	hasNextPage := len(operations) == operationFilter.Limit
+	if hasNextPage {
+		operations = operations[:len(operations)-1]
+	}
+
+	connection := &gqlschema.OperationConnection{
+		TotalCount: totalCount,
+		Edges:      make([]*gqlschema.OperationEdge, 0, len(operations)),
+		PageInfo:   &gqlschema.PageInfo{HasNextPage: hasNextPage},
+	}
+	for _, operation := range operations {
+		cursor := encodeListCursor(operation.StartTimestamp, operation.ID)
+		connection.Edges = append(connection.Edges, &gqlschema.OperationEdge{
+			Cursor: cursor,
+			Node:   r.graphQLConverter.OperationToGraphQLOperation(operation),
+		})
+		connection.PageInfo.EndCursor = &cursor
+	}
+
+	return connection, nil
+}
+
 func (r *service) RollBackLastUpgrade(runtimeID string) (*gqlschema.RuntimeStatus, apperrors.AppError) {
 
 	readSession := r.dbSessionFactory.NewReadSession()
@@ -621,6 +739,18 @@ func (r *service) setHibernationStarted(txSession dbsession.WriteSession, curren
 	return operation, nil
 }
 
+func (r *service) setWakeUpStarted(txSession dbsession.WriteSession, currentCluster model.Cluster) (model.Operation, error) {
+	log.Infof("Starting wake up operation")
+
+	operation, dbError := r.setOperationStarted(txSession, currentCluster.ID, model.WakeUp, model.WaitForWakeUp, time.Now(), "Starting ")
+
+	if dbError != nil {
+		return model.Operation{}, dbError.Append("Failed to start wake up operation:  %s", dbError.Error())
+	}
+
+	return operation, nil
+}
+
 func (r *service) setOperationStarted(
 	dbSession dbsession.WriteSession,
 	runtimeID string,
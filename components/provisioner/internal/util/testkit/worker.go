@@ -5,6 +5,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -77,3 +78,15 @@ func (tw *TestWorker) WithZones(zones ...string) *TestWorker {
 	tw.worker.Zones = append(tw.worker.Zones, zones...)
 	return tw
 }
+
+// WithLabels sets value of Labels
+func (tw *TestWorker) WithLabels(labels map[string]string) *TestWorker {
+	tw.worker.Labels = labels
+	return tw
+}
+
+// WithTaints adds taints to Taints
+func (tw *TestWorker) WithTaints(taints ...corev1.Taint) *TestWorker {
+	tw.worker.Taints = append(tw.worker.Taints, taints...)
+	return tw
+}
@@ -163,55 +163,73 @@ type GardenerConfig struct {
 	OidcConfig                          *OIDCConfig            `json:"oidcConfig"`
 	ExposureClassName                   *string                `json:"exposureClassName"`
 	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
+	HibernationSchedules                []*HibernationSchedule `json:"hibernationSchedules"`
+	WorkerPools                         []*WorkerPool          `json:"workerPools"`
 }
 
 type GardenerConfigInput struct {
-	Name                                string                 `json:"name"`
-	KubernetesVersion                   string                 `json:"kubernetesVersion"`
-	Provider                            string                 `json:"provider"`
-	TargetSecret                        string                 `json:"targetSecret"`
-	Region                              string                 `json:"region"`
-	MachineType                         string                 `json:"machineType"`
-	MachineImage                        *string                `json:"machineImage"`
-	MachineImageVersion                 *string                `json:"machineImageVersion"`
-	DiskType                            *string                `json:"diskType"`
-	VolumeSizeGb                        *int                   `json:"volumeSizeGB"`
-	WorkerCidr                          string                 `json:"workerCidr"`
-	AutoScalerMin                       int                    `json:"autoScalerMin"`
-	AutoScalerMax                       int                    `json:"autoScalerMax"`
-	MaxSurge                            int                    `json:"maxSurge"`
-	MaxUnavailable                      int                    `json:"maxUnavailable"`
-	Purpose                             *string                `json:"purpose"`
-	LicenceType                         *string                `json:"licenceType"`
-	EnableKubernetesVersionAutoUpdate   *bool                  `json:"enableKubernetesVersionAutoUpdate"`
-	EnableMachineImageVersionAutoUpdate *bool                  `json:"enableMachineImageVersionAutoUpdate"`
-	AllowPrivilegedContainers           *bool                  `json:"allowPrivilegedContainers"`
-	ProviderSpecificConfig              *ProviderSpecificInput `json:"providerSpecificConfig"`
-	DNSConfig                           *DNSConfigInput        `json:"dnsConfig"`
-	Seed                                *string                `json:"seed"`
-	OidcConfig                          *OIDCConfigInput       `json:"oidcConfig"`
-	ExposureClassName                   *string                `json:"exposureClassName"`
-	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
+	Name                                string                      `json:"name"`
+	KubernetesVersion                   string                      `json:"kubernetesVersion"`
+	Provider                            string                      `json:"provider"`
+	TargetSecret                        string                      `json:"targetSecret"`
+	Region                              string                      `json:"region"`
+	MachineType                         string                      `json:"machineType"`
+	MachineImage                        *string                     `json:"machineImage"`
+	MachineImageVersion                 *string                     `json:"machineImageVersion"`
+	DiskType                            *string                     `json:"diskType"`
+	VolumeSizeGb                        *int                        `json:"volumeSizeGB"`
+	WorkerCidr                          string                      `json:"workerCidr"`
+	AutoScalerMin                       int                         `json:"autoScalerMin"`
+	AutoScalerMax                       int                         `json:"autoScalerMax"`
+	MaxSurge                            int                         `json:"maxSurge"`
+	MaxUnavailable                      int                         `json:"maxUnavailable"`
+	Purpose                             *string                     `json:"purpose"`
+	LicenceType                         *string                     `json:"licenceType"`
+	EnableKubernetesVersionAutoUpdate   *bool                       `json:"enableKubernetesVersionAutoUpdate"`
+	EnableMachineImageVersionAutoUpdate *bool                       `json:"enableMachineImageVersionAutoUpdate"`
+	AllowPrivilegedContainers           *bool                       `json:"allowPrivilegedContainers"`
+	ProviderSpecificConfig              *ProviderSpecificInput      `json:"providerSpecificConfig"`
+	DNSConfig                           *DNSConfigInput             `json:"dnsConfig"`
+	Seed                                *string                     `json:"seed"`
+	OidcConfig                          *OIDCConfigInput            `json:"oidcConfig"`
+	ExposureClassName                   *string                     `json:"exposureClassName"`
+	ShootNetworkingFilterDisabled       *bool                       `json:"shootNetworkingFilterDisabled"`
+	HibernationSchedules                []*HibernationScheduleInput `json:"hibernationSchedules"`
+	WorkerPools                         []*WorkerPoolInput          `json:"workerPools"`
 }
 
 type GardenerUpgradeInput struct {
-	KubernetesVersion                   *string                `json:"kubernetesVersion"`
-	MachineType                         *string                `json:"machineType"`
-	DiskType                            *string                `json:"diskType"`
-	VolumeSizeGb                        *int                   `json:"volumeSizeGB"`
-	AutoScalerMin                       *int                   `json:"autoScalerMin"`
-	AutoScalerMax                       *int                   `json:"autoScalerMax"`
-	MachineImage                        *string                `json:"machineImage"`
-	MachineImageVersion                 *string                `json:"machineImageVersion"`
-	MaxSurge                            *int                   `json:"maxSurge"`
-	MaxUnavailable                      *int                   `json:"maxUnavailable"`
-	Purpose                             *string                `json:"purpose"`
-	EnableKubernetesVersionAutoUpdate   *bool                  `json:"enableKubernetesVersionAutoUpdate"`
-	EnableMachineImageVersionAutoUpdate *bool                  `json:"enableMachineImageVersionAutoUpdate"`
-	ProviderSpecificConfig              *ProviderSpecificInput `json:"providerSpecificConfig"`
-	OidcConfig                          *OIDCConfigInput       `json:"oidcConfig"`
-	ExposureClassName                   *string                `json:"exposureClassName"`
-	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
+	KubernetesVersion                   *string                     `json:"kubernetesVersion"`
+	MachineType                         *string                     `json:"machineType"`
+	DiskType                            *string                     `json:"diskType"`
+	VolumeSizeGb                        *int                        `json:"volumeSizeGB"`
+	AutoScalerMin                       *int                        `json:"autoScalerMin"`
+	AutoScalerMax                       *int                        `json:"autoScalerMax"`
+	MachineImage                        *string                     `json:"machineImage"`
+	MachineImageVersion                 *string                     `json:"machineImageVersion"`
+	MaxSurge                            *int                        `json:"maxSurge"`
+	MaxUnavailable                      *int                        `json:"maxUnavailable"`
+	Purpose                             *string                     `json:"purpose"`
+	EnableKubernetesVersionAutoUpdate   *bool                       `json:"enableKubernetesVersionAutoUpdate"`
+	EnableMachineImageVersionAutoUpdate *bool                       `json:"enableMachineImageVersionAutoUpdate"`
+	ProviderSpecificConfig              *ProviderSpecificInput      `json:"providerSpecificConfig"`
+	OidcConfig                          *OIDCConfigInput            `json:"oidcConfig"`
+	ExposureClassName                   *string                     `json:"exposureClassName"`
+	ShootNetworkingFilterDisabled       *bool                       `json:"shootNetworkingFilterDisabled"`
+	HibernationSchedules                []*HibernationScheduleInput `json:"hibernationSchedules"`
+	WorkerPools                         []*WorkerPoolInput          `json:"workerPools"`
+}
+
+type HibernationSchedule struct {
+	Start    *string `json:"start"`
+	End      *string `json:"end"`
+	Location *string `json:"location"`
+}
+
+type HibernationScheduleInput struct {
+	Start    *string `json:"start"`
+	End      *string `json:"end"`
+	Location *string `json:"location"`
 }
 
 type HibernationStatus struct {
@@ -274,6 +292,29 @@ type OpenStackProviderConfigInput struct {
 	LoadBalancerProvider string   `json:"loadBalancerProvider"`
 }
 
+type Operation struct {
+	ID             string         `json:"id"`
+	Operation      OperationType  `json:"operation"`
+	State          OperationState `json:"state"`
+	Stage          string         `json:"stage"`
+	Message        *string        `json:"message"`
+	RuntimeID      string         `json:"runtimeID"`
+	StartTimestamp string         `json:"startTimestamp"`
+	EndTimestamp   *string        `json:"endTimestamp"`
+	LastError      *LastError     `json:"lastError"`
+}
+
+type OperationConnection struct {
+	TotalCount int              `json:"totalCount"`
+	Edges      []*OperationEdge `json:"edges"`
+	PageInfo   *PageInfo        `json:"pageInfo"`
+}
+
+type OperationEdge struct {
+	Cursor string     `json:"cursor"`
+	Node   *Operation `json:"node"`
+}
+
 type OperationStatus struct {
 	ID        *string        `json:"id"`
 	Operation OperationType  `json:"operation"`
@@ -283,6 +324,11 @@ type OperationStatus struct {
 	LastError *LastError     `json:"lastError"`
 }
 
+type PageInfo struct {
+	EndCursor   *string `json:"endCursor"`
+	HasNextPage bool    `json:"hasNextPage"`
+}
+
 type ProviderSpecificInput struct {
 	GcpConfig       *GCPProviderConfigInput       `json:"gcpConfig"`
 	AzureConfig     *AzureProviderConfigInput     `json:"azureConfig"`
@@ -296,17 +342,38 @@ type ProvisionRuntimeInput struct {
 	KymaConfig    *KymaConfigInput    `json:"kymaConfig"`
 }
 
+type Runtime struct {
+	ID                  string           `json:"id"`
+	Tenant              string           `json:"tenant"`
+	SubAccountID        *string          `json:"subAccountID"`
+	CreationTimestamp   string           `json:"creationTimestamp"`
+	Deleted             bool             `json:"deleted"`
+	ClusterConfig       *GardenerConfig  `json:"clusterConfig"`
+	LastOperationStatus *OperationStatus `json:"lastOperationStatus"`
+}
+
 type RuntimeConfig struct {
 	ClusterConfig *GardenerConfig `json:"clusterConfig"`
 	KymaConfig    *KymaConfig     `json:"kymaConfig"`
 	Kubeconfig    *string         `json:"kubeconfig"`
 }
 
+type RuntimeConnection struct {
+	TotalCount int            `json:"totalCount"`
+	Edges      []*RuntimeEdge `json:"edges"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+}
+
 type RuntimeConnectionStatus struct {
 	Status RuntimeAgentConnectionStatus `json:"status"`
 	Errors []*Error                     `json:"errors"`
 }
 
+type RuntimeEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Runtime `json:"node"`
+}
+
 type RuntimeInput struct {
 	Name        string  `json:"name"`
 	Description *string `json:"description"`
@@ -320,6 +387,26 @@ type RuntimeStatus struct {
 	HibernationStatus       *HibernationStatus       `json:"hibernationStatus"`
 }
 
+type RuntimesFilter struct {
+	SubAccountID   *string `json:"subAccountID"`
+	ShootName      *string `json:"shootName"`
+	Provider       *string `json:"provider"`
+	Region         *string `json:"region"`
+	IncludeDeleted *bool   `json:"includeDeleted"`
+}
+
+type Taint struct {
+	Key    string  `json:"key"`
+	Value  *string `json:"value"`
+	Effect string  `json:"effect"`
+}
+
+type TaintInput struct {
+	Key    string  `json:"key"`
+	Value  *string `json:"value"`
+	Effect string  `json:"effect"`
+}
+
 type UpgradeRuntimeInput struct {
 	KymaConfig *KymaConfigInput `json:"kymaConfig"`
 }
@@ -329,6 +416,34 @@ type UpgradeShootInput struct {
 	Administrators []string              `json:"administrators"`
 }
 
+type WorkerPool struct {
+	Name           string   `json:"name"`
+	MachineType    string   `json:"machineType"`
+	AutoScalerMin  int      `json:"autoScalerMin"`
+	AutoScalerMax  int      `json:"autoScalerMax"`
+	MaxSurge       *int     `json:"maxSurge"`
+	MaxUnavailable *int     `json:"maxUnavailable"`
+	VolumeSizeGb   *int     `json:"volumeSizeGB"`
+	DiskType       *string  `json:"diskType"`
+	Zones          []string `json:"zones"`
+	Labels         Labels   `json:"labels"`
+	Taints         []*Taint `json:"taints"`
+}
+
+type WorkerPoolInput struct {
+	Name           string        `json:"name"`
+	MachineType    string        `json:"machineType"`
+	AutoScalerMin  int           `json:"autoScalerMin"`
+	AutoScalerMax  int           `json:"autoScalerMax"`
+	MaxSurge       *int          `json:"maxSurge"`
+	MaxUnavailable *int          `json:"maxUnavailable"`
+	VolumeSizeGb   *int          `json:"volumeSizeGB"`
+	DiskType       *string       `json:"diskType"`
+	Zones          []string      `json:"zones"`
+	Labels         Labels        `json:"labels"`
+	Taints         []*TaintInput `json:"taints"`
+}
+
 type ConflictStrategy string
 
 const (
@@ -467,6 +582,7 @@ const (
 	OperationTypeDeprovisionNoInstall OperationType = "DeprovisionNoInstall"
 	OperationTypeReconnectRuntime     OperationType = "ReconnectRuntime"
 	OperationTypeHibernate            OperationType = "Hibernate"
+	OperationTypeWakeUp               OperationType = "WakeUp"
 )
 
 var AllOperationType = []OperationType{
@@ -478,11 +594,12 @@ var AllOperationType = []OperationType{
 	OperationTypeDeprovisionNoInstall,
 	OperationTypeReconnectRuntime,
 	OperationTypeHibernate,
+	OperationTypeWakeUp,
 }
 
 func (e OperationType) IsValid() bool {
 	switch e {
-	case OperationTypeProvision, OperationTypeProvisionNoInstall, OperationTypeUpgrade, OperationTypeUpgradeShoot, OperationTypeDeprovision, OperationTypeDeprovisionNoInstall, OperationTypeReconnectRuntime, OperationTypeHibernate:
+	case OperationTypeProvision, OperationTypeProvisionNoInstall, OperationTypeUpgrade, OperationTypeUpgradeShoot, OperationTypeDeprovision, OperationTypeDeprovisionNoInstall, OperationTypeReconnectRuntime, OperationTypeHibernate, OperationTypeWakeUp:
 		return true
 	}
 	return false
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS bindings (
+    id varchar(255) PRIMARY KEY,
+    instance_id varchar(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    kubeconfig text NOT NULL,
+    expiration_seconds integer NOT NULL
+);
+
+CREATE INDEX bindings_by_instance_id ON bindings USING btree (instance_id);
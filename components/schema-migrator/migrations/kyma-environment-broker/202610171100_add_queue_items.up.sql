@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS queue_items (
+    queue_name varchar(255) NOT NULL,
+    item_id varchar(255) NOT NULL,
+    due_at TIMESTAMPTZ NOT NULL,
+    generation integer NOT NULL DEFAULT 0,
+    lease_owner varchar(255) NOT NULL DEFAULT '',
+    lease_expires_at TIMESTAMPTZ NOT NULL DEFAULT 'epoch',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (queue_name, item_id)
+);
+
+CREATE INDEX queue_items_by_due_at ON queue_items USING btree (queue_name, due_at);
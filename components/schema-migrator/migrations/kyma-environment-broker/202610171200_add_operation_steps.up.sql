@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS operation_steps (
+    id varchar(255) PRIMARY KEY,
+    operation_id varchar(255) NOT NULL,
+    step_name varchar(255) NOT NULL,
+    stage varchar(255) NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ NOT NULL,
+    result varchar(32) NOT NULL,
+    retry_delay bigint NOT NULL,
+    error_reason varchar(255) NOT NULL,
+    error_message text NOT NULL
+);
+
+CREATE INDEX operation_steps_by_operation_id ON operation_steps USING btree (operation_id, started_at);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS outbox_events (
+    sequence bigserial PRIMARY KEY,
+    event_type varchar(255) NOT NULL,
+    payload bytea NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS outbox_checkpoints (
+    subscriber varchar(255) PRIMARY KEY,
+    sequence bigint NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,2 @@
+DROP INDEX instances_by_created_at;
+DROP INDEX instances_by_global_account_id;
@@ -0,0 +1,2 @@
+CREATE INDEX instances_by_created_at ON instances USING btree (created_at, instance_id);
+CREATE INDEX instances_by_global_account_id ON instances USING btree (global_account_id, instance_id);
@@ -0,0 +1 @@
+DROP INDEX operations_by_updated_at;
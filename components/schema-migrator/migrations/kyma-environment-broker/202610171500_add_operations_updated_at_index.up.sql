@@ -0,0 +1 @@
+CREATE INDEX operations_by_updated_at ON operations USING btree (updated_at, id);
@@ -0,0 +1 @@
+CREATE INDEX runtime_states_by_runtime_id ON runtime_states USING btree (runtime_id, created_at);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS outbox_dead_letters (
+    subscriber varchar(255) NOT NULL,
+    sequence bigint NOT NULL,
+    event_type varchar(255) NOT NULL,
+    payload bytea NOT NULL,
+    error text NOT NULL,
+    attempts integer NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (subscriber, sequence)
+);
@@ -0,0 +1,9 @@
+UPDATE outbox_events SET sequence = nextval('outbox_events_sequence_seq') WHERE sequence IS NULL;
+
+DROP INDEX outbox_events_unsequenced;
+DROP INDEX outbox_events_by_sequence;
+
+ALTER TABLE outbox_events DROP CONSTRAINT outbox_events_pkey;
+ALTER TABLE outbox_events DROP COLUMN id;
+ALTER TABLE outbox_events ALTER COLUMN sequence SET DEFAULT nextval('outbox_events_sequence_seq'), ALTER COLUMN sequence SET NOT NULL;
+ALTER TABLE outbox_events ADD PRIMARY KEY (sequence);
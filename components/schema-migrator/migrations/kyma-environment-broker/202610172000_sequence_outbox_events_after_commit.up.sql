@@ -0,0 +1,6 @@
+ALTER TABLE outbox_events DROP CONSTRAINT outbox_events_pkey;
+ALTER TABLE outbox_events ADD COLUMN id bigserial PRIMARY KEY;
+ALTER TABLE outbox_events ALTER COLUMN sequence DROP DEFAULT, ALTER COLUMN sequence DROP NOT NULL;
+
+CREATE UNIQUE INDEX outbox_events_by_sequence ON outbox_events USING btree (sequence);
+CREATE INDEX outbox_events_unsequenced ON outbox_events USING btree (id) WHERE sequence IS NULL;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX operation_by_cluster_id;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,5 @@
+BEGIN;
+
+CREATE INDEX operation_by_cluster_id ON operation USING btree (cluster_id, start_timestamp);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,22 @@
+package command
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewKEBOperationCmd constructs the kcp operation command, which groups the commands managing Kyma Environment Broker operations
+func NewKEBOperationCmd() *cobra.Command {
+	cobraCmd := &cobra.Command{
+		Use:     "operation",
+		Aliases: []string{"operations", "op"},
+		Short:   "Manages Kyma Environment Broker operations.",
+		Long:    "Displays details of the processing of Kyma Environment Broker operations and resumes failed operations.",
+	}
+
+	cobraCmd.AddCommand(
+		NewOperationStepsCmd(),
+		NewOperationResumeCmd(),
+	)
+
+	return cobraCmd
+}
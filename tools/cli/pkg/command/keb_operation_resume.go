@@ -0,0 +1,61 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// OperationResumeCommand represents an execution of the kcp operation resume command
+type OperationResumeCommand struct {
+	cobraCmd    *cobra.Command
+	log         logger.Logger
+	operationID string
+	params      operation.ResumeParameters
+}
+
+// NewOperationResumeCmd constructs a new instance of OperationResumeCommand and configures it in terms of a cobra.Command
+func NewOperationResumeCmd() *cobra.Command {
+	cmd := OperationResumeCommand{}
+	cobraCmd := &cobra.Command{
+		Use:   "resume <operation ID>",
+		Short: "Resumes a failed operation.",
+		Long: `Sets a failed Kyma Environment Broker operation back to in progress and queues it for processing.
+The operation continues from the first not finished stage. Steps and stages given with the --skip-step and --skip-stage options are not run again.
+Upgrade operations are retried by their orchestrations.`,
+		Example: `  kcp operation resume 0c4357f5-83e0-4b72-9472-49b5cd417c00                                Resume the failed operation.
+  kcp operation resume 0c4357f5-83e0-4b72-9472-49b5cd417c00 --skip-step EDP_Registration  Resume the failed operation without running the EDP_Registration step.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: func(_ *cobra.Command, args []string) error { return cmd.Validate(args) },
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+	cmd.cobraCmd = cobraCmd
+
+	cobraCmd.Flags().StringSliceVar(&cmd.params.SkipSteps, "skip-step", nil, "Name of the step which is skipped when the operation is resumed. You can provide multiple values, either separated by a comma (e.g. step1,step2), or by specifying the option multiple times.")
+	cobraCmd.Flags().StringSliceVar(&cmd.params.SkipStages, "skip-stage", nil, "Name of the stage which is marked as finished when the operation is resumed. You can provide multiple values, either separated by a comma (e.g. stage1,stage2), or by specifying the option multiple times.")
+
+	return cobraCmd
+}
+
+// Run executes the operation resume command
+func (cmd *OperationResumeCommand) Run() error {
+	cmd.log = logger.New()
+	client := operation.NewClient(cmd.cobraCmd.Context(), GlobalOpts.KEBAPIURL(), CLICredentialManager(cmd.log))
+
+	resumed, err := client.Resume(cmd.operationID, cmd.params)
+	if err != nil {
+		return errors.Wrap(err, "while resuming operation")
+	}
+
+	fmt.Printf("Operation %s (%s) resumed, the current state: %s\n", resumed.OperationID, resumed.Type, resumed.State)
+	return nil
+}
+
+// Validate checks the input parameters of the operation resume command
+func (cmd *OperationResumeCommand) Validate(args []string) error {
+	cmd.operationID = args[0]
+	return nil
+}
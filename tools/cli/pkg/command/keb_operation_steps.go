@@ -0,0 +1,154 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/printer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// OperationStepsCommand represents an execution of the kcp operation steps command
+type OperationStepsCommand struct {
+	cobraCmd    *cobra.Command
+	log         logger.Logger
+	output      string
+	operationID string
+}
+
+var operationStepColumns = []printer.Column{
+	{
+		Header:    "STAGE",
+		FieldSpec: "{.Stage}",
+	},
+	{
+		Header:    "STEP",
+		FieldSpec: "{.StepName}",
+	},
+	{
+		Header:         "STARTED AT",
+		FieldFormatter: operationStepStartedAt,
+	},
+	{
+		Header:         "DURATION",
+		FieldFormatter: operationStepDuration,
+	},
+	{
+		Header:    "RESULT",
+		FieldSpec: "{.Result}",
+	},
+	{
+		Header:         "RETRY IN",
+		FieldFormatter: operationStepRetryDelay,
+	},
+	{
+		Header:         "ERROR",
+		FieldFormatter: operationStepError,
+	},
+}
+
+// NewOperationStepsCmd constructs a new instance of OperationStepsCommand and configures it in terms of a cobra.Command
+func NewOperationStepsCmd() *cobra.Command {
+	cmd := OperationStepsCommand{}
+	cobraCmd := &cobra.Command{
+		Use:   "steps <operation ID>",
+		Short: "Displays the journal of steps of an operation.",
+		Long: `Displays all runs of steps of a Kyma Environment Broker operation ordered by the start time.
+Every run shows the stage, the duration, the result, the delay of the next retry, and the error reason if the step failed.`,
+		Example: `  kcp operation steps 0c4357f5-83e0-4b72-9472-49b5cd417c00          Display the journal of steps of the operation.
+  kcp operation steps 0c4357f5-83e0-4b72-9472-49b5cd417c00 -o json  Display the journal of steps of the operation in the JSON format.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: func(_ *cobra.Command, args []string) error { return cmd.Validate(args) },
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+	cmd.cobraCmd = cobraCmd
+
+	SetOutputOpt(cobraCmd, &cmd.output)
+
+	return cobraCmd
+}
+
+// Run executes the operation steps command
+func (cmd *OperationStepsCommand) Run() error {
+	cmd.log = logger.New()
+	client := operation.NewClient(cmd.cobraCmd.Context(), GlobalOpts.KEBAPIURL(), CLICredentialManager(cmd.log))
+
+	steps, err := client.ListSteps(cmd.operationID)
+	if err != nil {
+		return errors.Wrap(err, "while listing operation steps")
+	}
+
+	err = cmd.printSteps(steps)
+	if err != nil {
+		return errors.Wrap(err, "while printing operation steps")
+	}
+
+	return nil
+}
+
+// Validate checks the input parameters of the operation steps command
+func (cmd *OperationStepsCommand) Validate(args []string) error {
+	err := ValidateOutputOpt(cmd.output)
+	if err != nil {
+		return err
+	}
+
+	cmd.operationID = args[0]
+	return nil
+}
+
+func (cmd *OperationStepsCommand) printSteps(steps operation.StepsResponse) error {
+	switch {
+	case cmd.output == tableOutput:
+		tp, err := printer.NewTablePrinter(operationStepColumns, false)
+		if err != nil {
+			return err
+		}
+		return tp.PrintObj(steps.Data)
+	case cmd.output == jsonOutput:
+		jp := printer.NewJSONPrinter("  ")
+		jp.PrintObj(steps)
+	case strings.HasPrefix(cmd.output, customOutput):
+		_, templateFile := printer.ParseOutputToTemplateTypeAndElement(cmd.output)
+		column, err := printer.ParseColumnToHeaderAndFieldSpec(templateFile)
+		if err != nil {
+			return err
+		}
+
+		ccp, err := printer.NewTablePrinter(column, false)
+		if err != nil {
+			return err
+		}
+		return ccp.PrintObj(steps.Data)
+	}
+	return nil
+}
+
+func operationStepStartedAt(obj interface{}) string {
+	step := obj.(operation.StepDTO)
+	return step.StartedAt.Format("2006/01/02 15:04:05")
+}
+
+func operationStepDuration(obj interface{}) string {
+	step := obj.(operation.StepDTO)
+	return step.Duration.String()
+}
+
+func operationStepRetryDelay(obj interface{}) string {
+	step := obj.(operation.StepDTO)
+	if step.RetryDelay == 0 {
+		return ""
+	}
+	return step.RetryDelay.String()
+}
+
+func operationStepError(obj interface{}) string {
+	step := obj.(operation.StepDTO)
+	if step.ErrorReason == "" {
+		return step.ErrorMessage
+	}
+	return fmt.Sprintf("%s: %s", step.ErrorReason, step.ErrorMessage)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/spf13/cobra"
@@ -31,6 +32,14 @@ const (
 	regionTarget     = "region"
 	planTarget       = "plan"
 	shootTarget      = "shoot"
+
+	kymaVersionTarget       = "kyma-version"
+	kubernetesVersionTarget = "kubernetes-version"
+	providerTarget          = "provider"
+	machineTypeTarget       = "machine-type"
+	createdAfterTarget      = "created-after"
+	createdBeforeTarget     = "created-before"
+	shootLabelTarget        = "shoot-label"
 )
 
 const (
@@ -190,14 +199,21 @@ func SetRuntimeTargetOpts(cmd *cobra.Command, targetInputs *[]string, targetExcl
 	cmd.Flags().StringArrayVarP(targetInputs, "target", "t", nil,
 		`List of Runtime target specifiers to include. You can specify this option multiple times.
 A target specifier is a comma-separated list of the following selectors:
-  all                 : All Runtimes provisioned successfully and not deprovisioning
-  account={REGEXP}    : Regex pattern to match against the Runtime's global account field, e.g. "CA50125541TID000000000741207136", "CA.*"
-  subaccount={REGEXP} : Regex pattern to match against the Runtime's subaccount field, e.g. "0d20e315-d0b4-48a2-9512-49bc8eb03cd1"
-  region={REGEXP}     : Regex pattern to match against the Runtime's provider region field, e.g. "europe|eu-"
-  runtime-id={ID}     : Specific Runtime by Runtime ID
-  plan={NAME}         : Name of the Runtime's service plan. The possible values are: azure, azure_lite, aws, trial, gcp, openstack
-  shoot={NAME}        : Specific Runtime by Shoot cluster name
-  instance-id={ID}    : Specific instance by Instance ID`)
+  all                             : All Runtimes provisioned successfully and not deprovisioning
+  account={REGEXP}                : Regex pattern to match against the Runtime's global account field, e.g. "CA50125541TID000000000741207136", "CA.*"
+  subaccount={REGEXP}             : Regex pattern to match against the Runtime's subaccount field, e.g. "0d20e315-d0b4-48a2-9512-49bc8eb03cd1"
+  region={REGEXP}                 : Regex pattern to match against the Runtime's provider region field, e.g. "europe|eu-"
+  runtime-id={ID}                 : Specific Runtime by Runtime ID
+  plan={NAME}                     : Name of the Runtime's service plan. The possible values are: azure, azure_lite, aws, trial, gcp, openstack
+  shoot={NAME}                    : Specific Runtime by Shoot cluster name
+  instance-id={ID}                : Specific instance by Instance ID
+  kyma-version={CONSTRAINT}       : Semantic version constraint to match against the Runtime's current Kyma version, e.g. ">=2.0.0,<2.4.0", "~1.24"
+  kubernetes-version={CONSTRAINT} : Semantic version constraint to match against the Kubernetes version of the Runtime's Shoot cluster, e.g. "<1.22"
+  provider={NAME}                 : Provider type of the Runtime's Shoot cluster. The possible values are: aws, azure, gcp, openstack
+  machine-type={NAME}             : Machine type of a worker pool of the Runtime's Shoot cluster, e.g. "m5.2xlarge"
+  created-after={TIME}            : Runtimes of instances created after the given time, in RFC3339 or YYYY-MM-DD format
+  created-before={TIME}           : Runtimes of instances created before the given time, in RFC3339 or YYYY-MM-DD format
+  shoot-label={KEY}={VALUE}       : Label of the Runtime's Shoot cluster. You can specify this selector multiple times`)
 	cmd.Flags().StringArrayVarP(targetExcludeInputs, "target-exclude", "e", nil,
 		`List of Runtime target specifiers to exclude. You can specify this option multiple times.
 A target specifier is a comma-separated list of the selectors described under the --target option.`)
@@ -225,7 +241,7 @@ func ValidateTransformRuntimeTargetOpts(targetInputs []string, targetExcludeInpu
 
 func parseRuntimeTarget(targetInput string, targets *[]orchestration.RuntimeTarget, include bool) error {
 	target := orchestration.RuntimeTarget{}
-	selectors := strings.Split(targetInput, ",")
+	selectors := splitRuntimeTargetSelectors(targetInput)
 	var flagName string
 	if include {
 		flagName = "--target"
@@ -234,7 +250,7 @@ func parseRuntimeTarget(targetInput string, targets *[]orchestration.RuntimeTarg
 	}
 
 	for _, selector := range selectors {
-		sv := strings.Split(selector, "=")
+		sv := strings.SplitN(selector, "=", 2)
 		selectorKey := sv[0]
 		var selectorValue string
 		if len(sv) > 1 {
@@ -272,15 +288,83 @@ func parseRuntimeTarget(targetInput string, targets *[]orchestration.RuntimeTarg
 			}
 		case shootTarget:
 			target.Shoot = selectorValue
+		case kymaVersionTarget:
+			target.KymaVersion = selectorValue
+		case kubernetesVersionTarget:
+			target.KubernetesVersion = selectorValue
+		case providerTarget:
+			target.Provider = selectorValue
+		case machineTypeTarget:
+			target.MachineType = selectorValue
+		case createdAfterTarget, createdBeforeTarget:
+			createdAt, err := parseRuntimeTargetTime(selectorValue)
+			if err != nil {
+				return fmt.Errorf("invalid value for selector: %s %s=%s", flagName, selectorKey, selectorValue)
+			}
+			if selectorKey == createdAfterTarget {
+				target.CreatedAfter = &createdAt
+			} else {
+				target.CreatedBefore = &createdAt
+			}
+		case shootLabelTarget:
+			kv := strings.SplitN(selectorValue, "=", 2)
+			if len(kv) != 2 || kv[0] == "" {
+				return fmt.Errorf("invalid value for selector: %s %s=%s (%s={KEY}={VALUE})", flagName, selectorKey, selectorValue, selectorKey)
+			}
+			if target.ShootLabels == nil {
+				target.ShootLabels = map[string]string{}
+			}
+			target.ShootLabels[kv[0]] = kv[1]
 		default:
 			return fmt.Errorf("invalid selector: %s %s", flagName, selectorKey)
 		}
 	}
 
+	err := orchestration.ValidateRuntimeTarget(target)
+	if err != nil {
+		return fmt.Errorf("invalid %s %s: %s", flagName, targetInput, err)
+	}
+
 	*targets = append(*targets, target)
 	return nil
 }
 
+// splitRuntimeTargetSelectors splits the target specifier by commas, keeping the commas of the version constraints,
+// e.g. "kyma-version=>=2.0.0,<2.4.0,plan=azure" is split into "kyma-version=>=2.0.0,<2.4.0" and "plan=azure"
+func splitRuntimeTargetSelectors(targetInput string) []string {
+	selectors := []string{}
+	for _, s := range strings.Split(targetInput, ",") {
+		n := len(selectors)
+		if n > 0 && isVersionSelector(selectors[n-1]) && !isRuntimeTargetSelector(s) {
+			selectors[n-1] = selectors[n-1] + "," + s
+			continue
+		}
+		selectors = append(selectors, s)
+	}
+	return selectors
+}
+
+func isVersionSelector(selector string) bool {
+	return strings.HasPrefix(selector, kymaVersionTarget+"=") || strings.HasPrefix(selector, kubernetesVersionTarget+"=")
+}
+
+func isRuntimeTargetSelector(selector string) bool {
+	switch strings.SplitN(selector, "=", 2)[0] {
+	case orchestration.TargetAll, accountTarget, subaccountTarget, regionTarget, runtimeIDTarget, instanceIDTarget, planTarget, shootTarget,
+		kymaVersionTarget, kubernetesVersionTarget, providerTarget, machineTypeTarget, createdAfterTarget, createdBeforeTarget, shootLabelTarget:
+		return true
+	}
+	return false
+}
+
+func parseRuntimeTargetTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Parse("2006-01-02", value)
+	}
+	return t, nil
+}
+
 func checkMissingRuntimeTargetSelector(selectorKey, selectorValue string, flagName string) error {
 
 	if selectorKey != orchestration.TargetAll && selectorValue == "" {
@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -18,6 +19,9 @@ import (
 
 const (
 	cancelCommand     = "cancel"
+	pauseCommand      = "pause"
+	resumeCommand     = "resume"
+	rollbackCommand   = "rollback"
 	retryCommand      = "retry"
 	operationsCommand = "operations"
 	opsCommand        = "ops"
@@ -43,6 +47,7 @@ var cliStates = map[string]string{
 	"canceled":   orchestration.Canceled,
 	"canceling":  orchestration.Canceling,
 	"retrying":   orchestration.Retrying,
+	"paused":     orchestration.Paused,
 }
 
 var orchestrationColumns = []printer.Column{
@@ -109,6 +114,12 @@ Description:      {{.Description}}
 Strategy:         {{.Parameters.Strategy.Type}}
 Schedule:         {{.Parameters.Strategy.Schedule}}
 Workers:          {{.Parameters.Strategy.Parallel.Workers}}
+{{- with .Parameters.Strategy.StartAt }}
+Start At:         {{.}}
+{{- end }}
+{{- if eq .Parameters.Strategy.Type "waves" }}
+Waves:            canary {{.Parameters.Strategy.Waves.Canary}}, factor {{.Parameters.Strategy.Waves.Factor}}, soak time {{.Parameters.Strategy.Waves.SoakTime}}, success threshold {{.Parameters.Strategy.Waves.SuccessThreshold}}%
+{{- end }}
 {{- if eq .Type "upgradeKyma" }}
 Kyma Version:     {{with .Parameters.Kyma}}{{.Version}}{{end}}
 {{- else if eq .Type "upgradeCluster" }}
@@ -162,7 +173,7 @@ Message:            {{ .Msg }}
 func NewOrchestrationCmd() *cobra.Command {
 	cmd := OrchestrationCommand{}
 	cobraCmd := &cobra.Command{
-		Use:     "orchestrations [id] [ops|operations] [cancel] [retry]",
+		Use:     "orchestrations [id] [ops|operations] [cancel] [retry] [pause] [resume] [rollback]",
 		Aliases: []string{"orchestration", "o"},
 		Short:   "Displays Kyma Control Plane (KCP) orchestrations.",
 		Long: `Displays KCP orchestrations and their primary attributes, such as identifiers, type, state, parameters, or Runtime operations.
@@ -172,6 +183,9 @@ The command has the following modes:
       If the optional --operation flag is provided, it displays details of the specified Runtime operation within the orchestration.
   - When specifying an orchestration ID and ` + "`operations` or `ops`" + ` as arguments. In this mode, the command displays the Runtime operations for the given orchestration.
   - When specifying an orchestration ID and ` + "`cancel`" + ` as arguments. In this mode, the command cancels the orchestration and all pending Runtime operations.
+  - When specifying an orchestration ID and ` + "`pause`" + ` as arguments. In this mode, the command pauses the orchestration in progress. Runtime operations in progress are completed, pending Runtime operations are not started until the orchestration is resumed.
+  - When specifying an orchestration ID and ` + "`resume`" + ` as arguments. In this mode, the command resumes the paused orchestration.
+  - When specifying an orchestration ID and ` + "`rollback`" + ` as arguments. In this mode, the command creates a new orchestration which rolls back the Kyma version and components of all Runtimes upgraded by the given finished Kyma upgrade orchestration.
   - When specifying an orchestration ID and ` + "`retry`" + ` as arguments. In this mode, the command retries all failed Runtime operations of the given orchestration. The ` + "`retry` " + `command only applies to the failed or in progress orchestration.
       If the optional --operation flag is provided, it retries the specified Runtime operation of the given orchestration.`,
 		Example: `  kcp orchestrations --state inprogress                                              Display all orchestrations which are in progress.
@@ -181,6 +195,9 @@ The command has the following modes:
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 --operation OID1,OID2       Display details of the specified Runtime operation within the orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 operations                  Display the operations of the given orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 cancel                      Cancel the given orchestration.
+  kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 pause                       Pause the given orchestration.
+  kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 resume                      Resume the given paused orchestration.
+  kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 rollback                    Roll back Runtimes upgraded by the given orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 retry                       Retry all failed operations of the given orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 retry --operation OID1,OID2 Retry the given operations of the given orchestration.`,
 		Args:    cobra.MaximumNArgs(2),
@@ -249,6 +266,12 @@ func (cmd *OrchestrationCommand) Run(args []string) error {
 			return cmd.cancelOrchestration(args[0])
 		case retryCommand:
 			return cmd.retryOrchestration(args[0])
+		case pauseCommand:
+			return cmd.pauseOrchestration(args[0])
+		case resumeCommand:
+			return cmd.resumeOrchestration(args[0])
+		case rollbackCommand:
+			return cmd.rollbackOrchestration(args[0])
 		case operationsCommand, opsCommand:
 			return cmd.showOperations(args[0])
 		}
@@ -279,7 +302,7 @@ func (cmd *OrchestrationCommand) Validate(args []string) error {
 	if len(args) == 2 {
 		cmd.subCommand = args[1]
 		switch cmd.subCommand {
-		case cancelCommand, retryCommand, operationsCommand, opsCommand:
+		case cancelCommand, retryCommand, pauseCommand, resumeCommand, rollbackCommand, operationsCommand, opsCommand:
 		default:
 			return fmt.Errorf("invalid subcommand: %s", cmd.subCommand)
 		}
@@ -429,6 +452,68 @@ func (cmd *OrchestrationCommand) cancelOrchestration(orchestrationID string) err
 
 }
 
+func (cmd *OrchestrationCommand) pauseOrchestration(orchestrationID string) error {
+	sr, err := cmd.client.GetOrchestration(orchestrationID)
+	if err != nil {
+		return errors.Wrap(err, "while getting orchestration")
+	}
+	switch sr.State {
+	case orchestration.Paused:
+		fmt.Println("Orchestration is already paused.")
+		return nil
+	case orchestration.InProgress:
+	default:
+		return fmt.Errorf("orchestration is %s, only orchestration in progress can be paused", sr.State)
+	}
+
+	fmt.Printf("%d pending operation(s) will not be started, %d in progress operation(s) will still be completed.\n", sr.OperationStats[orchestration.Pending], sr.OperationStats[orchestration.InProgress])
+	return cmd.client.PauseOrchestration(orchestrationID)
+}
+
+func (cmd *OrchestrationCommand) resumeOrchestration(orchestrationID string) error {
+	sr, err := cmd.client.GetOrchestration(orchestrationID)
+	if err != nil {
+		return errors.Wrap(err, "while getting orchestration")
+	}
+	if sr.State != orchestration.Paused {
+		return fmt.Errorf("orchestration is %s, only paused orchestration can be resumed", sr.State)
+	}
+
+	return cmd.client.ResumeOrchestration(orchestrationID)
+}
+
+func (cmd *OrchestrationCommand) rollbackOrchestration(orchestrationID string) error {
+	sr, err := cmd.client.GetOrchestration(orchestrationID)
+	if err != nil {
+		return errors.Wrap(err, "while getting orchestration")
+	}
+	if sr.Type != orchestration.UpgradeKymaOrchestration {
+		return fmt.Errorf("orchestration of %s type cannot be rolled back", sr.Type)
+	}
+	switch sr.State {
+	case orchestration.Succeeded, orchestration.Failed, orchestration.Canceled:
+	default:
+		return fmt.Errorf("orchestration is %s, only finished orchestration can be rolled back", sr.State)
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	fmt.Printf("%d upgraded Runtime(s) will be rolled back to the Kyma version and components from before the orchestration.\n", sr.OperationStats[orchestration.Succeeded]+sr.OperationStats[orchestration.Failed])
+	fmt.Print("Do you want to continue? (Y/N) ")
+	scanner.Scan()
+	if scanner.Text() != "Y" {
+		fmt.Println("Aborted.")
+		return nil
+	}
+
+	ur, err := cmd.client.RollbackOrchestration(orchestrationID, orchestration.RollbackRequest{})
+	if err != nil {
+		return errors.Wrap(err, "while triggering rollback orchestration")
+	}
+	fmt.Println("Rollback orchestration ID:", ur.OrchestrationID)
+
+	return nil
+}
+
 func (cmd *OrchestrationCommand) retryOrchestration(orchestrationID string) error {
 	sr, err := cmd.client.GetOrchestration(orchestrationID)
 	if err != nil {
@@ -473,6 +558,9 @@ func orchestrationType(obj interface{}) string {
 	if sr.Type == orchestration.UpgradeClusterOrchestration {
 		return "cluster upgrade"
 	}
+	if sr.Type == orchestration.RollbackKymaOrchestration {
+		return "kyma rollback"
+	}
 	return string(sr.Type)
 }
 
@@ -508,6 +596,30 @@ func orchestrationTarget(t orchestration.RuntimeTarget) string {
 	if t.Shoot != "" {
 		targets = append(targets, fmt.Sprintf("shoot = %s", t.Shoot))
 	}
+	if t.KymaVersion != "" {
+		targets = append(targets, fmt.Sprintf("kyma-version = %s", t.KymaVersion))
+	}
+	if t.KubernetesVersion != "" {
+		targets = append(targets, fmt.Sprintf("kubernetes-version = %s", t.KubernetesVersion))
+	}
+	if t.Provider != "" {
+		targets = append(targets, fmt.Sprintf("provider = %s", t.Provider))
+	}
+	if t.MachineType != "" {
+		targets = append(targets, fmt.Sprintf("machine-type = %s", t.MachineType))
+	}
+	if t.CreatedAfter != nil {
+		targets = append(targets, fmt.Sprintf("created-after = %s", t.CreatedAfter.Format(time.RFC3339)))
+	}
+	if t.CreatedBefore != nil {
+		targets = append(targets, fmt.Sprintf("created-before = %s", t.CreatedBefore.Format(time.RFC3339)))
+	}
+	labels := make([]string, 0, len(t.ShootLabels))
+	for key, value := range t.ShootLabels {
+		labels = append(labels, fmt.Sprintf("shoot label = %s=%s", key, value))
+	}
+	sort.Strings(labels)
+	targets = append(targets, labels...)
 
 	return strings.Join(targets, ",")
 }
@@ -548,6 +660,9 @@ func orchestrationDetails(obj interface{}) string {
 	} else {
 		sb.WriteString("-")
 	}
+	if sr.State == orchestration.Paused {
+		sb.WriteString(", " + sr.Description)
+	}
 
 	return sb.String()
 }
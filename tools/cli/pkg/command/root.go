@@ -51,9 +51,11 @@ See the **Global Options** section of each command for the description of these
 	cmd.AddCommand(
 		NewLoginCmd(),
 		NewRuntimeCmd(),
+		NewKEBOperationCmd(),
 		NewOrchestrationCmd(),
 		NewKubeconfigCmd(),
 		NewUpgradeCmd(),
+		NewRunCmd(),
 		NewTaskRunCmd(),
 		NewCompletionCommand(),
 		NewReconciliationCmd(),
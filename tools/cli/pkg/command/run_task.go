@@ -0,0 +1,112 @@
+package command
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// RunTaskCommand represents an execution of the kcp run task command
+type RunTaskCommand struct {
+	UpgradeCommand
+	cobraCmd      *cobra.Command
+	jobFile       string
+	manifestFiles []string
+	taskTimeout   string
+}
+
+// NewRunCmd constructs the run command and all subcommands under the run command
+func NewRunCmd() *cobra.Command {
+	cobraCmd := &cobra.Command{
+		Use:   "run",
+		Short: "Runs tasks on Kyma Runtimes.",
+		Long:  "Runs tasks on Kyma Runtimes within orchestrations of Kyma Control Plane.",
+	}
+
+	cobraCmd.AddCommand(NewRunTaskCmd())
+	return cobraCmd
+}
+
+// NewRunTaskCmd constructs a new instance of RunTaskCommand and configures it in terms of a cobra.Command
+func NewRunTaskCmd() *cobra.Command {
+	cmd := RunTaskCommand{UpgradeCommand: UpgradeCommand{}}
+	cobraCmd := &cobra.Command{
+		Use:   "task --target {TARGET SPEC} ... [--target-exclude {TARGET SPEC} ...] [--job {FILE}] [--manifest {FILE} ...]",
+		Short: "Runs a Kubernetes Job or applies manifests on one or more Kyma Runtimes.",
+		Long: `Runs a Kubernetes Job and/or applies Kubernetes manifests on targets of Runtimes.
+The task is performed by Kyma Control Plane (KCP) within a new orchestration asynchronously, so unlike "kcp taskrun" it does not depend on the local machine. The ID of the orchestration is returned by the command upon success.
+The targets of Runtimes are specified via the --target and --target-exclude options. At least one --target must be specified.
+The manifests are applied first, then the Job is created and KCP waits until it completes or fails. The result of every Runtime is recorded in the operations of the orchestration.`,
+		Example: `  kcp run task --target all --job cleanup-job.yaml                             Run the Job defined in cleanup-job.yaml on all Runtimes.
+  kcp run task --target "account=CA.*" --manifest config.yaml                  Apply the manifests from config.yaml on Runtimes of all global accounts starting with CA.
+  kcp run task --target all --job check.yaml --task-timeout 15m --schedule maintenancewindow
+                                                                               Run the Job on all Runtimes in their next respective maintenance window hours, failing the Runtimes where it does not finish within 15 minutes.`,
+
+		PreRunE: func(_ *cobra.Command, _ []string) error { return cmd.Validate() },
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+
+	cmd.cobraCmd = cobraCmd
+	cmd.UpgradeCommand.SetUpgradeOpts(cobraCmd)
+	cobraCmd.Flags().StringVar(&cmd.jobFile, "job", "", "Path to the file with the batch/v1 Job to run on every Runtime.")
+	cobraCmd.Flags().StringSliceVar(&cmd.manifestFiles, "manifest", nil, "Path to the file with the Kubernetes manifests to apply on every Runtime. The option can be specified multiple times.")
+	cobraCmd.Flags().StringVar(&cmd.taskTimeout, "task-timeout", "", "Time to wait for the Job to finish on a Runtime, for example \"30m\". By default the timeout is configured by Kyma Environment Broker (KEB).")
+
+	return cobraCmd
+}
+
+// Validate checks the input parameters of the run task command and reads the task files
+func (cmd *RunTaskCommand) Validate() error {
+	err := cmd.ValidateTransformUpgradeOpts()
+	if err != nil {
+		return err
+	}
+	if cmd.jobFile == "" && len(cmd.manifestFiles) == 0 {
+		return errors.New("at least one of --job or --manifest must be specified")
+	}
+	if cmd.taskTimeout != "" {
+		if _, err := time.ParseDuration(cmd.taskTimeout); err != nil {
+			return fmt.Errorf("invalid value for task-timeout: %s", cmd.taskTimeout)
+		}
+	}
+
+	task := &orchestration.TaskParameters{Timeout: cmd.taskTimeout}
+	if cmd.jobFile != "" {
+		job, err := ioutil.ReadFile(cmd.jobFile)
+		if err != nil {
+			return errors.Wrapf(err, "while reading job file %s", cmd.jobFile)
+		}
+		task.Job = string(job)
+	}
+	for _, file := range cmd.manifestFiles {
+		manifest, err := ioutil.ReadFile(file)
+		if err != nil {
+			return errors.Wrapf(err, "while reading manifest file %s", file)
+		}
+		task.Manifests = append(task.Manifests, string(manifest))
+	}
+	cmd.orchestrationParams.Task = task
+
+	return nil
+}
+
+// Run executes the run task command
+func (cmd *RunTaskCommand) Run() error {
+	cmd.log = logger.New()
+	client := orchestration.NewClient(cmd.cobraCmd.Context(), GlobalOpts.KEBAPIURL(), CLICredentialManager(cmd.log))
+	if cmd.preview {
+		return cmd.showPreview(client, orchestration.RunTaskOrchestration)
+	}
+	ur, err := client.RunTask(cmd.orchestrationParams)
+	if err != nil {
+		return errors.Wrap(err, "while triggering run task")
+	}
+	fmt.Println("OrchestrationID:", ur.OrchestrationID)
+
+	return nil
+}
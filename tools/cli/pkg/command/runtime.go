@@ -20,7 +20,9 @@ type RuntimeCommand struct {
 	output   string
 	params   runtime.ListParameters
 	states   []string
+	sort     string
 	opDetail bool
+	watch    bool
 	display  Display
 }
 
@@ -59,6 +61,35 @@ var tableColumns = []printer.Column{
 	},
 }
 
+var watchTableColumns = []printer.Column{
+	{
+		Header:         "EVENT",
+		FieldFormatter: func(obj interface{}) string { return string(obj.(runtime.WatchEvent).Type) },
+	},
+	{
+		Header:         "INSTANCE ID",
+		FieldFormatter: func(obj interface{}) string { return obj.(runtime.WatchEvent).Runtime.InstanceID },
+	},
+	{
+		Header:         "SHOOT",
+		FieldFormatter: func(obj interface{}) string { return obj.(runtime.WatchEvent).Runtime.ShootName },
+	},
+	{
+		Header:         "PLAN",
+		FieldFormatter: func(obj interface{}) string { return obj.(runtime.WatchEvent).Runtime.ServicePlanName },
+	},
+	{
+		Header: "STATE",
+		FieldFormatter: func(obj interface{}) string {
+			ev := obj.(runtime.WatchEvent)
+			if ev.Type == runtime.WatchEventDeleted {
+				return ""
+			}
+			return runtimeStatus(*ev.Runtime)
+		},
+	},
+}
+
 // NewRuntimeCmd constructs a new instance of RuntimeCommand and configures it in terms of a cobra.Command
 func NewRuntimeCmd() *cobra.Command {
 	cmd := RuntimeCommand{}
@@ -74,7 +105,9 @@ The command supports filtering Runtimes based on various attributes. See the lis
   kcp runtimes -c bbc3ee7 -o custom="INSTANCE ID:instanceID,SHOOTNAME:shootName"
                                                          Display the custom fields about one Runtime identified by a Shoot name.
   kcp runtimes -o custom="INSTANCE ID:instanceID,SHOOTNAME:shootName,runtimeID:runtimeID,STATUS:{status.provisioning}"
-                                                         Display all Runtimes with specific custom fields.`,
+                                                         Display all Runtimes with specific custom fields.
+  kcp runtimes --account CA4836781TID000000000123456789 --watch
+                                                         Display all Runtimes of a given global account and then their changes.`,
 		PreRunE: func(_ *cobra.Command, _ []string) error { return cmd.Validate() },
 		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
 	}
@@ -90,9 +123,11 @@ The command supports filtering Runtimes based on various attributes. See the lis
 	cobraCmd.Flags().StringSliceVarP(&cmd.params.Regions, "region", "R", nil, "Filter by provider region. You can provide multiple values, either separated by a comma (e.g. westeurope,northeurope), or by specifying the option multiple times.")
 	cobraCmd.Flags().StringSliceVarP(&cmd.params.Plans, "plan", "p", nil, "Filter by service plan name. You can provide multiple values, either separated by a comma (e.g. azure,trial), or by specifying the option multiple times.")
 	cobraCmd.Flags().StringSliceVarP(&cmd.states, "state", "S", nil, "Filter by Runtime state. The possible values are: succeeded, failed, error, provisioning, deprovisioning, upgrading, suspended, all. Suspended Runtimes are filtered out unless the \"all\" or \"suspended\" values are provided. You can provide multiple values, either separated by a comma (e.g. succeeded,failed), or by specifying the option multiple times.")
+	cobraCmd.Flags().StringVar(&cmd.sort, "sort", "", "Sort the Runtimes by the given field. The possible values are: createdAt, globalAccountID, state. By default, the Runtimes are sorted by the creation time.")
 	cobraCmd.Flags().BoolVar(&cmd.opDetail, "ops", false, "Get all operations for the runtimes instead of just querying the last operation.")
 	cobraCmd.Flags().BoolVar(&cmd.params.KymaConfig, "kyma-config", false, "Get all Kyma configuration details for the selected runtimes.")
 	cobraCmd.Flags().BoolVar(&cmd.params.ClusterConfig, "cluster-config", false, "Get all cluster configuration details for the selected runtimes.")
+	cobraCmd.Flags().BoolVarP(&cmd.watch, "watch", "w", false, "After listing the Runtimes, watch for changes of the Runtimes until the command is interrupted.")
 
 	return cobraCmd
 }
@@ -111,6 +146,41 @@ func (cmd *RuntimeCommand) Run() error {
 	if err != nil {
 		return errors.Wrap(err, "while printing runtimes")
 	}
+	if cmd.watch {
+		return cmd.watchRuntimes(client, rp.ResourceVersion)
+	}
+
+	return nil
+}
+
+// watchRuntimes prints changes of runtimes, the watch is resumed from the last received version when the server closes the stream
+func (cmd *RuntimeCommand) watchRuntimes(client runtime.Client, resourceVersion string) error {
+	ctx := cmd.cobraCmd.Context()
+	var printEvent func(event runtime.WatchEvent) error
+	switch {
+	case cmd.output == jsonOutput:
+		jp := printer.NewJSONPrinter("  ")
+		printEvent = func(event runtime.WatchEvent) error { return jp.PrintObj(event) }
+	default:
+		tp, err := printer.NewTablePrinter(watchTableColumns, false)
+		if err != nil {
+			return err
+		}
+		printEvent = func(event runtime.WatchEvent) error { return tp.PrintObj(event) }
+	}
+
+	for ctx.Err() == nil {
+		err := client.WatchRuntimes(ctx, cmd.params, resourceVersion, func(event runtime.WatchEvent) error {
+			resourceVersion = event.ResourceVersion
+			if event.Type == runtime.WatchEventBookmark {
+				return nil
+			}
+			return printEvent(event)
+		})
+		if err != nil && ctx.Err() == nil {
+			return errors.Wrap(err, "while watching runtimes")
+		}
+	}
 
 	return nil
 }
@@ -133,6 +203,14 @@ func (cmd *RuntimeCommand) Validate() error {
 		}
 	}
 
+	switch sort := runtime.SortField(cmd.sort); sort {
+	case "":
+	case runtime.SortByCreatedAt, runtime.SortByGlobalAccountID, runtime.SortByState:
+		cmd.params.Sort = sort
+	default:
+		return fmt.Errorf("invalid value for sort: %s", cmd.sort)
+	}
+
 	cmd.params.OperationDetail = runtime.LastOperation
 	if cmd.opDetail {
 		cmd.params.OperationDetail = runtime.AllOperation
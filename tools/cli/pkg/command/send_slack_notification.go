@@ -35,7 +35,7 @@ const KCP_Prefix = "kcp"
 const PROD_Postfix = "-prod"
 
 var upgradeOpts = []string{"parallel-workers", "schedule", "strategy",
-	"target", "target-exclude", "verbose", "version"}
+	"target", "target-exclude", "verbose", "version", "waves-canary", "waves-factor", "waves-soak-time", "waves-success-threshold", "max-failures", "max-failure-ratio", "start-at"}
 
 // SendSlackNotification will post message including attachments to slackhookUrl.
 func SendSlackNotification(title string, cobraCmd *cobra.Command, output string) error {
@@ -110,7 +110,8 @@ func revertUpgradeOpts(title string, cobraCmd *cobra.Command) string {
 		for _, col := range upgradeOpts {
 			if flag.Name == col && flag.Value.String() != "" {
 				if (flag.Name == "strategy" && flag.Value.String() == "parallel") ||
-					((flag.Name == "verbose" || flag.Name == "parallel-workers") && flag.Value.String() == "0") ||
+					((flag.Name == "verbose" || flag.Name == "parallel-workers" || flag.Name == "waves-factor" || flag.Name == "waves-success-threshold" ||
+						flag.Name == "max-failures" || flag.Name == "max-failure-ratio") && flag.Value.String() == "0") ||
 					((flag.Name == "target" || flag.Name == "target-exclude") && flag.Value.String() == "[]") {
 					continue
 				} else if flag.Name == "target" || flag.Name == "target-exclude" {
@@ -130,7 +130,7 @@ func (cmd *TaskRunCommand) Run(args []string) error {
 	}
 
 	mgr := NewRuntimeTaskMakager(cmd, operations)
-	strategy := strategies.NewParallelOrchestrationStrategy(mgr, cmd.log, 0)
+	strategy := strategies.NewParallelOrchestrationStrategy(mgr, nil, nil, cmd.log, 0)
 	execID, err := strategy.Execute(operations, orchestration.StrategySpec{
 		Type:     orchestration.ParallelStrategy,
 		Schedule: orchestration.Immediate,
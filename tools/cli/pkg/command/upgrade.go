@@ -2,11 +2,15 @@ package command
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/printer"
 )
 
 // UpgradeCommand is the base type of all subcommands under the upgrade command. The type holds common attributes and methods inherited by all subcommands
@@ -16,6 +20,11 @@ type UpgradeCommand struct {
 	targetExcludeInputs []string
 	strategy            string
 	schedule            string
+	startAt             string
+	preview             bool
+	operationDuration   string
+	successThreshold    int
+	flags               *pflag.FlagSet
 	orchestrationParams orchestration.Parameters
 }
 
@@ -42,10 +51,20 @@ func NewUpgradeCmd() *cobra.Command {
 // SetUpgradeOpts configures the upgrade specific options on the given command
 func (cmd *UpgradeCommand) SetUpgradeOpts(cobraCmd *cobra.Command) {
 	SetRuntimeTargetOpts(cobraCmd, &cmd.targetInputs, &cmd.targetExcludeInputs)
-	cobraCmd.Flags().StringVar(&cmd.strategy, "strategy", string(orchestration.ParallelStrategy), "Orchestration strategy to use.")
-	cobraCmd.Flags().IntVar(&cmd.orchestrationParams.Strategy.Parallel.Workers, "parallel-workers", 0, "Number of parallel workers to use in parallel orchestration strategy. By default the amount of workers will be auto-selected on control plane server side.")
+	cobraCmd.Flags().StringVar(&cmd.strategy, "strategy", string(orchestration.ParallelStrategy), "Orchestration strategy to use. Possible values: \"parallel\", \"waves\".")
+	cobraCmd.Flags().IntVar(&cmd.orchestrationParams.Strategy.Parallel.Workers, "parallel-workers", 0, "Number of parallel workers to use in parallel orchestration strategy, or in every wave of waves orchestration strategy. By default the amount of workers will be auto-selected on control plane server side.")
+	cobraCmd.Flags().StringVar(&cmd.orchestrationParams.Strategy.Waves.Canary, "waves-canary", "", "Size of the first wave in waves orchestration strategy, a number of Runtimes (for example, \"5\") or a percentage of all Runtimes (for example, \"10%\"). By default the first wave has one Runtime.")
+	cobraCmd.Flags().IntVar(&cmd.orchestrationParams.Strategy.Waves.Factor, "waves-factor", 0, "Multiplier of the size of every next wave in waves orchestration strategy. By default every wave is twice as big as the previous one.")
+	cobraCmd.Flags().StringVar(&cmd.orchestrationParams.Strategy.Waves.SoakTime, "waves-soak-time", "", "Time to wait after a wave before the next wave starts in waves orchestration strategy, for example \"30m\".")
+	cobraCmd.Flags().IntVar(&cmd.successThreshold, "waves-success-threshold", 0, "Minimal percentage of succeeded operations of a wave required to start the next wave in waves orchestration strategy. By default all operations of a wave must succeed. Set to 0 to start the next wave regardless of the results.")
+	cobraCmd.Flags().IntVar(&cmd.orchestrationParams.Strategy.MaxFailures, "max-failures", 0, "Number of failed operations above which the orchestration is paused. By default there is no limit.")
+	cobraCmd.Flags().Float64Var(&cmd.orchestrationParams.Strategy.MaxFailureRatio, "max-failure-ratio", 0, "Ratio of failed operations to all operations of the orchestration above which the orchestration is paused, for example 0.1. By default there is no limit.")
 	cobraCmd.Flags().StringVar(&cmd.schedule, "schedule", "", "Orchestration schedule to use. Possible values: \"immediate\", \"maintenancewindow\". By default the schedule will be auto-selected on control plane server side.")
+	cobraCmd.Flags().StringVar(&cmd.startAt, "start-at", "", "Time in RFC3339 format, for example \"2026-11-02T08:00:00Z\", before which the orchestration does not start. By default the orchestration starts immediately.")
 	cobraCmd.Flags().BoolVar(&cmd.orchestrationParams.DryRun, "dry-run", false, "Perform the orchestration without executing the actual upgrade operations for the Runtimes. The details can be obtained using the \"kcp orchestrations\" command.")
+	cobraCmd.Flags().BoolVar(&cmd.preview, "preview", false, "Display the targeted Runtimes and the estimated schedule of the orchestration without creating it.")
+	cobraCmd.Flags().StringVar(&cmd.operationDuration, "preview-operation-duration", "", "Estimated duration of a single Runtime operation used by --preview, for example \"45m\". By default it is estimated by the control plane server side.")
+	cmd.flags = cobraCmd.Flags()
 }
 
 // ValidateTransformUpgradeOpts checks in the input upgrade options, and transforms them for internal usage
@@ -62,9 +81,28 @@ func (cmd *UpgradeCommand) ValidateTransformUpgradeOpts() error {
 		return fmt.Errorf("invalid value for schedule: %s. Check kcp upgrade --help for more information", cmd.schedule)
 	}
 
+	// Validate start time
+	if cmd.startAt != "" {
+		startAt, err := time.Parse(time.RFC3339, cmd.startAt)
+		if err != nil {
+			return fmt.Errorf("invalid value for start-at: %s. The time must be in RFC3339 format", cmd.startAt)
+		}
+		cmd.orchestrationParams.Strategy.StartAt = &startAt
+	}
+
+	if cmd.operationDuration != "" && !cmd.preview {
+		return errors.New("--preview-operation-duration should only be used together with --preview")
+	}
+
+	// The success threshold is sent only when it is set, so that 0 is not replaced with the default
+	if cmd.flags != nil && cmd.flags.Changed("waves-success-threshold") {
+		successThreshold := cmd.successThreshold
+		cmd.orchestrationParams.Strategy.Waves.SuccessThreshold = &successThreshold
+	}
+
 	// Validate strategy type
 	switch cmd.strategy {
-	case string(orchestration.ParallelStrategy):
+	case string(orchestration.ParallelStrategy), string(orchestration.WavesStrategy):
 		cmd.orchestrationParams.Strategy.Type = orchestration.StrategyType(cmd.strategy)
 	default:
 		return fmt.Errorf("invalid value for strategy: %s", cmd.strategy)
@@ -72,3 +110,113 @@ func (cmd *UpgradeCommand) ValidateTransformUpgradeOpts() error {
 
 	return nil
 }
+
+var previewRuntimeColumns = []printer.Column{
+	{
+		Header:    "SHOOT",
+		FieldSpec: "{.ShootName}",
+	},
+	{
+		Header:    "RUNTIME ID",
+		FieldSpec: "{.RuntimeID}",
+	},
+	{
+		Header:    "PLAN",
+		FieldSpec: "{.Plan}",
+	},
+	{
+		Header:    "REGION",
+		FieldSpec: "{.Region}",
+	},
+	{
+		Header:    "KYMA VERSION",
+		FieldSpec: "{.KymaVersion}",
+	},
+	{
+		Header:    "K8S VERSION",
+		FieldSpec: "{.KubernetesVersion}",
+	},
+	{
+		Header:         "MAINTENANCE WINDOW",
+		FieldFormatter: previewMaintenanceWindow,
+	},
+	{
+		Header:         "ESTIMATED START",
+		FieldFormatter: previewEstimatedStart,
+	},
+	{
+		Header:         "ESTIMATED END",
+		FieldFormatter: previewEstimatedEnd,
+	},
+}
+
+var previewBreakdownColumns = []printer.Column{
+	{
+		Header:    "PLAN",
+		FieldSpec: "{.Plan}",
+	},
+	{
+		Header:    "REGION",
+		FieldSpec: "{.Region}",
+	},
+	{
+		Header:    "RUNTIMES",
+		FieldSpec: "{.Count}",
+	},
+}
+
+// showPreview displays the Runtimes targeted by the orchestration of the given type and its estimated schedule
+func (cmd *UpgradeCommand) showPreview(client orchestration.Client, orchestrationType orchestration.Type) error {
+	pr, err := client.PreviewOrchestration(orchestration.PreviewRequest{
+		Type:              orchestrationType,
+		Parameters:        cmd.orchestrationParams,
+		OperationDuration: cmd.operationDuration,
+	})
+	if err != nil {
+		return errors.Wrap(err, "while previewing orchestration")
+	}
+
+	fmt.Printf("Runtimes:           %d\n", pr.Count)
+	if pr.Count == 0 {
+		return nil
+	}
+	fmt.Printf("Operation Duration: %s\n", pr.OperationDuration)
+	fmt.Printf("Estimated Start:    %s\n", pr.EstimatedStart.Format(previewTimeFormat))
+	fmt.Printf("Estimated End:      %s\n\n", pr.EstimatedEnd.Format(previewTimeFormat))
+
+	tp, err := printer.NewTablePrinter(previewBreakdownColumns, false)
+	if err != nil {
+		return err
+	}
+	err = tp.PrintObj(pr.Breakdown)
+	if err != nil {
+		return err
+	}
+	fmt.Println()
+
+	tp, err = printer.NewTablePrinter(previewRuntimeColumns, false)
+	if err != nil {
+		return err
+	}
+	return tp.PrintObj(pr.Runtimes)
+}
+
+const previewTimeFormat = "2006/01/02 15:04:05"
+
+func previewMaintenanceWindow(obj interface{}) string {
+	r := obj.(orchestration.PreviewRuntime)
+	if r.MaintenanceWindowBegin.IsZero() {
+		return "-"
+	}
+	return fmt.Sprintf("%s - %s", r.MaintenanceWindowBegin.Format(previewTimeFormat), r.MaintenanceWindowEnd.Format(previewTimeFormat))
+}
+
+func previewEstimatedStart(obj interface{}) string {
+	r := obj.(orchestration.PreviewRuntime)
+	return r.EstimatedStart.Format(previewTimeFormat)
+}
+
+func previewEstimatedEnd(obj interface{}) string {
+	r := obj.(orchestration.PreviewRuntime)
+	return r.EstimatedEnd.Format(previewTimeFormat)
+}
@@ -27,7 +27,8 @@ Additional Kyma configurations to use for the upgrade are taken from Kyma Contro
 		Example: `  kcp upgrade cluster --target all --schedule maintenancewindow    Upgrade Kubernetes cluster on Runtime in their next respective maintenance window hours.
   kcp upgrade cluster --target "account=CA.*"                       Upgrade Kubernetes cluster on Runtimes of all global accounts starting with CA.
   kcp upgrade cluster --target all --target-exclude "account=CA.*"  Upgrade Kubernetes cluster on Runtimes of all global accounts not starting with CA.
-  kcp upgrade cluster --target "region=europe|eu|uk"                Upgrade Kubernetes cluster on Runtimes whose region belongs to Europe.`,
+  kcp upgrade cluster --target "region=europe|eu|uk"                Upgrade Kubernetes cluster on Runtimes whose region belongs to Europe.
+  kcp upgrade cluster --target all --preview                        Display all Runtimes and the estimated schedule of their Kubernetes cluster upgrade without upgrading them.`,
 
 		PreRunE: func(_ *cobra.Command, _ []string) error { return cmd.Validate() },
 		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
@@ -54,6 +55,9 @@ func (cmd *UpgradeClusterCommand) Validate() error {
 func (cmd *UpgradeClusterCommand) Run() error {
 	cmd.log = logger.New()
 	client := orchestration.NewClient(cmd.cobraCmd.Context(), GlobalOpts.KEBAPIURL(), CLICredentialManager(cmd.log))
+	if cmd.preview {
+		return cmd.showPreview(client, orchestration.UpgradeClusterOrchestration)
+	}
 	ur, err := client.UpgradeCluster(cmd.orchestrationParams)
 	if err != nil {
 		return errors.Wrap(err, "while triggering kyma upgrade")
@@ -33,7 +33,8 @@ Additional Kyma configurations to use for the upgrade are taken from Kyma Contro
   kcp upgrade kyma --target "account=CA.*"                       Upgrade Kyma on Runtimes of all global accounts starting with CA.
   kcp upgrade kyma --target all --target-exclude "account=CA.*"  Upgrade Kyma on Runtimes of all global accounts not starting with CA.
   kcp upgrade kyma --target "region=europe|eu|uk"                Upgrade Kyma on Runtimes whose region belongs to Europe.
-  kcp upgrade kyma --target all --version "main-00e83e99"        Upgrade Kyma on Runtimes of all global accounts to the custom Kyma version (main-00e83e99).`,
+  kcp upgrade kyma --target all --version "main-00e83e99"        Upgrade Kyma on Runtimes of all global accounts to the custom Kyma version (main-00e83e99).
+  kcp upgrade kyma --target "plan=azure" --preview               Display the Runtimes with the azure plan and the estimated schedule of their upgrade without upgrading them.`,
 		PreRunE: func(_ *cobra.Command, _ []string) error { return cmd.Validate() },
 		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
 	}
@@ -53,6 +54,9 @@ func (cmd *UpgradeKymaCommand) SetUpgradeOpts(cobraCmd *cobra.Command) {
 func (cmd *UpgradeKymaCommand) Run() error {
 	cmd.log = logger.New()
 	client := orchestration.NewClient(cmd.cobraCmd.Context(), GlobalOpts.KEBAPIURL(), CLICredentialManager(cmd.log))
+	if cmd.preview {
+		return cmd.showPreview(client, orchestration.UpgradeKymaOrchestration)
+	}
 	ur, err := client.UpgradeKyma(cmd.orchestrationParams)
 	if err != nil {
 		return errors.Wrap(err, "while triggering kyma upgrade")